@@ -16,7 +16,9 @@ import (
 // produce tiled plots with DataCanvases that are evenly sized and spaced.
 // The arguments to the function are a two-dimensional row-major array
 // of plots, a tile configuration, and the canvas to which the tiled
-// plots are to be drawn.
+// plots are to be drawn. If the tile configuration has relative
+// column widths or row heights, the DataCanvases are sized in
+// proportion to them instead.
 func Align(plots [][]*Plot, t draw.Tiles, dc draw.Canvas) [][]draw.Canvas {
 	o := make([][]draw.Canvas, len(plots))
 
@@ -72,6 +74,14 @@ func Align(plots [][]*Plot, t draw.Tiles, dc draw.Canvas) [][]draw.Canvas {
 
 	avgWidth := vg.Length((float64(dc.Max.X-dc.Min.X) - xTotalSpace) / float64(t.Cols))
 	avgHeight := vg.Length((float64(dc.Max.Y-dc.Min.Y) - yTotalSpace) / float64(t.Rows))
+	widths := make([]vg.Length, t.Cols)
+	for i := range widths {
+		widths[i] = avgWidth * vg.Length(tileFraction(t.ColWidths, t.Cols, i))
+	}
+	heights := make([]vg.Length, t.Rows)
+	for j := range heights {
+		heights[j] = avgHeight * vg.Length(tileFraction(t.RowHeights, t.Rows, j))
+	}
 
 	moveVertical := make([]vg.Length, t.Cols)
 	for j := t.Rows - 1; j >= 0; j-- {
@@ -107,13 +117,31 @@ func Align(plots [][]*Plot, t draw.Tiles, dc draw.Canvas) [][]draw.Canvas {
 			// DataCanvas is the same for all plots.
 			o[j][i] = draw.Crop(c,
 				moveHorizontal,
-				moveHorizontal+avgWidth-width,
+				moveHorizontal+widths[i]-width,
 				moveVertical[i],
-				moveVertical[i]+avgHeight-height,
+				moveVertical[i]+heights[j]-height,
 			)
-			moveHorizontal += avgWidth - width
-			moveVertical[i] += avgHeight - height
+			moveHorizontal += widths[i] - width
+			moveVertical[i] += heights[j] - height
 		}
 	}
 	return o
 }
+
+// tileFraction returns the size of the ith of n tiles with
+// the relative sizes, as a multiple of the average size of
+// the tiles. All the tiles have the average size if sizes
+// is empty.
+func tileFraction(sizes []float64, n, i int) float64 {
+	if len(sizes) == 0 {
+		return 1
+	}
+	if len(sizes) != n {
+		panic(fmt.Errorf("plot: tile sizes (%d) != tiles (%d)", len(sizes), n))
+	}
+	var sum float64
+	for _, s := range sizes {
+		sum += s
+	}
+	return sizes[i] * float64(n) / sum
+}
@@ -7,7 +7,9 @@ package plot
 import (
 	"image/color"
 	"math"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"gonum.org/v1/plot/vg"
@@ -28,6 +30,18 @@ type Normalizer interface {
 	Normalize(min, max, x float64) float64
 }
 
+// Denormalizer is a Normalizer that can transform values
+// back from the normalized coordinate system.
+type Denormalizer interface {
+	Normalizer
+
+	// Denormalize transforms a value v in the normalized
+	// coordinate system to the data coordinate system,
+	// so that Normalize(min, max, Denormalize(min, max, v))
+	// is v.
+	Denormalize(min, max, v float64) float64
+}
+
 // An Axis represents either a horizontal or vertical
 // axis of a plot.
 type Axis struct {
@@ -64,10 +78,41 @@ type Axis struct {
 	// on the axis, thus making it easier to see.
 	Padding vg.Length
 
+	// Cross specifies whether the axis is drawn across
+	// the data area, crossing the other axis at the value
+	// CrossAt, instead of along the border of the plot.
+	// CrossAt is clamped to the range of the other axis,
+	// so that an axis crossing the other outside of its
+	// range is drawn along the nearest border of the data
+	// area. An axis crossing the other at the start of its
+	// range takes the space of a border axis.
+	Cross bool
+
+	// CrossAt is the value of the other axis at which the
+	// axis is drawn when Cross is true. Axes through the
+	// origin are drawn by setting Cross on both axes with
+	// CrossAt zero. A non-positive CrossAt is below the
+	// range of an other axis with a log scale.
+	CrossAt float64
+
 	Tick struct {
 		// Label is the TextStyle on the tick labels.
 		Label draw.TextStyle
 
+		// LabelFunc, if not nil, returns the labels of the
+		// major tick marks from their values, replacing the
+		// labels returned by the Marker, so that the labels
+		// can be formatted, for example as amounts of money
+		// or with SI prefixes, independently of the placement
+		// of the tick marks. A tick mark given an empty label
+		// is drawn as a minor tick mark.
+		LabelFunc func(v float64) string
+
+		// LabelPadding is the space added between the
+		// tick marks, or the axis line, and the tick
+		// labels, in addition to the default gap.
+		LabelPadding vg.Length
+
 		// LineStyle is the LineStyle of the tick lines.
 		draw.LineStyle
 
@@ -80,12 +125,128 @@ type Axis struct {
 		// returned by the Marker function that are not in
 		// range of the axis are not drawn.
 		Marker Ticker
+
+		// Direction is the direction of the tick marks
+		// relative to the data area. The default is
+		// TicksOutward.
+		Direction TickDirection
+
+		// CenterInk centers the glyphs of the tick labels
+		// of a vertical axis on their ticks, using the
+		// ascent and descent measured from the glyphs
+		// of each label rather than those of the font.
+		// It only applies to unrotated labels drawn by
+		// the plain text handler.
+		CenterInk bool
 	}
 
 	// Scale transforms a value given in the data coordinate system
 	// to the normalized coordinate system of the axis—its distance
 	// along the axis as a fraction of the axis range.
+	//
+	// When the Scale is a LogScale, possibly inverted, non-positive
+	// values are left out of the range of the axis by Plot.Add and
+	// Plot.AddY2, and tick marks at non-positive values are not
+	// drawn, so that the axis can be used with any Ticker.
 	Scale Normalizer
+
+	// RangePadding is the padding of the range of the axis
+	// applied when the plot is drawn. The zero value does not
+	// pad the range.
+	RangePadding RangePadding
+
+	// Breaks are the intervals of data values collapsed by
+	// the axis. The values on either side of a break are
+	// drawn next to each other, the values inside it are
+	// drawn at the break, and no tick marks are drawn
+	// inside it. The axis line is broken at each break
+	// with a pair of slashes the length of a major tick
+	// mark. The breaks must not overlap.
+	Breaks []Break
+
+	// padded is the range of the axis after padding,
+	// so that the padding is only applied once.
+	padded [2]float64
+}
+
+// RangePadding specifies how the range of an axis is widened
+// beyond the range of the data, so that the data are not drawn
+// on the edges of the plot.
+type RangePadding struct {
+	// Fraction is the fraction of the range of the
+	// axis added below its minimum and above its maximum.
+	Fraction float64
+
+	// Absolute is the amount, in data units, added
+	// below the minimum and above the maximum of the
+	// axis, in addition to Fraction.
+	Absolute float64
+
+	// Nice specifies whether the padded range is widened
+	// to round numbers matching the Marker of the axis.
+	// For DefaultTicks, the range is widened to the nearest
+	// labels covering it that DefaultTicks would choose.
+	// For other Tickers, the range is widened to the nearest
+	// multiples of the distance between the major ticks,
+	// which are assumed to be evenly spaced. A non-negative
+	// minimum is not moved below zero.
+	//
+	// The range of an axis with a log scale is padded in
+	// decades: Fraction is a fraction of the number of
+	// decades of the range, Absolute is a number of decades,
+	// and Nice widens the range to whole decades.
+	Nice bool
+}
+
+// pad returns the range min, max padded according to p, using
+// the ticker to snap the padded range to round values.
+func (p RangePadding) pad(min, max float64, ticker Ticker) (float64, float64) {
+	d := p.Fraction*(max-min) + p.Absolute
+	lo, hi := min-d, max+d
+	if !p.Nice || !(lo < hi) {
+		return lo, hi
+	}
+
+	var nlo, nhi float64
+	if _, ok := ticker.(DefaultTicks); ok {
+		labels, _, _, _ := talbotLinHanrahan(lo, hi, suggestedTicks, containData, nil, nil, nil)
+		if len(labels) == 0 {
+			return lo, hi
+		}
+		nlo, nhi = labels[0], labels[len(labels)-1]
+	} else {
+		var majors []float64
+		for _, t := range ticker.Ticks(lo, hi) {
+			if !t.IsMinor() {
+				majors = append(majors, t.Value)
+			}
+		}
+		if len(majors) < 2 {
+			return lo, hi
+		}
+		step := majors[1] - majors[0]
+		if !(step > 0) {
+			return lo, hi
+		}
+		nlo = majors[0] + step*math.Floor((lo-majors[0])/step)
+		nhi = majors[0] + step*math.Ceil((hi-majors[0])/step)
+	}
+	if lo < 0 || nlo >= 0 {
+		lo = nlo
+	}
+	return lo, math.Max(hi, nhi)
+}
+
+// padLog returns the range from min to max, which must be
+// positive, padded in decades.
+func (p RangePadding) padLog(min, max float64) (float64, float64) {
+	lo, hi := math.Log10(min), math.Log10(max)
+	d := p.Fraction*(hi-lo) + p.Absolute
+	lo, hi = lo-d, hi+d
+	if p.Nice {
+		lo, hi = math.Floor(lo), math.Ceil(hi)
+	}
+	return math.Pow(10, lo), math.Pow(10, hi)
 }
 
 // makeAxis returns a default Axis.
@@ -153,7 +314,8 @@ func makeAxis(o orientation) (Axis, error) {
 }
 
 // sanitizeRange ensures that the range of the
-// axis makes sense.
+// axis makes sense, and pads it according to the
+// RangePadding of the axis.
 func (a *Axis) sanitizeRange() {
 	if math.IsInf(a.Min, 0) {
 		a.Min = 0
@@ -164,28 +326,62 @@ func (a *Axis) sanitizeRange() {
 	if a.Min > a.Max {
 		a.Min, a.Max = a.Max, a.Min
 	}
+	if isLogScale(a.Scale) {
+		a.sanitizeLogRange()
+		return
+	}
 	if a.Min == a.Max {
 		a.Min--
 		a.Max++
 	}
+	if a.RangePadding != (RangePadding{}) && (a.Min != a.padded[0] || a.Max != a.padded[1]) {
+		a.Min, a.Max = a.RangePadding.pad(a.Min, a.Max, a.Tick.Marker)
+		a.padded = [2]float64{a.Min, a.Max}
+	}
+}
+
+// sanitizeLogRange ensures that the range of an axis
+// with a log scale is positive and not empty, and pads
+// it in decades.
+func (a *Axis) sanitizeLogRange() {
+	if a.Max <= 0 {
+		a.Min, a.Max = 1, 10
+	}
+	if a.Min <= 0 {
+		a.Min = a.Max / 10
+	}
+	if a.Min == a.Max {
+		a.Min /= 10
+		a.Max *= 10
+	}
+	if a.RangePadding != (RangePadding{}) && (a.Min != a.padded[0] || a.Max != a.padded[1]) {
+		a.Min, a.Max = a.RangePadding.padLog(a.Min, a.Max)
+		a.padded = [2]float64{a.Min, a.Max}
+	}
 }
 
 // LinearScale an be used as the value of an Axis.Scale function to
 // set the axis to a standard linear scale.
 type LinearScale struct{}
 
-var _ Normalizer = LinearScale{}
+var _ Denormalizer = LinearScale{}
 
 // Normalize returns the fractional distance of x between min and max.
 func (LinearScale) Normalize(min, max, x float64) float64 {
 	return (x - min) / (max - min)
 }
 
+// Denormalize returns the value at the fractional
+// distance v between min and max.
+func (LinearScale) Denormalize(min, max, v float64) float64 {
+	return min + v*(max-min)
+}
+
 // LogScale can be used as the value of an Axis.Scale function to
 // set the axis to a log scale.
 type LogScale struct{}
 
-var _ Normalizer = LogScale{}
+var _ Denormalizer = LogScale{}
 
 // Normalize returns the fractional logarithmic distance of
 // x between min and max.
@@ -197,23 +393,429 @@ func (LogScale) Normalize(min, max, x float64) float64 {
 	return (math.Log(x) - logMin) / (math.Log(max) - logMin)
 }
 
+// Denormalize returns the value at the fractional
+// logarithmic distance v between min and max.
+func (LogScale) Denormalize(min, max, v float64) float64 {
+	if min <= 0 || max <= 0 {
+		panic("Values must be greater than 0 for a log scale.")
+	}
+	logMin := math.Log(min)
+	return math.Exp(logMin + v*(math.Log(max)-logMin))
+}
+
+// isLogScale returns whether n is a LogScale,
+// possibly inverted.
+func isLogScale(n Normalizer) bool {
+	switch n := n.(type) {
+	case LogScale, *LogScale:
+		return true
+	case InvertedScale:
+		return isLogScale(n.Normalizer)
+	case *InvertedScale:
+		return isLogScale(n.Normalizer)
+	}
+	return false
+}
+
+// SymlogScale can be used as the value of an Axis.Scale function to
+// set the axis to a symmetric log scale. The scale is linear between
+// -Threshold and +Threshold, and logarithmic beyond, so that it can
+// represent zero and negative values.
+type SymlogScale struct {
+	// Threshold is the extent of the linear region
+	// around zero. If Threshold is not positive,
+	// a threshold of 1 is used.
+	Threshold float64
+}
+
+var _ Denormalizer = SymlogScale{}
+
+// Normalize returns the fractional symmetric logarithmic
+// distance of x between min and max.
+func (s SymlogScale) Normalize(min, max, x float64) float64 {
+	t := symlogThreshold(s.Threshold)
+	smin := symlog(min, t)
+	return (symlog(x, t) - smin) / (symlog(max, t) - smin)
+}
+
+// Denormalize returns the value at the fractional symmetric
+// logarithmic distance v between min and max.
+func (s SymlogScale) Denormalize(min, max, v float64) float64 {
+	t := symlogThreshold(s.Threshold)
+	smin := symlog(min, t)
+	y := smin + v*(symlog(max, t)-smin)
+	if math.Abs(y) <= 1 {
+		return y * t
+	}
+	return math.Copysign(t*math.Pow(10, math.Abs(y)-1), y)
+}
+
+// symlogThreshold returns the threshold of a symmetric log
+// scale, replacing a non-positive threshold by 1.
+func symlogThreshold(t float64) float64 {
+	if t <= 0 {
+		return 1
+	}
+	return t
+}
+
+// symlog returns the symmetric log transform of x, with
+// a linear region between -t and +t.
+func symlog(x, t float64) float64 {
+	ax := math.Abs(x)
+	if ax <= t {
+		return x / t
+	}
+	return math.Copysign(1+math.Log10(ax/t), x)
+}
+
+// LogitScale can be used as the value of an Axis.Scale function to
+// set the axis to a logit scale, the log of the odds p/(1-p) of
+// the probabilities p, which stretches the distances between the
+// probabilities near 0 and 1.
+//
+// Values less than 1e-6 or greater than 1-1e-6, including 0 and 1,
+// are clamped to that range.
+type LogitScale struct{}
+
+var _ Denormalizer = LogitScale{}
+
+// Normalize returns the fractional logit distance of
+// x between min and max.
+func (LogitScale) Normalize(min, max, x float64) float64 {
+	lmin := logit(min)
+	return (logit(x) - lmin) / (logit(max) - lmin)
+}
+
+// Denormalize returns the value at the fractional
+// logit distance v between min and max.
+func (LogitScale) Denormalize(min, max, v float64) float64 {
+	lmin := logit(min)
+	return 1 / (1 + math.Exp(-(lmin + v*(logit(max)-lmin))))
+}
+
+// ProbabilityScale can be used as the value of an Axis.Scale function
+// to set the axis to a probability scale, the quantile of the standard
+// normal distribution of the probabilities, as on normal probability
+// paper.
+//
+// Values less than 1e-6 or greater than 1-1e-6, including 0 and 1,
+// are clamped to that range.
+type ProbabilityScale struct{}
+
+var _ Denormalizer = ProbabilityScale{}
+
+// Normalize returns the fractional normal quantile distance
+// of x between min and max.
+func (ProbabilityScale) Normalize(min, max, x float64) float64 {
+	qmin := probit(min)
+	return (probit(x) - qmin) / (probit(max) - qmin)
+}
+
+// Denormalize returns the value at the fractional normal
+// quantile distance v between min and max.
+func (ProbabilityScale) Denormalize(min, max, v float64) float64 {
+	qmin := probit(min)
+	z := qmin + v*(probit(max)-qmin)
+	return math.Erfc(-z/math.Sqrt2) / 2
+}
+
+// probEpsilon is the distance to 0 and 1 at which
+// probabilities are clamped by the logit and
+// probability scales.
+const probEpsilon = 1e-6
+
+// clampProb returns p clamped to the
+// range [probEpsilon, 1-probEpsilon].
+func clampProb(p float64) float64 {
+	return math.Max(probEpsilon, math.Min(1-probEpsilon, p))
+}
+
+// logit returns the log of the odds of the clamped p.
+func logit(p float64) float64 {
+	p = clampProb(p)
+	return math.Log(p / (1 - p))
+}
+
+// probit returns the standard normal quantile of the clamped p.
+func probit(p float64) float64 {
+	p = clampProb(p)
+	return math.Sqrt2 * math.Erfinv(2*p-1)
+}
+
 // InvertedScale can be used as the value of an Axis.Scale function to
 // invert the axis using any Normalizer.
 type InvertedScale struct{ Normalizer }
 
-var _ Normalizer = InvertedScale{}
+var _ Denormalizer = InvertedScale{}
 
 // Normalize returns a normalized [0, 1] value for the position of x.
 func (is InvertedScale) Normalize(min, max, x float64) float64 {
 	return is.Normalizer.Normalize(max, min, x)
 }
 
+// Denormalize returns the value whose position is v.
+func (is InvertedScale) Denormalize(min, max, v float64) float64 {
+	return denormalize(is.Normalizer, max, min, v)
+}
+
+// Break is an interval of data values collapsed by an Axis.
+type Break struct {
+	// Min and Max are the ends of the interval.
+	Min, Max float64
+}
+
+// brokenScale is the Normalizer of an axis with breaks. It
+// removes the normalized intervals of the breaks from the
+// normalized coordinate system of its Normalizer.
+type brokenScale struct {
+	Normalizer
+	breaks []Break
+}
+
+var _ Denormalizer = brokenScale{}
+
+// gap returns the normalized interval of the break b,
+// clipped to [0, 1].
+func (s brokenScale) gap(min, max float64, b Break) (lo, hi float64) {
+	lo = s.Normalizer.Normalize(min, max, b.Min)
+	hi = s.Normalizer.Normalize(min, max, b.Max)
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	return math.Max(lo, 0), math.Min(hi, 1)
+}
+
+// removed returns the length of the normalized intervals
+// of the breaks before the normalized position n and the
+// total length of the intervals.
+func (s brokenScale) removed(min, max, n float64) (before, total float64) {
+	for _, b := range s.breaks {
+		lo, hi := s.gap(min, max, b)
+		if hi <= lo {
+			continue
+		}
+		before += math.Max(0, math.Min(n, hi)-lo)
+		total += hi - lo
+	}
+	return before, total
+}
+
+// Normalize returns the normalized position of x with the
+// normalized intervals of the breaks removed.
+func (s brokenScale) Normalize(min, max, x float64) float64 {
+	n := s.Normalizer.Normalize(min, max, x)
+	before, total := s.removed(min, max, n)
+	if total >= 1 {
+		return n
+	}
+	return (n - before) / (1 - total)
+}
+
+// Denormalize returns the value whose position is v. Values
+// at the position of a break return the start of the break.
+func (s brokenScale) Denormalize(min, max, v float64) float64 {
+	var (
+		gaps  [][2]float64
+		total float64
+	)
+	for _, b := range s.breaks {
+		lo, hi := s.gap(min, max, b)
+		if hi <= lo {
+			continue
+		}
+		gaps = append(gaps, [2]float64{lo, hi})
+		total += hi - lo
+	}
+	if total >= 1 {
+		return denormalize(s.Normalizer, min, max, v)
+	}
+	sort.Slice(gaps, func(i, j int) bool { return gaps[i][0] < gaps[j][0] })
+	n := v * (1 - total)
+	for _, g := range gaps {
+		if n <= g[0] {
+			break
+		}
+		n += g[1] - g[0]
+	}
+	return denormalize(s.Normalizer, min, max, n)
+}
+
+// denormalize returns the value at v in the normalized
+// coordinate system of the Normalizer n. If n is not a
+// Denormalizer, the value is found by bisection, assuming
+// that n increases monotonically from min to max.
+func denormalize(n Normalizer, min, max, v float64) float64 {
+	if d, ok := n.(Denormalizer); ok {
+		return d.Denormalize(min, max, v)
+	}
+
+	// Bisect the fraction s of the way from min to
+	// max, first widening the range to contain v.
+	at := func(s float64) float64 { return min + s*(max-min) }
+	norm := func(s float64) float64 { return n.Normalize(min, max, at(s)) }
+	lo, hi := 0.0, 1.0
+	for i := 0; i < 64 && norm(lo) > v; i++ {
+		lo = 2*lo - 1
+	}
+	for i := 0; i < 64 && norm(hi) < v; i++ {
+		hi *= 2
+	}
+	for i := 0; i < 64; i++ {
+		mid := (lo + hi) / 2
+		if norm(mid) < v {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return at((lo + hi) / 2)
+}
+
 // Norm returns the value of x, given in the data coordinate
 // system, normalized to its distance as a fraction of the
 // range of this axis.  For example, if x is a.Min then the return
 // value is 0, and if x is a.Max then the return value is 1.
 func (a Axis) Norm(x float64) float64 {
-	return a.Scale.Normalize(a.Min, a.Max, x)
+	return a.scale().Normalize(a.Min, a.Max, x)
+}
+
+// Denorm returns the value, in the data coordinate system,
+// whose normalized distance as a fraction of the range of
+// this axis is v. It is the inverse of Norm. If the Scale of
+// the axis is not a Denormalizer, it is assumed to increase
+// monotonically from Min to Max and is inverted numerically.
+func (a Axis) Denorm(v float64) float64 {
+	return denormalize(a.scale(), a.Min, a.Max, v)
+}
+
+// scale returns the Normalizer of the axis, collapsing
+// the breaks of the axis.
+func (a Axis) scale() Normalizer {
+	if len(a.Breaks) == 0 {
+		return a.Scale
+	}
+	return brokenScale{Normalizer: a.Scale, breaks: a.Breaks}
+}
+
+// inBreak returns whether x is inside a break of the axis,
+// including its ends.
+func (a Axis) inBreak(x float64) bool {
+	for _, b := range a.Breaks {
+		if math.Min(b.Min, b.Max) <= x && x <= math.Max(b.Min, b.Max) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedBreaks returns the breaks of the axis in increasing
+// order, with the Min of each break less than its Max.
+func (a Axis) sortedBreaks() []Break {
+	bs := make([]Break, len(a.Breaks))
+	for i, b := range a.Breaks {
+		bs[i] = Break{Min: math.Min(b.Min, b.Max), Max: math.Max(b.Min, b.Max)}
+	}
+	sort.Slice(bs, func(i, j int) bool { return bs[i].Min < bs[j].Min })
+	return bs
+}
+
+// Ticks returns the tick marks drawn on the axis for its
+// current range, labelled by Tick.LabelFunc if it is set.
+// The tick marks of an axis with breaks are those of the
+// intervals between its breaks, leaving out the tick marks
+// inside the breaks. The tick marks at non-positive values
+// are left out on an axis with a log scale.
+func (a Axis) Ticks() []Tick {
+	return a.labelTicks(a.markerTicks())
+}
+
+// markerTicks returns the tick marks of the axis
+// returned by the Marker, as described by Ticks.
+func (a Axis) markerTicks() []Tick {
+	if len(a.Breaks) == 0 {
+		return a.positiveTicks(a.Tick.Marker.Ticks(a.Min, a.Max))
+	}
+	var ticks []Tick
+	bs := a.sortedBreaks()
+	from := math.Inf(-1)
+	for i := 0; i <= len(bs); i++ {
+		to := math.Inf(1)
+		if i < len(bs) {
+			to = bs[i].Min
+		}
+		min, max := math.Max(from, a.Min), math.Min(to, a.Max)
+		if min < max {
+			for _, t := range a.Tick.Marker.Ticks(min, max) {
+				if from < t.Value && t.Value < to && !a.inBreak(t.Value) {
+					ticks = append(ticks, t)
+				}
+			}
+		}
+		if i < len(bs) {
+			from = bs[i].Max
+		}
+	}
+	return a.positiveTicks(ticks)
+}
+
+// labelTicks returns a copy of the ticks with the labels
+// of the major ticks returned by Tick.LabelFunc, or the
+// ticks if Tick.LabelFunc is nil.
+func (a Axis) labelTicks(ticks []Tick) []Tick {
+	if a.Tick.LabelFunc == nil {
+		return ticks
+	}
+	labelled := make([]Tick, len(ticks))
+	for i, t := range ticks {
+		if !t.IsMinor() {
+			t.Label = a.Tick.LabelFunc(t.Value)
+		}
+		labelled[i] = t
+	}
+	return labelled
+}
+
+// positiveTicks returns the ticks at positive values if
+// the axis has a log scale, and all the ticks otherwise.
+func (a Axis) positiveTicks(ticks []Tick) []Tick {
+	if !isLogScale(a.Scale) {
+		return ticks
+	}
+	pos := ticks[:0:0]
+	for _, t := range ticks {
+		if t.Value > 0 {
+			pos = append(pos, t)
+		}
+	}
+	return pos
+}
+
+// strokeLine strokes the axis line from start to end, leaving
+// a gap marked by a pair of slashes at each break of the axis.
+// The pos function returns the distance along the axis line of
+// a normalized position, and the point function returns the
+// point at a distance along the axis line and across it.
+func (a Axis) strokeLine(c draw.Canvas, start, end vg.Length, pos func(float64) vg.Length, point func(along, across vg.Length) vg.Point) {
+	l := a.Tick.Length
+	for _, b := range a.sortedBreaks() {
+		if b.Max <= a.Min || a.Max <= b.Min {
+			continue
+		}
+		at := pos(a.Norm(b.Min))
+		if at <= start || end <= at {
+			continue
+		}
+		c.StrokeLines(a.LineStyle, []vg.Point{point(start, 0), point(at-l/4, 0)})
+		start = at + l/4
+		for _, off := range []vg.Length{-l / 4, l / 4} {
+			c.StrokeLines(a.LineStyle, []vg.Point{
+				point(at+off-l/4, -l/2),
+				point(at+off+l/4, l/2),
+			})
+		}
+	}
+	c.StrokeLines(a.LineStyle, []vg.Point{point(start, 0), point(end, 0)})
 }
 
 // drawTicks returns true if the tick marks should be drawn.
@@ -221,6 +823,77 @@ func (a Axis) drawTicks() bool {
 	return a.Tick.Width > 0 && a.Tick.Length > 0
 }
 
+// TickDirection is the direction of the tick marks
+// of an axis relative to the data area.
+type TickDirection int
+
+const (
+	// TicksOutward draws the tick marks outside
+	// the data area, between the axis line and
+	// the tick labels.
+	TicksOutward TickDirection = iota
+
+	// TicksInward draws the tick marks inside
+	// the data area. The tick labels are moved
+	// against the axis line.
+	TicksInward
+
+	// TicksBoth draws the tick marks across
+	// the axis line, with half of their length
+	// on each side.
+	TicksBoth
+)
+
+// tickOutside returns the length of the major tick
+// marks outside the axis line.
+func (a Axis) tickOutside() vg.Length {
+	switch a.Tick.Direction {
+	case TicksInward:
+		return 0
+	case TicksBoth:
+		return a.Tick.Length / 2
+	default:
+		return a.Tick.Length
+	}
+}
+
+// tickSpan returns the start and the end of the line of
+// the tick mark t, as distances from the outer edge of
+// the major tick marks towards the data area. The axis
+// line is at the distance returned by tickOutside.
+func (a Axis) tickSpan(t Tick) (start, end vg.Length) {
+	len := a.Tick.Length
+	switch a.Tick.Direction {
+	case TicksInward:
+		return 0, len - t.lengthOffset(len)
+	case TicksBoth:
+		l := (len - t.lengthOffset(len)) / 2
+		return len/2 - l, len/2 + l
+	default:
+		return t.lengthOffset(len), len
+	}
+}
+
+// crossing returns the normalized position along the
+// other axis at which the axis is drawn, and whether
+// the axis is drawn across the data area rather than
+// along the border of the plot.
+func (a *Axis) crossing(other *Axis) (float64, bool) {
+	if !a.Cross {
+		return 0, false
+	}
+	if a.CrossAt <= 0 && isLogScale(other.Scale) {
+		// The axis crosses a log axis below its range.
+		return 0, false
+	}
+	n := other.Norm(a.CrossAt)
+	if math.IsNaN(n) {
+		return 0, false
+	}
+	n = math.Max(0, math.Min(1, n))
+	return n, n > 0
+}
+
 // A horizontalAxis draws horizontally across the bottom
 // of a plot.
 type horizontalAxis struct {
@@ -235,12 +908,13 @@ func (a horizontalAxis) size() (h vg.Length) {
 		h += a.Label.Padding
 	}
 
-	marks := a.Tick.Marker.Ticks(a.Min, a.Max)
+	marks := a.Ticks()
 	if len(marks) > 0 {
 		if a.drawTicks() {
-			h += a.Tick.Length
+			h += a.tickOutside()
 		}
 		h += tickLabelHeight(a.Tick.Label, marks)
+		h += a.Tick.LabelPadding
 	}
 	h += a.Width / 2
 	h += a.Padding
@@ -248,6 +922,26 @@ func (a horizontalAxis) size() (h vg.Length) {
 	return h
 }
 
+// lineOffset returns the distance between the lower
+// edge of the canvas passed to draw and the axis line.
+func (a horizontalAxis) lineOffset() (y vg.Length) {
+	if a.Label.Text != "" {
+		y -= a.Label.Font.Extents().Descent
+		y += a.Label.Height(a.Label.Text)
+		y += a.Label.Padding
+	}
+	marks := a.Ticks()
+	if len(marks) == 0 {
+		return y + a.Width/2
+	}
+	y += tickLabelHeight(a.Tick.Label, marks)
+	y += a.Tick.LabelPadding
+	if a.drawTicks() {
+		y += a.tickOutside()
+	}
+	return y
+}
+
 // draw draws the axis along the lower edge of a draw.Canvas.
 func (a horizontalAxis) draw(c draw.Canvas) {
 	var (
@@ -268,42 +962,47 @@ func (a horizontalAxis) draw(c draw.Canvas) {
 		y += a.Label.Padding
 	}
 
-	marks := a.Tick.Marker.Ticks(a.Min, a.Max)
+	marks := a.Ticks()
 	ticklabelheight := tickLabelHeight(a.Tick.Label, marks)
 	for _, t := range marks {
 		x := c.X(a.Norm(t.Value))
 		if !c.ContainsX(x) || t.IsMinor() {
 			continue
 		}
-		c.FillText(a.Tick.Label, vg.Point{X: x, Y: y + ticklabelheight}, t.Label)
+		// Align the top of the possibly rotated
+		// label with the top of the label area.
+		top := a.Tick.Label.Rectangle(t.Label).Max.Y
+		c.FillText(a.Tick.Label, vg.Point{X: x, Y: y + ticklabelheight - top}, t.Label)
 	}
 
 	if len(marks) > 0 {
 		y += ticklabelheight
+		y += a.Tick.LabelPadding
 	} else {
 		y += a.Width / 2
 	}
 
 	if len(marks) > 0 && a.drawTicks() {
-		len := a.Tick.Length
 		for _, t := range marks {
 			x := c.X(a.Norm(t.Value))
 			if !c.ContainsX(x) {
 				continue
 			}
-			start := t.lengthOffset(len)
-			c.StrokeLine2(a.Tick.LineStyle, x, y+start, x, y+len)
+			start, end := a.tickSpan(t)
+			c.StrokeLine2(a.Tick.LineStyle, x, y+start, x, y+end)
 		}
-		y += len
+		y += a.tickOutside()
 	}
 
-	c.StrokeLine2(a.LineStyle, c.Min.X, y, c.Max.X, y)
+	a.strokeLine(c, c.Min.X, c.Max.X, c.X, func(along, across vg.Length) vg.Point {
+		return vg.Point{X: along, Y: y + across}
+	})
 }
 
 // GlyphBoxes returns the GlyphBoxes for the tick labels.
 func (a horizontalAxis) GlyphBoxes(*Plot) []GlyphBox {
 	var boxes []GlyphBox
-	for _, t := range a.Tick.Marker.Ticks(a.Min, a.Max) {
+	for _, t := range a.Ticks() {
 		if t.IsMinor() {
 			continue
 		}
@@ -329,14 +1028,15 @@ func (a verticalAxis) size() (w vg.Length) {
 		w += a.Label.Padding
 	}
 
-	marks := a.Tick.Marker.Ticks(a.Min, a.Max)
+	marks := a.Ticks()
 	if len(marks) > 0 {
 		if lwidth := tickLabelWidth(a.Tick.Label, marks); lwidth > 0 {
 			w += lwidth
 			w += a.Label.Width(" ")
+			w += a.Tick.LabelPadding
 		}
 		if a.drawTicks() {
-			w += a.Tick.Length
+			w += a.tickOutside()
 		}
 	}
 	w += a.Width / 2
@@ -345,6 +1045,26 @@ func (a verticalAxis) size() (w vg.Length) {
 	return w
 }
 
+// lineOffset returns the distance between the left
+// side of the canvas passed to draw and the axis line.
+func (a verticalAxis) lineOffset() (x vg.Length) {
+	if a.Label.Text != "" {
+		x += a.Label.Height(a.Label.Text)
+		x += -a.Label.Font.Extents().Descent
+		x += a.Label.Padding
+	}
+	marks := a.Ticks()
+	if w := tickLabelWidth(a.Tick.Label, marks); len(marks) > 0 && w > 0 {
+		x += w
+		x += a.Tick.Label.Width(" ")
+		x += a.Tick.LabelPadding
+	}
+	if a.drawTicks() && len(marks) > 0 {
+		x += a.tickOutside()
+	}
+	return x
+}
+
 // draw draws the axis along the left side of a draw.Canvas.
 func (a verticalAxis) draw(c draw.Canvas) {
 	var (
@@ -366,7 +1086,7 @@ func (a verticalAxis) draw(c draw.Canvas) {
 		x += -a.Label.Font.Extents().Descent
 		x += a.Label.Padding
 	}
-	marks := a.Tick.Marker.Ticks(a.Min, a.Max)
+	marks := a.Ticks()
 	if w := tickLabelWidth(a.Tick.Label, marks); len(marks) > 0 && w > 0 {
 		x += w
 	}
@@ -377,44 +1097,167 @@ func (a verticalAxis) draw(c draw.Canvas) {
 		if !c.ContainsY(y) || t.IsMinor() {
 			continue
 		}
-		c.FillText(a.Tick.Label, vg.Point{X: x, Y: y}, t.Label)
+		// Align the right of the possibly rotated
+		// label with the right of the label area.
+		right := a.Tick.Label.Rectangle(t.Label).Max.X
+		y += a.tickLabelOffset(t.Label)
+		c.FillText(a.Tick.Label, vg.Point{X: x - right, Y: y}, t.Label)
 		major = true
 	}
 	if major {
 		x += a.Tick.Label.Width(" ")
+		x += a.Tick.LabelPadding
 	}
 	if a.drawTicks() && len(marks) > 0 {
-		len := a.Tick.Length
 		for _, t := range marks {
 			y := c.Y(a.Norm(t.Value))
 			if !c.ContainsY(y) {
 				continue
 			}
-			start := t.lengthOffset(len)
-			c.StrokeLine2(a.Tick.LineStyle, x+start, y, x+len, y)
+			start, end := a.tickSpan(t)
+			c.StrokeLine2(a.Tick.LineStyle, x+start, y, x+end, y)
 		}
-		x += len
+		x += a.tickOutside()
 	}
 
-	c.StrokeLine2(a.LineStyle, x, c.Min.Y, x, c.Max.Y)
+	a.strokeLine(c, c.Min.Y, c.Max.Y, c.Y, func(along, across vg.Length) vg.Point {
+		return vg.Point{X: x + across, Y: along}
+	})
 }
 
 // GlyphBoxes returns the GlyphBoxes for the tick labels
 func (a verticalAxis) GlyphBoxes(*Plot) []GlyphBox {
 	var boxes []GlyphBox
-	for _, t := range a.Tick.Marker.Ticks(a.Min, a.Max) {
+	for _, t := range a.Ticks() {
 		if t.IsMinor() {
 			continue
 		}
+		r := a.Tick.Label.Rectangle(t.Label)
+		off := a.tickLabelOffset(t.Label)
+		r.Min.Y += off
+		r.Max.Y += off
 		box := GlyphBox{
 			Y:         a.Norm(t.Value),
-			Rectangle: a.Tick.Label.Rectangle(t.Label),
+			Rectangle: r,
 		}
 		boxes = append(boxes, box)
 	}
 	return boxes
 }
 
+// tickLabelOffset returns the vertical offset of the
+// tick label txt from its tick that centers the glyphs
+// of the label on the center of its text rectangle.
+// The offset is zero unless Tick.CenterInk is set.
+func (a verticalAxis) tickLabelOffset(txt string) vg.Length {
+	sty := a.Tick.Label
+	if !a.Tick.CenterInk || sty.Rotation != 0 {
+		return 0
+	}
+	if _, ok := sty.Handler.(draw.PlainTextHandler); sty.Handler != nil && !ok {
+		return 0
+	}
+	txt = strings.TrimRight(txt, "\n")
+	if txt == "" {
+		return 0
+	}
+
+	// Follow the placement of the lines of text
+	// by the plain text handler.
+	lines := strings.Split(txt, "\n")
+	ht := sty.Height(txt)
+	lh := sty.LineSpacing()
+	base := ht*vg.Length(sty.YAlign) - sty.Font.Extents().Ascent + sty.Font.Size - lh
+	var (
+		top, bottom vg.Length
+		inked       bool
+	)
+	for i, line := range lines {
+		ext := sty.Font.TextExtents(line)
+		if ext.Ascent == 0 && ext.Descent == 0 {
+			continue
+		}
+		y := base + vg.Length(len(lines)-i)*lh
+		if !inked || y+ext.Ascent > top {
+			top = y + ext.Ascent
+		}
+		if !inked || y+ext.Descent < bottom {
+			bottom = y + ext.Descent
+		}
+		inked = true
+	}
+	if !inked {
+		return 0
+	}
+	center := ht * vg.Length(sty.YAlign+0.5)
+	return center - (top+bottom)/2
+}
+
+// A rightAxis is drawn vertically up the right side of a plot.
+type rightAxis struct {
+	verticalAxis
+}
+
+// draw draws the axis along the right side of a draw.Canvas.
+func (a rightAxis) draw(c draw.Canvas) {
+	x := c.Min.X + a.Padding + a.Width/2
+	a.strokeLine(c, c.Min.Y, c.Max.Y, c.Y, func(along, across vg.Length) vg.Point {
+		return vg.Point{X: x + across, Y: along}
+	})
+
+	marks := a.Ticks()
+	if a.drawTicks() && len(marks) > 0 {
+		out := a.tickOutside()
+		for _, t := range marks {
+			y := c.Y(a.Norm(t.Value))
+			if !c.ContainsY(y) {
+				continue
+			}
+			start, end := a.tickSpan(t)
+			c.StrokeLine2(a.Tick.LineStyle, x+(out-end), y, x+(out-start), y)
+		}
+		x += out
+	}
+
+	if w := tickLabelWidth(a.Tick.Label, marks); len(marks) > 0 && w > 0 {
+		x += a.Tick.Label.Width(" ")
+		x += a.Tick.LabelPadding
+		for _, t := range marks {
+			y := c.Y(a.Norm(t.Value))
+			if !c.ContainsY(y) || t.IsMinor() {
+				continue
+			}
+			// Align the left of the possibly rotated
+			// label with the left of the label area.
+			left := a.Tick.Label.Rectangle(t.Label).Min.X
+			y += a.tickLabelOffset(t.Label)
+			c.FillText(a.Tick.Label, vg.Point{X: x - left, Y: y}, t.Label)
+		}
+		x += w
+	}
+
+	if a.Label.Text != "" {
+		sty := a.Label.TextStyle
+		sty.Rotation += math.Pi / 2
+		x += a.Label.Padding
+		x += -a.Label.Font.Extents().Descent
+		x += a.Label.Height(a.Label.Text)
+		var y vg.Length
+		switch a.Label.Position {
+		case draw.PosCenter:
+			y = c.Center().Y
+		case draw.PosTop:
+			y = c.Max.Y
+			y -= a.Label.Font.Width(a.Label.Text) / 2
+		}
+		c.FillText(sty, vg.Point{X: x, Y: y}, a.Label.Text)
+	}
+}
+
+// suggestedTicks is the number of major ticks
+// that DefaultTicks aims for.
+const suggestedTicks = 3
+
 // DefaultTicks is suitable for the Tick.Marker field of an Axis,
 // it returns a reasonable default set of tick marks.
 type DefaultTicks struct{}
@@ -427,8 +1270,6 @@ func (DefaultTicks) Ticks(min, max float64) []Tick {
 		panic("illegal range")
 	}
 
-	const suggestedTicks = 3
-
 	labels, step, q, mag := talbotLinHanrahan(min, max, suggestedTicks, withinData, nil, nil, nil)
 	majorDelta := step * math.Pow10(mag)
 	if q == 0 {
@@ -513,6 +1354,10 @@ func maxInt(a, b int) int {
 
 // LogTicks is suitable for the Tick.Marker field of an Axis,
 // it returns tick marks suitable for a log-scale axis.
+// Labelled major ticks are placed at the decades spanning
+// the range, and unlabelled minor ticks at 2 to 9 times
+// each decade, so that a Grid drawing minor lines in a
+// subdued style emphasizes the decades.
 type LogTicks struct{}
 
 var _ Ticker = LogTicks{}
@@ -523,14 +1368,12 @@ func (LogTicks) Ticks(min, max float64) []Tick {
 		panic("Values must be greater than 0 for a log scale.")
 	}
 
-	val := math.Pow10(int(math.Log10(min)))
+	val := math.Pow10(int(math.Floor(math.Log10(min))))
 	max = math.Pow10(int(math.Ceil(math.Log10(max))))
 	var ticks []Tick
 	for val < max {
-		for i := 1; i < 10; i++ {
-			if i == 1 {
-				ticks = append(ticks, Tick{Value: val, Label: formatFloatTick(val, -1)})
-			}
+		ticks = append(ticks, Tick{Value: val, Label: formatFloatTick(val, -1)})
+		for i := 2; i < 10; i++ {
 			ticks = append(ticks, Tick{Value: val * float64(i)})
 		}
 		val *= 10
@@ -540,6 +1383,153 @@ func (LogTicks) Ticks(min, max float64) []Tick {
 	return ticks
 }
 
+// SymlogTicks is suitable for the Tick.Marker field of an Axis,
+// it returns tick marks suitable for a symmetric log scale axis.
+// Major ticks are placed at zero and at the decades of the
+// threshold on either side of it.
+type SymlogTicks struct {
+	// Threshold is the extent of the linear region
+	// around zero. It should be the same as the
+	// Threshold of the SymlogScale of the axis.
+	// If Threshold is not positive, a threshold of
+	// 1 is used.
+	Threshold float64
+}
+
+var _ Ticker = SymlogTicks{}
+
+// Ticks returns Ticks in a specified range. If fewer than two
+// major ticks fall within the range, as happens for ranges inside
+// the linear region or between two decades, the ends of the range
+// are labelled as major ticks. A range with min == max returns a
+// single major tick at that value.
+func (st SymlogTicks) Ticks(min, max float64) []Tick {
+	if max < min {
+		panic("illegal range")
+	}
+	if max == min {
+		return []Tick{{Value: min, Label: formatFloatTick(min, -1)}}
+	}
+	t := symlogThreshold(st.Threshold)
+
+	var neg, pos []Tick
+	if min < -t {
+		neg = symlogDecades(-min, t)
+	}
+	if max > t {
+		pos = symlogDecades(max, t)
+	}
+
+	ticks := make([]Tick, 0, len(neg)+len(pos)+1)
+	for i := len(neg) - 1; i >= 0; i-- {
+		tick := neg[i]
+		tick.Value = -tick.Value
+		if tick.Label != "" {
+			tick.Label = formatFloatTick(tick.Value, -1)
+		}
+		ticks = append(ticks, tick)
+	}
+	if min <= 0 && 0 <= max {
+		ticks = append(ticks, Tick{Value: 0, Label: "0"})
+	}
+	ticks = append(ticks, pos...)
+
+	var n int
+	for _, tick := range ticks {
+		if !tick.IsMinor() && min <= tick.Value && tick.Value <= max {
+			n++
+		}
+	}
+	if n >= 2 {
+		return ticks
+	}
+	for _, v := range []float64{min, max} {
+		i := sort.Search(len(ticks), func(i int) bool { return ticks[i].Value >= v })
+		if i < len(ticks) && ticks[i].Value == v {
+			ticks[i].Label = formatFloatTick(v, -1)
+			continue
+		}
+		ticks = append(ticks, Tick{})
+		copy(ticks[i+1:], ticks[i:])
+		ticks[i] = Tick{Value: v, Label: formatFloatTick(v, -1)}
+	}
+	return ticks
+}
+
+// symlogDecades returns the ticks on the decades of t,
+// and the minor ticks between them, up to the first decade
+// not less than max.
+func symlogDecades(max, t float64) []Tick {
+	var ticks []Tick
+	val := t
+	for val < max {
+		ticks = append(ticks, Tick{Value: val, Label: formatFloatTick(val, -1)})
+		for i := 2; i < 10; i++ {
+			ticks = append(ticks, Tick{Value: val * float64(i)})
+		}
+		val *= 10
+	}
+	return append(ticks, Tick{Value: val, Label: formatFloatTick(val, -1)})
+}
+
+// ProbabilityTicks is suitable for the Tick.Marker field of an Axis,
+// it returns tick marks suitable for a LogitScale or ProbabilityScale
+// axis. Major ticks are placed at 0.5 and at the powers of ten 0.1,
+// 0.01, ... and their complements 0.9, 0.99, ..., with minor ticks
+// between them.
+type ProbabilityTicks struct{}
+
+var _ Ticker = ProbabilityTicks{}
+
+// Ticks returns Ticks in a specified range, clamped
+// to the range of the probability scales.
+func (ProbabilityTicks) Ticks(min, max float64) []Tick {
+	min, max = clampProb(min), clampProb(max)
+	if max <= min {
+		panic("illegal range")
+	}
+
+	// The ticks below one half, from the largest,
+	// and their complements above one half.
+	var lower, upper []Tick
+	add := func(v float64, label string, prec int) {
+		lower = append(lower, Tick{Value: v, Label: label})
+		if label != "" {
+			label = formatFloatTick(1-v, prec)
+		}
+		upper = append(upper, Tick{Value: 1 - v, Label: label})
+	}
+	for _, v := range []float64{0.4, 0.3, 0.2} {
+		add(v, "", 0)
+	}
+	for k := 1; math.Pow10(-k) >= probEpsilon; k++ {
+		val := math.Pow10(-k)
+		add(val, formatFloatTick(val, -1), k)
+		if math.Pow10(-k-1) < probEpsilon {
+			break
+		}
+		for i := 9; i > 1; i-- {
+			add(float64(i)*math.Pow10(-k-1), "", 0)
+		}
+	}
+
+	var ticks []Tick
+	for i := len(lower) - 1; i >= 0; i-- {
+		if t := lower[i]; min <= t.Value && t.Value <= max {
+			ticks = append(ticks, t)
+		}
+	}
+	if min <= 0.5 && 0.5 <= max {
+		ticks = append(ticks, Tick{Value: 0.5, Label: "0.5"})
+	}
+	for _, t := range upper {
+		if min <= t.Value && t.Value <= max {
+			ticks = append(ticks, t)
+		}
+	}
+	return ticks
+}
+
 // ConstantTicks is suitable for the Tick.Marker field of an Axis.
 // This function returns the given set of ticks.
 type ConstantTicks []Tick
@@ -562,16 +1552,26 @@ func UnixTimeIn(loc *time.Location) func(t float64) time.Time {
 var UTCUnixTime = UnixTimeIn(time.UTC)
 
 // TimeTicks is suitable for axes representing time values.
+//
+// If the Ticker is nil, TimeTicks places ticks at calendar
+// intervals—seconds, minutes, hours, days, months or years—
+// chosen according to the range of the axis. These ticks are
+// computed on the wall clock of the location of the times
+// returned by Time, so that daily ticks stay at midnight across
+// daylight saving time transitions.
 type TimeTicks struct {
 	// Ticker is used to generate a set of ticks.
-	// If nil, DefaultTicks will be used.
+	// If nil, ticks are placed at calendar intervals.
 	Ticker Ticker
 
 	// Format is the textual representation of the time value.
-	// If empty, time.RFC3339 will be used
+	// If empty, a layout suitable for the calendar interval
+	// between ticks is used if the Ticker is nil, and
+	// time.RFC3339 otherwise.
 	Format string
 
 	// Time takes a float64 value and converts it into a time.Time.
+	// If Ticker is nil, Time must map values to times in seconds.
 	// If nil, UTCUnixTime is used.
 	Time func(t float64) time.Time
 }
@@ -580,15 +1580,18 @@ var _ Ticker = TimeTicks{}
 
 // Ticks implements plot.Ticker.
 func (t TimeTicks) Ticks(min, max float64) []Tick {
+	if t.Time == nil {
+		t.Time = UTCUnixTime
+	}
 	if t.Ticker == nil {
-		t.Ticker = DefaultTicks{}
+		if max <= min {
+			panic("illegal range")
+		}
+		return calendarTicks(min, max, t.Time, t.Format)
 	}
 	if t.Format == "" {
 		t.Format = time.RFC3339
 	}
-	if t.Time == nil {
-		t.Time = UTCUnixTime
-	}
 
 	ticks := t.Ticker.Ticks(min, max)
 	for i := range ticks {
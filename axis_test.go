@@ -8,10 +8,15 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
+	"gonum.org/v1/gonum/floats"
 	"gonum.org/v1/plot/cmpimg"
 	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 var axisSmallTickTests = []struct {
@@ -152,6 +157,376 @@ func TestInvertedScale_Normalize(t *testing.T) {
 	}
 }
 
+func TestSymlogScale_Normalize(t *testing.T) {
+	for _, threshold := range []float64{0, 0.5, 1, 10} {
+		scale := SymlogScale{Threshold: threshold}
+		const min, max = -1e4, 1e4
+		thr := threshold
+		if thr <= 0 {
+			thr = 1
+		}
+
+		if got := scale.Normalize(min, max, min); got != 0 {
+			t.Errorf("threshold=%v: unexpected normalized min: got:%v want:0", threshold, got)
+		}
+		if got := scale.Normalize(min, max, max); got != 1 {
+			t.Errorf("threshold=%v: unexpected normalized max: got:%v want:1", threshold, got)
+		}
+		if got := scale.Normalize(min, max, 0); math.Abs(got-0.5) > 1e-15 {
+			t.Errorf("threshold=%v: unexpected normalized zero: got:%v want:0.5", threshold, got)
+		}
+
+		// Normalize is continuous at the thresholds.
+		const eps = 1e-9
+		for _, x := range []float64{-thr, thr} {
+			below := scale.Normalize(min, max, x-eps*thr)
+			at := scale.Normalize(min, max, x)
+			above := scale.Normalize(min, max, x+eps*thr)
+			if math.Abs(below-at) > 1e-8 || math.Abs(above-at) > 1e-8 {
+				t.Errorf("threshold=%v: discontinuity at %v: got:%v %v %v",
+					threshold, x, below, at, above)
+			}
+		}
+
+		// Normalize is strictly increasing.
+		prev := math.Inf(-1)
+		const n = 10000
+		for i := 0; i <= n; i++ {
+			x := min + (max-min)*float64(i)/n
+			got := scale.Normalize(min, max, x)
+			if got <= prev {
+				t.Errorf("threshold=%v: not monotonic at %v: got:%v previous:%v", threshold, x, got, prev)
+			}
+			prev = got
+		}
+	}
+}
+
+func TestSymlogTicks(t *testing.T) {
+	ticks := SymlogTicks{Threshold: 1}.Ticks(-50, 500)
+	var (
+		values []float64
+		labels []string
+	)
+	for _, tick := range ticks {
+		if tick.IsMinor() {
+			continue
+		}
+		values = append(values, tick.Value)
+		labels = append(labels, tick.Label)
+	}
+	wantValues := []float64{-100, -10, -1, 0, 1, 10, 100, 1000}
+	wantLabels := []string{"-100", "-10", "-1", "0", "1", "10", "100", "1000"}
+	if !reflect.DeepEqual(values, wantValues) {
+		t.Errorf("unexpected major tick values: got:%v want:%v", values, wantValues)
+	}
+	if !reflect.DeepEqual(labels, wantLabels) {
+		t.Errorf("unexpected major tick labels: got:%q want:%q", labels, wantLabels)
+	}
+	for i := 1; i < len(ticks); i++ {
+		if ticks[i].Value <= ticks[i-1].Value {
+			t.Errorf("ticks not sorted: %v before %v", ticks[i-1].Value, ticks[i].Value)
+		}
+	}
+}
+
+func TestSymlogTicksDegenerate(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		min, max   float64
+		wantValues []float64
+		wantLabels []string
+	}{
+		{
+			name: "empty range",
+			min:  5, max: 5,
+			wantValues: []float64{5},
+			wantLabels: []string{"5"},
+		},
+		{
+			name: "empty range at zero",
+			min:  0, max: 0,
+			wantValues: []float64{0},
+			wantLabels: []string{"0"},
+		},
+		{
+			name: "inside threshold",
+			min:  0.2, max: 0.5,
+			wantValues: []float64{0.2, 0.5},
+			wantLabels: []string{"0.2", "0.5"},
+		},
+		{
+			name: "inside threshold across zero",
+			min:  -0.5, max: 0.25,
+			wantValues: []float64{-0.5, 0, 0.25},
+			wantLabels: []string{"-0.5", "0", "0.25"},
+		},
+		{
+			name: "negative between decades",
+			min:  -5, max: -2,
+			wantValues: []float64{-10, -5, -2, -1},
+			wantLabels: []string{"-10", "-5", "-2", "-1"},
+		},
+		{
+			name: "negative inside threshold",
+			min:  -0.5, max: -0.1,
+			wantValues: []float64{-0.5, -0.1},
+			wantLabels: []string{"-0.5", "-0.1"},
+		},
+		{
+			name: "negative decades",
+			min:  -500, max: -5,
+			wantValues: []float64{-1000, -100, -10, -1},
+			wantLabels: []string{"-1000", "-100", "-10", "-1"},
+		},
+	} {
+		ticks := SymlogTicks{Threshold: 1}.Ticks(test.min, test.max)
+		var (
+			values []float64
+			labels []string
+		)
+		for _, tick := range ticks {
+			if tick.IsMinor() {
+				continue
+			}
+			values = append(values, tick.Value)
+			labels = append(labels, tick.Label)
+		}
+		if !reflect.DeepEqual(values, test.wantValues) {
+			t.Errorf("%s: unexpected major tick values: got:%v want:%v", test.name, values, test.wantValues)
+		}
+		if !reflect.DeepEqual(labels, test.wantLabels) {
+			t.Errorf("%s: unexpected major tick labels: got:%q want:%q", test.name, labels, test.wantLabels)
+		}
+		for i := 1; i < len(ticks); i++ {
+			if ticks[i].Value <= ticks[i-1].Value {
+				t.Errorf("%s: ticks not sorted: %v before %v", test.name, ticks[i-1].Value, ticks[i].Value)
+			}
+		}
+	}
+}
+
+func TestLogTicks(t *testing.T) {
+	for _, test := range []struct {
+		min, max float64
+		want     []float64
+	}{
+		{min: 1, max: 100, want: []float64{1, 10, 100}},
+		{min: 2, max: 500, want: []float64{1, 10, 100, 1000}},
+		{min: 0.05, max: 50, want: []float64{0.01, 0.1, 1, 10, 100}},
+		{min: 0.001, max: 0.02, want: []float64{0.001, 0.01, 0.1}},
+	} {
+		var got []float64
+		for _, tick := range (LogTicks{}).Ticks(test.min, test.max) {
+			if !tick.IsMinor() {
+				got = append(got, tick.Value)
+			}
+		}
+		if !floats.EqualApprox(got, test.want, 1e-12) {
+			t.Errorf("unexpected major ticks for [%v,%v]: got:%v want:%v", test.min, test.max, got, test.want)
+		}
+	}
+}
+
+func TestLogTicksMinor(t *testing.T) {
+	var major, minor []float64
+	for _, tick := range (LogTicks{}).Ticks(1, 1000) {
+		if tick.IsMinor() {
+			minor = append(minor, tick.Value)
+			continue
+		}
+		major = append(major, tick.Value)
+	}
+	if want := []float64{1, 10, 100, 1000}; !floats.EqualApprox(major, want, 1e-12) {
+		t.Errorf("unexpected major ticks: got:%v want:%v", major, want)
+	}
+	var want []float64
+	for dec := 1.0; dec < 1000; dec *= 10 {
+		for i := 2; i < 10; i++ {
+			want = append(want, float64(i)*dec)
+		}
+	}
+	if !floats.EqualApprox(minor, want, 1e-12) {
+		t.Errorf("unexpected minor ticks: got:%v want:%v", minor, want)
+	}
+}
+
+func TestLogAxisRange(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		min, max float64
+		padding  RangePadding
+		want     [2]float64
+	}{
+		{name: "empty", min: math.Inf(1), max: math.Inf(-1), want: [2]float64{1, 10}},
+		{name: "non-positive", min: 0, max: 100, want: [2]float64{10, 100}},
+		{name: "single", min: 5, max: 5, want: [2]float64{0.5, 50}},
+		{name: "fraction", min: 1, max: 100, padding: RangePadding{Fraction: 0.5}, want: [2]float64{0.1, 1000}},
+		{name: "nice", min: 2, max: 50, padding: RangePadding{Nice: true}, want: [2]float64{1, 100}},
+	} {
+		a := Axis{Min: test.min, Max: test.max, Scale: LogScale{}, RangePadding: test.padding}
+		a.sanitizeRange()
+		if got := [2]float64{a.Min, a.Max}; !floats.EqualApprox(got[:], test.want[:], 1e-12) {
+			t.Errorf("%s: unexpected range: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestProbabilityScales_Normalize(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		scale Denormalizer
+	}{
+		{name: "logit", scale: LogitScale{}},
+		{name: "probability", scale: ProbabilityScale{}},
+	} {
+		const min, max = 0.01, 0.99
+		scale := test.scale
+
+		if got := scale.Normalize(min, max, 0.5); math.Abs(got-0.5) > 1e-15 {
+			t.Errorf("%s: unexpected normalized one half: got:%v want:0.5", test.name, got)
+		}
+
+		// Normalize is symmetric around one half.
+		for _, p := range []float64{0.001, 0.01, 0.1, 0.25, 0.4} {
+			lo := scale.Normalize(min, max, p)
+			hi := scale.Normalize(min, max, 1-p)
+			if math.Abs(lo+hi-1) > 1e-12 {
+				t.Errorf("%s: asymmetric at %v: got:%v and %v", test.name, p, lo, hi)
+			}
+			if got := scale.Denormalize(min, max, lo); math.Abs(got-p) > 1e-12 {
+				t.Errorf("%s: unexpected denormalized value: got:%v want:%v", test.name, got, p)
+			}
+		}
+
+		// Normalize is strictly increasing.
+		prev := math.Inf(-1)
+		const n = 10000
+		for i := 1; i < n; i++ {
+			x := float64(i) / n
+			got := scale.Normalize(min, max, x)
+			if got <= prev {
+				t.Errorf("%s: not monotonic at %v: got:%v previous:%v", test.name, x, got, prev)
+			}
+			prev = got
+		}
+
+		// Zero and one are clamped.
+		lo, hi := scale.Normalize(0, 1, 0), scale.Normalize(0, 1, 1)
+		if lo != 0 || hi != 1 {
+			t.Errorf("%s: unexpected normalized bounds: got:%v and %v want:0 and 1", test.name, lo, hi)
+		}
+		if got := scale.Normalize(min, max, 0); math.IsInf(got, 0) || math.IsNaN(got) || got >= 0 {
+			t.Errorf("%s: unexpected normalized zero: got:%v", test.name, got)
+		}
+	}
+}
+
+func TestProbabilityTicks(t *testing.T) {
+	ticks := ProbabilityTicks{}.Ticks(0.005, 0.995)
+	var labels []string
+	for _, tick := range ticks {
+		if !tick.IsMinor() {
+			labels = append(labels, tick.Label)
+		}
+	}
+	want := []string{"0.01", "0.1", "0.5", "0.9", "0.99"}
+	if !reflect.DeepEqual(labels, want) {
+		t.Errorf("unexpected major tick labels: got:%q want:%q", labels, want)
+	}
+	for i := 1; i < len(ticks); i++ {
+		if ticks[i].Value <= ticks[i-1].Value {
+			t.Errorf("ticks not sorted: %v before %v", ticks[i-1].Value, ticks[i].Value)
+		}
+	}
+
+	ticks = ProbabilityTicks{}.Ticks(0, 1)
+	if got := ticks[0].Value; got != 1e-6 {
+		t.Errorf("unexpected first tick for clamped range: got:%v want:1e-06", got)
+	}
+	if got := ticks[len(ticks)-1].Label; got != "0.999999" {
+		t.Errorf("unexpected last tick label for clamped range: got:%q want:\"0.999999\"", got)
+	}
+}
+
+func TestRotatedTickLabels(t *testing.T) {
+	names := make([]string, 10)
+	for i := range names {
+		names[i] = fmt.Sprintf("a rather long label %d", i)
+	}
+
+	newPlot := func(rotation float64) *Plot {
+		p, err := New()
+		if err != nil {
+			t.Fatalf("could not create plot: %v", err)
+		}
+		p.X.Min, p.X.Max = 0, 9
+		p.Y.Min, p.Y.Max = 0, 1
+		if rotation != 0 {
+			p.X.Tick.Label.Rotation = rotation
+			p.X.Tick.Label.XAlign = draw.XRight
+			p.X.Tick.Label.YAlign = draw.YCenter
+		}
+		p.NominalX(names...)
+		return p
+	}
+
+	const w, h = 20 * vg.Centimeter, 15 * vg.Centimeter
+	for _, rotation := range []float64{math.Pi / 4, math.Pi / 2} {
+		flatCanvas := newPlot(0).DataCanvas(draw.NewCanvas(new(recorder.Canvas), w, h))
+		p := newPlot(rotation)
+		var r recorder.Canvas
+		c := draw.NewCanvas(&r, w, h)
+		dc := p.DataCanvas(c)
+		if dc.Min.Y <= flatCanvas.Min.Y {
+			t.Errorf("rotation=%v: bottom padding did not grow: got:%v unrotated:%v",
+				rotation, dc.Min.Y, flatCanvas.Min.Y)
+		}
+
+		p.Draw(c)
+
+		// Check that the rotated labels are drawn within
+		// the canvas, below the data area. As for the layout
+		// of text, descenders are not taken into account.
+		fnt := p.X.Tick.Label.Font
+		ascent := fnt.Extents().Ascent
+		var (
+			angle float64
+			found int
+		)
+		for _, a := range r.Actions {
+			switch a := a.(type) {
+			case *recorder.Rotate:
+				angle += a.Angle
+			case *recorder.Pop:
+				angle = 0
+			case *recorder.FillString:
+				if angle == 0 || !strings.HasPrefix(a.String, "a rather long label") {
+					continue
+				}
+				found++
+				width := fnt.Width(a.String)
+				sin, cos := vg.Length(math.Sin(angle)), vg.Length(math.Cos(angle))
+				for _, q := range []vg.Point{
+					{X: a.Point.X, Y: a.Point.Y},
+					{X: a.Point.X + width, Y: a.Point.Y},
+					{X: a.Point.X, Y: a.Point.Y + ascent},
+					{X: a.Point.X + width, Y: a.Point.Y + ascent},
+				} {
+					y := q.X*sin + q.Y*cos
+					if y < -1e-6 || y > dc.Min.Y {
+						t.Errorf("rotation=%v: label %q extends outside the axis area: y=%v not in [0, %v]",
+							rotation, a.String, y, dc.Min.Y)
+					}
+				}
+			}
+		}
+		if found != len(names) {
+			t.Errorf("rotation=%v: unexpected number of rotated labels: got:%d want:%d", rotation, found, len(names))
+		}
+	}
+}
+
 func TestAxisPadding(t *testing.T) {
 	for _, padding := range []int{0, 5, 10} {
 		t.Run(fmt.Sprintf("padding-%d", padding), func(t *testing.T) {
@@ -176,3 +551,569 @@ func TestAxisPadding(t *testing.T) {
 		})
 	}
 }
+
+func TestAxisRangePadding(t *testing.T) {
+	for _, test := range []struct {
+		name             string
+		min, max         float64
+		padding          RangePadding
+		ticker           Ticker
+		wantMin, wantMax float64
+	}{
+		{
+			name: "none",
+			min:  0, max: 97,
+			wantMin: 0, wantMax: 97,
+		},
+		{
+			name: "fraction",
+			min:  10, max: 30,
+			padding: RangePadding{Fraction: 0.05},
+			wantMin: 9, wantMax: 31,
+		},
+		{
+			name: "absolute",
+			min:  10, max: 30,
+			padding: RangePadding{Fraction: 0.05, Absolute: 2},
+			wantMin: 7, wantMax: 33,
+		},
+		{
+			name: "nice",
+			min:  0, max: 97,
+			padding: RangePadding{Nice: true},
+			wantMin: 0, wantMax: 100,
+		},
+		{
+			name: "nice fraction",
+			min:  0, max: 97,
+			padding: RangePadding{Fraction: 0.05, Nice: true},
+			wantMin: -10, wantMax: 110,
+		},
+		{
+			name: "nice positive",
+			min:  3, max: 97,
+			padding: RangePadding{Nice: true},
+			wantMin: 0, wantMax: 100,
+		},
+		{
+			name: "nice ticker",
+			min:  -3, max: 97,
+			padding: RangePadding{Nice: true},
+			ticker:  ConstantTicks{{Value: 0, Label: "0"}, {Value: 25, Label: "25"}, {Value: 50, Label: "50"}},
+			wantMin: -25, wantMax: 100,
+		},
+	} {
+		p, err := New()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		p.X.Min, p.X.Max = test.min, test.max
+		p.X.RangePadding = test.padding
+		if test.ticker != nil {
+			p.X.Tick.Marker = test.ticker
+		}
+
+		// Padding must only be applied once.
+		for i := 0; i < 2; i++ {
+			p.Draw(draw.NewCanvas(&recorder.Canvas{}, 10*vg.Centimeter, 10*vg.Centimeter))
+			if p.X.Min != test.wantMin || p.X.Max != test.wantMax {
+				t.Errorf("unexpected range for %s after %d draws: got:[%g, %g] want:[%g, %g]",
+					test.name, i+1, p.X.Min, p.X.Max, test.wantMin, test.wantMax)
+			}
+		}
+	}
+}
+
+func TestAxisCross(t *testing.T) {
+	cmpimg.CheckPlot(func() {
+		p, err := New()
+		if err != nil {
+			t.Fatalf("error: %+v", err)
+		}
+		p.Title.Text = "Axes through the origin"
+		p.X.Min, p.X.Max = -2, 3
+		p.Y.Min, p.Y.Max = -1, 4
+		p.X.Cross, p.Y.Cross = true, true
+
+		err = p.Save(8*vg.Centimeter, 8*vg.Centimeter, "testdata/axis_cross.png")
+		if err != nil {
+			t.Fatalf("error: %+v", err)
+		}
+	}, t, "axis_cross.png")
+
+	for _, test := range []struct {
+		name     string
+		xCrossAt float64
+		yCrossAt float64
+
+		// wantX and wantY are the normalized positions
+		// of the X and Y axis lines along the other axis.
+		wantX, wantY float64
+	}{
+		{name: "origin", xCrossAt: 0, yCrossAt: 0, wantX: 0.2, wantY: 0.4},
+		{name: "inside", xCrossAt: 1.5, yCrossAt: -1, wantX: 0.5, wantY: 0.2},
+		{name: "clamped", xCrossAt: 10, yCrossAt: 10, wantX: 1, wantY: 1},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := New()
+			if err != nil {
+				t.Fatalf("error: %+v", err)
+			}
+			p.X.Min, p.X.Max = -2, 3
+			p.Y.Min, p.Y.Max = -1, 4
+			p.X.Cross, p.X.CrossAt = true, test.xCrossAt
+			p.Y.Cross, p.Y.CrossAt = true, test.yCrossAt
+
+			var rec recorder.Canvas
+			c := draw.NewCanvas(&rec, 200, 200)
+			p.Draw(c)
+			da := p.DataCanvas(c)
+			x := da.Y(test.wantX)
+			y := da.X(test.wantY)
+			var gotX, gotY bool
+			for _, a := range rec.Actions {
+				s, ok := a.(*recorder.Stroke)
+				if !ok || len(s.Path) != 2 {
+					continue
+				}
+				p0, p1 := s.Path[0].Pos, s.Path[1].Pos
+				if closeTo(p0.Y, x) && closeTo(p1.Y, x) && p0.X == da.Min.X && p1.X == da.Max.X {
+					gotX = true
+				}
+				if closeTo(p0.X, y) && closeTo(p1.X, y) && p0.Y == da.Min.Y && p1.Y == da.Max.Y {
+					gotY = true
+				}
+			}
+			if !gotX {
+				t.Errorf("missing X axis line at y=%v", x)
+			}
+			if !gotY {
+				t.Errorf("missing Y axis line at x=%v", y)
+			}
+		})
+	}
+
+	// An axis crossing the other at the start
+	// of its range is drawn along the border.
+	p, err := New()
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	p.X.Min, p.X.Max = -2, 3
+	p.Y.Min, p.Y.Max = 1, 4
+	c := draw.NewCanvas(&recorder.Canvas{}, 200, 200)
+	want := p.DataCanvas(c).Rectangle
+	p.X.Cross = true
+	got := p.DataCanvas(c).Rectangle
+	if got != want {
+		t.Errorf("unexpected data area for an axis crossing below the range: got:%v want:%v", got, want)
+	}
+}
+
+func TestAxisBreaks(t *testing.T) {
+	cmpimg.CheckPlot(func() {
+		p, err := New()
+		if err != nil {
+			t.Fatalf("error: %+v", err)
+		}
+		p.Title.Text = "Broken axes"
+		p.X.Min, p.X.Max = 0, 100
+		p.Y.Min, p.Y.Max = 0, 1000
+		p.X.Breaks = []Break{{Min: 20, Max: 80}}
+		p.Y.Breaks = []Break{{Min: 100, Max: 900}}
+
+		err = p.Save(8*vg.Centimeter, 8*vg.Centimeter, "testdata/axis_breaks.png")
+		if err != nil {
+			t.Fatalf("error: %+v", err)
+		}
+	}, t, "axis_breaks.png")
+
+	for _, test := range []struct {
+		name   string
+		scale  Normalizer
+		breaks []Break
+		x      []float64
+		want   []float64
+	}{
+		{
+			name:   "linear",
+			scale:  LinearScale{},
+			breaks: []Break{{Min: 20, Max: 80}},
+			x:      []float64{0, 10, 20, 50, 80, 90, 100},
+			want:   []float64{0, 0.25, 0.5, 0.5, 0.5, 0.75, 1},
+		},
+		{
+			name:   "reversed break",
+			scale:  LinearScale{},
+			breaks: []Break{{Min: 80, Max: 20}},
+			x:      []float64{10, 50, 90},
+			want:   []float64{0.25, 0.5, 0.75},
+		},
+		{
+			name:   "two breaks",
+			scale:  LinearScale{},
+			breaks: []Break{{Min: 60, Max: 90}, {Min: 10, Max: 40}},
+			x:      []float64{0, 10, 25, 40, 50, 60, 90, 100},
+			want:   []float64{0, 0.25, 0.25, 0.25, 0.5, 0.75, 0.75, 1},
+		},
+		{
+			name:   "outside range",
+			scale:  LinearScale{},
+			breaks: []Break{{Min: -50, Max: 50}},
+			x:      []float64{0, 50, 75, 100},
+			want:   []float64{0, 0, 0.5, 1},
+		},
+		{
+			name:   "inverted",
+			scale:  InvertedScale{LinearScale{}},
+			breaks: []Break{{Min: 20, Max: 80}},
+			x:      []float64{0, 10, 50, 90, 100},
+			want:   []float64{1, 0.75, 0.5, 0.25, 0},
+		},
+		{
+			name:   "log",
+			scale:  LogScale{},
+			breaks: []Break{{Min: 10, Max: 1000}},
+			x:      []float64{1, 10, 100, 1000, 10000},
+			want:   []float64{0, 0.5, 0.5, 0.5, 1},
+		},
+	} {
+		a := Axis{Min: 0, Max: 100, Scale: test.scale, Breaks: test.breaks}
+		if test.name == "log" {
+			a.Min, a.Max = 1, 10000
+		}
+		for i, x := range test.x {
+			got := a.Norm(x)
+			if math.Abs(got-test.want[i]) > 1e-12 {
+				t.Errorf("%s: unexpected normalized value of %v: got:%v want:%v", test.name, x, got, test.want[i])
+			}
+			if a.inBreak(x) {
+				continue
+			}
+			if got := a.Denorm(test.want[i]); math.Abs(got-x) > 1e-9 {
+				t.Errorf("%s: unexpected denormalized value of %v: got:%v want:%v", test.name, test.want[i], got, x)
+			}
+		}
+	}
+
+	a := Axis{Min: 0, Max: 100, Scale: LinearScale{}, Breaks: []Break{{Min: 20, Max: 80}}}
+	a.Tick.Marker = DefaultTicks{}
+	var values []float64
+	for _, tick := range a.Ticks() {
+		if a.inBreak(tick.Value) {
+			t.Errorf("unexpected tick inside break: %v", tick.Value)
+		}
+		if !tick.IsMinor() {
+			values = append(values, tick.Value)
+		}
+	}
+	want := []float64{0, 10, 90, 100}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("unexpected major tick values: got:%v want:%v", values, want)
+	}
+
+	// The axis line is broken at the break, with a pair of slashes.
+	p, err := New()
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	p.X.Min, p.X.Max = 0, 100
+	p.Y.Min, p.Y.Max = 0, 1
+	p.X.Breaks = []Break{{Min: 20, Max: 80}}
+	var rec recorder.Canvas
+	c := draw.NewCanvas(&rec, 200, 200)
+	p.Draw(c)
+	da := p.DataCanvas(c)
+	at := da.X(0.5)
+	l := p.X.Tick.Length
+	var left, right, slashes int
+	for _, a := range rec.Actions {
+		s, ok := a.(*recorder.Stroke)
+		if !ok || len(s.Path) != 2 {
+			continue
+		}
+		p0, p1 := s.Path[0].Pos, s.Path[1].Pos
+		switch {
+		case p0.Y == p1.Y && p0.X == da.Min.X && closeTo(p1.X, at-l/4):
+			left++
+		case p0.Y == p1.Y && closeTo(p0.X, at+l/4) && p1.X == da.Max.X:
+			right++
+		case p0.Y != p1.Y && p0.X != p1.X && p0.X > at-l && p1.X < at+l:
+			slashes++
+		}
+	}
+	if left != 1 || right != 1 || slashes != 2 {
+		t.Errorf("unexpected broken axis line: got %d left, %d right and %d slashes want 1, 1 and 2",
+			left, right, slashes)
+	}
+}
+
+func TestAxisCenterInk(t *testing.T) {
+	labels := []string{"10", "ace", "Éj"}
+	p, err := New()
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	p.X.Min, p.X.Max = 0, 1
+	p.Y.Min, p.Y.Max = 0, 2
+	var ticks ConstantTicks
+	for i, l := range labels {
+		ticks = append(ticks, Tick{Value: float64(i), Label: l})
+	}
+	p.Y.Tick.Marker = ticks
+	p.Y.Tick.CenterInk = true
+
+	var rec recorder.Canvas
+	c := draw.NewCanvas(&rec, 200, 200)
+	p.Draw(c)
+	da := p.DataCanvas(c)
+
+	fnt := p.Y.Tick.Label.Font
+	found := make(map[string]bool)
+	for _, a := range rec.Actions {
+		s, ok := a.(*recorder.FillString)
+		if !ok {
+			continue
+		}
+		for i, l := range labels {
+			if s.String != l {
+				continue
+			}
+			found[l] = true
+			ext := fnt.TextExtents(l)
+			got := s.Point.Y + (ext.Ascent+ext.Descent)/2
+			want := da.Y(p.Y.Norm(float64(i)))
+			if math.Abs(float64(got-want)) > 1e-6 {
+				t.Errorf("unexpected center of the glyphs of label %q: got:%v want:%v", l, got, want)
+			}
+		}
+	}
+	for _, l := range labels {
+		if !found[l] {
+			t.Errorf("missing tick label %q", l)
+		}
+	}
+}
+
+// siLabel formats v with an SI prefix.
+func siLabel(v float64) string {
+	for _, p := range []struct {
+		scale  float64
+		prefix string
+	}{
+		{1e9, "G"}, {1e6, "M"}, {1e3, "k"},
+	} {
+		if math.Abs(v) >= p.scale {
+			return strconv.FormatFloat(v/p.scale, 'g', -1, 64) + p.prefix
+		}
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func TestTickLabelFunc(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	p.X.Min, p.X.Max = 0, 3000
+	p.Y.Min, p.Y.Max = 0, 2000
+	p.Y.Tick.Marker = ConstantTicks{
+		{Value: 0, Label: "zero"},
+		{Value: 500, Label: "a"},
+		{Value: 1500, Label: "b"},
+		{Value: 1750},
+		{Value: 2e6, Label: "c"},
+	}
+	p.X.Tick.LabelFunc = siLabel
+	p.Y.Tick.LabelFunc = siLabel
+
+	got := p.Y.Ticks()
+	want := []Tick{
+		{Value: 0, Label: "0"},
+		{Value: 500, Label: "500"},
+		{Value: 1500, Label: "1.5k"},
+		{Value: 1750},
+		{Value: 2e6, Label: "2M"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected ticks:\ngot: %v\nwant:%v", got, want)
+	}
+	if m := p.Y.Tick.Marker.(ConstantTicks); m[2].Label != "b" {
+		t.Errorf("marker ticks modified: got label %q want %q", m[2].Label, "b")
+	}
+
+	var rec recorder.Canvas
+	p.Draw(draw.NewCanvas(&rec, 200, 200))
+	drawn := make(map[string]bool)
+	for _, a := range rec.Actions {
+		if s, ok := a.(*recorder.FillString); ok {
+			drawn[s.String] = true
+		}
+	}
+	for _, l := range []string{"0", "500", "1.5k", "1k", "2k", "3k"} {
+		if !drawn[l] {
+			t.Errorf("missing tick label %q", l)
+		}
+	}
+	for _, l := range []string{"zero", "a", "b", "1000", "1500"} {
+		if drawn[l] {
+			t.Errorf("unexpected tick label %q", l)
+		}
+	}
+}
+
+func TestTickLabelPadding(t *testing.T) {
+	const pad = 5
+	draws := func(padding vg.Length) (da draw.Canvas, labels map[string]vg.Point) {
+		p, err := New()
+		if err != nil {
+			t.Fatalf("error: %+v", err)
+		}
+		p.X.Min, p.X.Max = 0, 10
+		p.Y.Min, p.Y.Max = 20, 30
+		p.X.Tick.LabelPadding = padding
+		p.Y.Tick.LabelPadding = padding
+
+		var rec recorder.Canvas
+		c := draw.NewCanvas(&rec, 200, 200)
+		p.Draw(c)
+		labels = make(map[string]vg.Point)
+		for _, a := range rec.Actions {
+			if s, ok := a.(*recorder.FillString); ok {
+				labels[s.String] = s.Point
+			}
+		}
+		return p.DataCanvas(c), labels
+	}
+	da0, labels0 := draws(0)
+	da, labels := draws(pad)
+
+	// The axes move away from their tick labels,
+	// which stay at the edges of the plot.
+	if got, want := da.Min.X, da0.Min.X+pad; !closeTo(got, want) {
+		t.Errorf("unexpected left of the data area: got:%v want:%v", got, want)
+	}
+	if got, want := da.Min.Y, da0.Min.Y+pad; !closeTo(got, want) {
+		t.Errorf("unexpected bottom of the data area: got:%v want:%v", got, want)
+	}
+	for _, l := range []string{"0", "20"} {
+		pt0, ok0 := labels0[l]
+		pt, ok := labels[l]
+		if !ok0 || !ok {
+			t.Errorf("missing tick label %q", l)
+			continue
+		}
+		if l == "0" && !closeTo(pt.Y, pt0.Y) {
+			t.Errorf("unexpected height of tick label %q: got:%v want:%v", l, pt.Y, pt0.Y)
+		}
+		if l == "20" && !closeTo(pt.X, pt0.X) {
+			t.Errorf("unexpected position of tick label %q: got:%v want:%v", l, pt.X, pt0.X)
+		}
+	}
+}
+
+func closeTo(a, b vg.Length) bool {
+	return math.Abs(float64(a-b)) < 1e-9
+}
+
+func TestTickDirection(t *testing.T) {
+	const w, h = 200, 200
+	newPlot := func(dir TickDirection) *Plot {
+		p, err := New()
+		if err != nil {
+			t.Fatalf("error: %+v", err)
+		}
+		p.X.Min, p.X.Max = 0, 10
+		p.Y.Min, p.Y.Max = 0, 10
+		p.X.Tick.Direction = dir
+		p.Y.Tick.Direction = dir
+		return p
+	}
+	outward := newPlot(TicksOutward).DataCanvas(draw.NewCanvas(&recorder.Canvas{}, w, h))
+
+	for _, test := range []struct {
+		dir TickDirection
+
+		// out and in are the lengths of the major
+		// ticks outside and inside the axis line.
+		out, in vg.Length
+	}{
+		{dir: TicksOutward, out: 8, in: 0},
+		{dir: TicksInward, out: 0, in: 8},
+		{dir: TicksBoth, out: 4, in: 4},
+	} {
+		p := newPlot(test.dir)
+		length := p.X.Tick.Length
+		if length != 8 {
+			t.Fatalf("unexpected default tick length: %v", length)
+		}
+
+		var rec recorder.Canvas
+		c := draw.NewCanvas(&rec, w, h)
+		p.Draw(c)
+		da := p.DataCanvas(c)
+
+		// The labels move against the axis line by the
+		// length of the ticks that are no longer outside.
+		if got, want := da.Min.Y, outward.Min.Y-(length-test.out); !closeTo(got, want) {
+			t.Errorf("dir=%v: unexpected bottom of the data area: got:%v want:%v", test.dir, got, want)
+		}
+		if got, want := da.Min.X, outward.Min.X-(length-test.out); !closeTo(got, want) {
+			t.Errorf("dir=%v: unexpected left of the data area: got:%v want:%v", test.dir, got, want)
+		}
+
+		// The axis lines are the horizontal and vertical
+		// strokes spanning the padded data area.
+		var (
+			xLine, yLine vg.Length
+			xTicks       [][2]vg.Length
+			yTicks       [][2]vg.Length
+		)
+		var strokes [][2]vg.Point
+		for _, a := range rec.Actions {
+			s, ok := a.(*recorder.Stroke)
+			if !ok || len(s.Path) != 2 {
+				continue
+			}
+			p0, p1 := s.Path[0].Pos, s.Path[1].Pos
+			strokes = append(strokes, [2]vg.Point{p0, p1})
+			switch {
+			case p0.Y == p1.Y && p1.X-p0.X > w/2:
+				xLine = p0.Y
+			case p0.X == p1.X && p1.Y-p0.Y > h/2:
+				yLine = p0.X
+			}
+		}
+		for _, s := range strokes {
+			p0, p1 := s[0], s[1]
+			switch {
+			case p0.X == p1.X && p1.Y-p0.Y <= length:
+				xTicks = append(xTicks, [2]vg.Length{p0.Y - xLine, p1.Y - xLine})
+			case p0.Y == p1.Y && p1.X-p0.X <= length:
+				yTicks = append(yTicks, [2]vg.Length{p0.X - yLine, p1.X - yLine})
+			}
+		}
+		for name, ticks := range map[string][][2]vg.Length{"x": xTicks, "y": yTicks} {
+			var major int
+			for _, tk := range ticks {
+				if tk[1]-tk[0] < length {
+					// Minor ticks are half as long, on the
+					// same sides of the axis line.
+					if !closeTo(tk[0], -test.out/2) || !closeTo(tk[1], test.in/2) {
+						t.Errorf("dir=%v: unexpected %s minor tick span: got:%v want:[%v, %v]",
+							test.dir, name, tk, -test.out/2, test.in/2)
+					}
+					continue
+				}
+				major++
+				if !closeTo(tk[0], -test.out) || !closeTo(tk[1], test.in) {
+					t.Errorf("dir=%v: unexpected %s major tick span: got:%v want:[%v, %v]",
+						test.dir, name, tk, -test.out, test.in)
+				}
+			}
+			if major == 0 {
+				t.Errorf("dir=%v: no %s major ticks", test.dir, name)
+			}
+		}
+	}
+}
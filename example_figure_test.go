@@ -0,0 +1,55 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+func ExampleFigure() {
+	const rows, cols = 2, 2
+	fig := plot.NewFigure(rows, cols)
+	fig.ShareX = true
+	for j := 0; j < rows; j++ {
+		for i := 0; i < cols; i++ {
+			p, err := plot.New()
+			if err != nil {
+				log.Fatalf("could not create plot: %+v", err)
+			}
+			p.Title.Text = fmt.Sprintf("row %d, col %d", j, i)
+
+			// The plots of each column have their own data X ranges,
+			// but are drawn with the same X range.
+			n := 10 * (j + 1)
+			pts := make(plotter.XYs, n+1)
+			for k := range pts {
+				x := float64(k) / float64(n) * math.Pi * float64(j+i+1)
+				pts[k] = plotter.XY{X: x, Y: math.Sin(x)}
+			}
+			line, err := plotter.NewLine(pts)
+			if err != nil {
+				log.Fatalf("could not create line: %+v", err)
+			}
+			p.Add(line)
+
+			fig.Plots[j][i] = p
+		}
+	}
+	fig.Tiles.PadTop = vg.Points(2)
+	fig.Tiles.PadBottom = vg.Points(2)
+	fig.Tiles.PadLeft = vg.Points(2)
+	fig.Tiles.PadRight = vg.Points(2)
+
+	err := fig.Save(15*vg.Centimeter, 12*vg.Centimeter, "testdata/figure.png")
+	if err != nil {
+		log.Fatalf("could not save figure: %+v", err)
+	}
+}
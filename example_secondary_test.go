@@ -0,0 +1,94 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"image/color"
+	"log"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExamplePlot_AddY2 draws the monthly temperature on the
+// left Y axis and the monthly rainfall on the right Y axis.
+func ExamplePlot_AddY2() {
+	temperature := plotter.XYs{
+		{X: 1, Y: 4.1}, {X: 2, Y: 4.6}, {X: 3, Y: 6.8}, {X: 4, Y: 9.2},
+		{X: 5, Y: 12.6}, {X: 6, Y: 15.6}, {X: 7, Y: 17.8}, {X: 8, Y: 17.5},
+		{X: 9, Y: 14.9}, {X: 10, Y: 11.4}, {X: 11, Y: 7.4}, {X: 12, Y: 4.9},
+	}
+	rainfall := plotter.Values{55, 41, 42, 44, 49, 45, 45, 50, 49, 69, 59, 55}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Climate"
+	p.X.Label.Text = "Month"
+	p.Y.Label.Text = "Temperature (°C)"
+	p.Y2.Label.Text = "Rainfall (mm)"
+
+	bars, err := plotter.NewBarChart(rainfall, vg.Points(10))
+	if err != nil {
+		log.Panic(err)
+	}
+	bars.XMin = 1
+	bars.Color = color.NRGBA{B: 255, A: 96}
+	bars.LineStyle.Width = 0
+	p.AddY2(bars)
+
+	line, err := plotter.NewLine(temperature)
+	if err != nil {
+		log.Panic(err)
+	}
+	line.Color = color.RGBA{R: 255, A: 255}
+	line.Width = vg.Points(2)
+	p.Add(line)
+
+	err = p.Save(12*vg.Centimeter, 8*vg.Centimeter, "testdata/secondary_y.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+// ExamplePlot_AddY2_labelPadding draws a line against the
+// left Y axis and its square against the right Y axis, with
+// extra space between the axes and their tick labels.
+func ExamplePlot_AddY2_labelPadding() {
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.X.Label.Text = "x"
+	p.Y.Label.Text = "x"
+	p.Y2.Label.Text = "x²"
+	p.Y.Tick.LabelPadding = vg.Points(6)
+	p.Y2.Tick.LabelPadding = vg.Points(6)
+
+	var lin, sq plotter.XYs
+	for x := 0.0; x <= 10; x++ {
+		lin = append(lin, plotter.XY{X: x, Y: x})
+		sq = append(sq, plotter.XY{X: x, Y: x * x})
+	}
+	l, err := plotter.NewLine(lin)
+	if err != nil {
+		log.Panic(err)
+	}
+	l.Color = color.RGBA{R: 255, A: 255}
+	p.Add(l)
+	s, err := plotter.NewLine(sq)
+	if err != nil {
+		log.Panic(err)
+	}
+	s.Color = color.RGBA{B: 255, A: 255}
+	p.AddY2(s)
+
+	err = p.Save(10*vg.Centimeter, 8*vg.Centimeter, "testdata/secondary_y_padding.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
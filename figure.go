@@ -0,0 +1,148 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"io"
+	"math"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Figure is a grid of plots drawn together on a single
+// canvas, with the data areas of the plots of each row
+// and column aligned by Align.
+type Figure struct {
+	// Plots are the plots of the figure, by rows from
+	// the top and columns from the left. A nil plot
+	// leaves its tile empty.
+	Plots [][]*Plot
+
+	// Tiles specifies the number of rows and columns of
+	// the figure, and the spacing around and between
+	// its tiles.
+	Tiles draw.Tiles
+
+	// ShareX specifies whether the plots of each column
+	// are drawn with the same X range, the union of the
+	// X ranges of the plots of the column.
+	ShareX bool
+
+	// ShareY specifies whether the plots of each row
+	// are drawn with the same Y range, the union of the
+	// Y ranges of the plots of the row.
+	ShareY bool
+}
+
+// NewFigure returns a Figure of the given number of rows
+// and columns of empty tiles, separated by a millimeter.
+func NewFigure(rows, cols int) *Figure {
+	plots := make([][]*Plot, rows)
+	for j := range plots {
+		plots[j] = make([]*Plot, cols)
+	}
+	return &Figure{
+		Plots: plots,
+		Tiles: draw.Tiles{
+			Rows: rows,
+			Cols: cols,
+			PadX: vg.Millimeter,
+			PadY: vg.Millimeter,
+		},
+	}
+}
+
+// Draw draws the plots of the figure to the draw canvas.
+//
+// When the axes are shared, the ranges of the axes of the
+// plots are changed while they are drawn, and restored
+// afterwards.
+func (f *Figure) Draw(dc draw.Canvas) {
+	defer f.share()()
+
+	canvases := Align(f.Plots, f.Tiles, dc)
+	for j, row := range f.Plots {
+		for i, p := range row {
+			if p != nil {
+				p.Draw(canvases[j][i])
+			}
+		}
+	}
+}
+
+// share sets the ranges of the shared axes of the plots,
+// returning a function restoring their ranges.
+func (f *Figure) share() (restore func()) {
+	type axisRange struct {
+		axis     *Axis
+		min, max float64
+	}
+	var saved []axisRange
+	union := func(axes []*Axis) {
+		min, max := math.Inf(1), math.Inf(-1)
+		for _, a := range axes {
+			min = math.Min(min, a.Min)
+			max = math.Max(max, a.Max)
+		}
+		for _, a := range axes {
+			saved = append(saved, axisRange{axis: a, min: a.Min, max: a.Max})
+			a.Min, a.Max = min, max
+		}
+	}
+
+	if f.ShareX {
+		for i := 0; i < f.Tiles.Cols; i++ {
+			var axes []*Axis
+			for _, row := range f.Plots {
+				if i < len(row) && row[i] != nil {
+					axes = append(axes, &row[i].X)
+				}
+			}
+			union(axes)
+		}
+	}
+	if f.ShareY {
+		for _, row := range f.Plots {
+			var axes []*Axis
+			for _, p := range row {
+				if p != nil {
+					axes = append(axes, &p.Y)
+				}
+			}
+			union(axes)
+		}
+	}
+
+	return func() {
+		// Restore in reverse order, so that the axes shared
+		// both ways get back their own ranges.
+		for k := len(saved) - 1; k >= 0; k-- {
+			s := saved[k]
+			s.axis.Min, s.axis.Max = s.min, s.max
+		}
+	}
+}
+
+// WriterTo returns an io.WriterTo that will write the figure
+// as the specified image format.
+//
+// Supported formats are the same as for Plot.WriterTo.
+func (f *Figure) WriterTo(w, h vg.Length, format string) (io.WriterTo, error) {
+	c, err := draw.NewFormattedCanvas(w, h, format)
+	if err != nil {
+		return nil, err
+	}
+	f.Draw(draw.New(c))
+	return c, nil
+}
+
+// Save saves the figure to an image file. The file format
+// is determined by the extension, as for Plot.Save.
+func (f *Figure) Save(w, h vg.Length, file string) error {
+	return save(file, func(format string) (io.WriterTo, error) {
+		return f.WriterTo(w, h, format)
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestFigure(t *testing.T) {
+	cmpimg.CheckPlot(ExampleFigure, t, "figure.png")
+}
+
+// figurePlotter records the data canvas and the X range
+// of the plot it is drawn in.
+type figurePlotter struct {
+	xmin, xmax float64
+
+	c            draw.Canvas
+	axMin, axMax float64
+}
+
+func (p *figurePlotter) Plot(c draw.Canvas, plt *plot.Plot) {
+	p.c = c
+	p.axMin, p.axMax = plt.X.Min, plt.X.Max
+}
+
+func (p *figurePlotter) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return p.xmin, p.xmax, 0, 1
+}
+
+func TestFigureTiles(t *testing.T) {
+	for _, share := range []bool{false, true} {
+		const rows, cols = 2, 2
+		fig := plot.NewFigure(rows, cols)
+		fig.ShareX = share
+		rec := make([][]*figurePlotter, rows)
+		for j := range rec {
+			rec[j] = make([]*figurePlotter, cols)
+			for i := range rec[j] {
+				p, err := plot.New()
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				rec[j][i] = &figurePlotter{xmin: float64(j + i), xmax: float64(10 * (j + 1))}
+				p.Add(rec[j][i])
+				fig.Plots[j][i] = p
+			}
+		}
+
+		dc := draw.NewCanvas(new(recorder.Canvas), 20*vg.Centimeter, 20*vg.Centimeter)
+		fig.Draw(dc)
+
+		for j := range rec {
+			for i, r := range rec[j] {
+				tile := fig.Tiles.At(dc, i, j)
+				if r.c.Min.X < tile.Min.X || r.c.Max.X > tile.Max.X ||
+					r.c.Min.Y < tile.Min.Y || r.c.Max.Y > tile.Max.Y {
+					t.Errorf("share=%v: plot (%d,%d) drawn at %v outside of its tile %v",
+						share, j, i, r.c.Rectangle, tile.Rectangle)
+				}
+
+				wantMin, wantMax := r.xmin, r.xmax
+				if share {
+					wantMin, wantMax = float64(i), float64(10*rows)
+				}
+				if r.axMin != wantMin || r.axMax != wantMax {
+					t.Errorf("share=%v: unexpected X range of plot (%d,%d): got:[%v, %v] want:[%v, %v]",
+						share, j, i, r.axMin, r.axMax, wantMin, wantMax)
+				}
+
+				x := fig.Plots[j][i].X
+				if x.Min != r.xmin || x.Max != r.xmax {
+					t.Errorf("share=%v: X range of plot (%d,%d) not restored: got:[%v, %v] want:[%v, %v]",
+						share, j, i, x.Min, x.Max, r.xmin, r.xmax)
+				}
+			}
+		}
+	}
+}
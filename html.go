@@ -0,0 +1,114 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"bytes"
+	"html/template"
+	"io"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgsvg"
+)
+
+// WriteHTML writes the plot to w as a standalone HTML document,
+// holding the plot as inline SVG of the given width and height.
+// The document has a small script panning the plot when it is
+// dragged with the mouse and zooming it with the mouse wheel.
+// Double clicking the plot restores its initial view.
+//
+// The title of the document is the text of the title of the plot.
+func (p *Plot) WriteHTML(w io.Writer, width, height vg.Length) error {
+	c := vgsvg.New(width, height)
+	p.Draw(draw.New(c))
+
+	var svg bytes.Buffer
+	if _, err := c.WriteTo(&svg); err != nil {
+		return err
+	}
+	// Drop the XML declaration, which is not
+	// allowed in the body of an HTML document.
+	doc := svg.Bytes()
+	if i := bytes.Index(doc, []byte("<svg")); i >= 0 {
+		doc = doc[i:]
+	}
+
+	return htmlTemplate.Execute(w, struct {
+		Title string
+		SVG   template.HTML
+	}{
+		Title: p.Title.Text,
+		SVG:   template.HTML(doc),
+	})
+}
+
+// htmlTemplate is the template of the HTML documents
+// written by WriteHTML.
+var htmlTemplate = template.Must(template.New("html").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+#plot svg { cursor: move; user-select: none; }
+</style>
+</head>
+<body>
+<div id="plot">
+{{.SVG}}</div>
+<script>
+(function() {
+	var svg = document.querySelector("#plot svg");
+	var vb = svg.viewBox.baseVal;
+	var initial = [vb.x, vb.y, vb.width, vb.height];
+
+	// point returns the position of the mouse event e
+	// in the coordinates of the view box.
+	function point(e) {
+		var r = svg.getBoundingClientRect();
+		return {
+			x: vb.x + (e.clientX - r.left) / r.width * vb.width,
+			y: vb.y + (e.clientY - r.top) / r.height * vb.height
+		};
+	}
+
+	svg.addEventListener("wheel", function(e) {
+		e.preventDefault();
+		var p = point(e);
+		var s = Math.pow(1.002, e.deltaY);
+		vb.x = p.x - (p.x - vb.x) * s;
+		vb.y = p.y - (p.y - vb.y) * s;
+		vb.width *= s;
+		vb.height *= s;
+	});
+
+	var drag = null;
+	svg.addEventListener("mousedown", function(e) {
+		drag = point(e);
+	});
+	window.addEventListener("mousemove", function(e) {
+		if (drag === null) {
+			return;
+		}
+		var p = point(e);
+		vb.x -= p.x - drag.x;
+		vb.y -= p.y - drag.y;
+	});
+	window.addEventListener("mouseup", function() {
+		drag = null;
+	});
+
+	svg.addEventListener("dblclick", function() {
+		vb.x = initial[0];
+		vb.y = initial[1];
+		vb.width = initial[2];
+		vb.height = initial[3];
+	});
+})();
+</script>
+</body>
+</html>
+`))
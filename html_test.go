@@ -0,0 +1,58 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+func TestWriteHTML(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Title.Text = "Sales & returns"
+	line, err := plotter.NewLine(plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 2}, {X: 2, Y: 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Add(line)
+
+	var buf bytes.Buffer
+	err = p.WriteHTML(&buf, 10*vg.Centimeter, 8*vg.Centimeter)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	doc := buf.String()
+
+	for _, want := range []string{
+		"<!DOCTYPE html>",
+		"<title>Sales &amp; returns</title>",
+		`<svg width="283.46pt" height="226.77pt" viewBox="0 0 283.46 226.77"`,
+		"<path",
+		"</svg>",
+		"<script>",
+		`svg.addEventListener("wheel"`,
+		"</script>",
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("HTML document does not contain %q", want)
+		}
+	}
+	if strings.Contains(doc, "<?xml") {
+		t.Error("HTML document contains an XML declaration")
+	}
+	svg := strings.Index(doc, "<svg")
+	script := strings.Index(doc, "<script>")
+	if !(strings.Index(doc, "<body>") < svg && svg < strings.Index(doc, "</svg>") && strings.Index(doc, "</svg>") < script) {
+		t.Error("inline SVG not before the script in the body of the document")
+	}
+}
@@ -5,7 +5,7 @@
 package plot
 
 import (
-	"math"
+	"sort"
 
 	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
@@ -36,6 +36,11 @@ type Legend struct {
 	// positioned before the icons.
 	Top, Left bool
 
+	// Placement specifies whether the legend is
+	// drawn over the data area of the plot, the
+	// default, or outside of it.
+	Placement LegendPlacement
+
 	// XOffs and YOffs are added to the legend's
 	// final position.
 	XOffs, YOffs vg.Length
@@ -48,11 +53,44 @@ type Legend struct {
 	// ThumbnailWidth is the width of legend thumbnails.
 	ThumbnailWidth vg.Length
 
+	// Columns is the number of columns of the legend.
+	// The entries fill the first column before the next
+	// ones, and the last column may have fewer entries.
+	// If Columns is less than 2, the legend has a single
+	// column.
+	Columns int
+
+	// ColumnPadding is the amount of padding
+	// between the columns of the legend.
+	ColumnPadding vg.Length
+
 	// entries are all of the legendEntries described
 	// by this legend.
 	entries []legendEntry
 }
 
+// LegendPlacement specifies where a legend is drawn
+// relative to the data area of a plot.
+type LegendPlacement int
+
+const (
+	// LegendInside draws the legend over the data area,
+	// along the edges given by Top and Left.
+	LegendInside LegendPlacement = iota
+
+	// LegendRight draws the legend to the right of the
+	// data area and of its axes, along the top or the
+	// bottom of the data area according to Top. The data
+	// area is narrowed to make room for the legend.
+	LegendRight
+
+	// LegendBottom draws the legend below the X axis,
+	// along the left or the right of the data area
+	// according to Left. The data area is shortened to
+	// make room for the legend.
+	LegendBottom
+)
+
 // A legendEntry represents a single line of a legend, it
 // has a name and an icon.
 type legendEntry struct {
@@ -84,6 +122,7 @@ func NewLegend() (Legend, error) {
 	return Legend{
 		YPosition:      draw.PosBottom,
 		ThumbnailWidth: vg.Points(20),
+		ColumnPadding:  vg.Points(10),
 		TextStyle: draw.TextStyle{
 			Font:    font,
 			Handler: DefaultTextHandler,
@@ -93,70 +132,80 @@ func NewLegend() (Legend, error) {
 
 // Draw draws the legend to the given draw.Canvas.
 func (l *Legend) Draw(c draw.Canvas) {
-	iconx := c.Min.X
+	if len(l.entries) == 0 {
+		return
+	}
+	if l.YPosition < draw.PosBottom || draw.PosTop < l.YPosition {
+		panic("plot: invalid vertical offset for the legend's entries")
+	}
+
 	sty := l.TextStyle
 	em := sty.Rectangle(" ")
-	textx := iconx + l.ThumbnailWidth + em.Max.X
 	if !l.Left {
-		iconx = c.Max.X - l.ThumbnailWidth
-		textx = iconx - em.Max.X
 		sty.XAlign--
 	}
-	textx += l.XOffs
-	iconx += l.XOffs
+	yoff := vg.Length(l.YPosition-draw.PosBottom) / 2
+	yoff *= -sty.Font.Extents().Descent
 
+	rows, widths := l.layout()
 	enth := l.entryHeight()
-	y := c.Max.Y - enth
-	if !l.Top {
-		y = c.Min.Y + (enth+l.Padding)*(vg.Length(len(l.entries))-1)
-	}
-	y += l.YOffs
 
-	icon := &draw.Canvas{
-		Canvas: c.Canvas,
-		Rectangle: vg.Rectangle{
-			Min: vg.Point{X: iconx, Y: y},
-			Max: vg.Point{X: iconx + l.ThumbnailWidth, Y: y + enth},
-		},
+	// edges are the sides of the columns on
+	// which their entries are aligned.
+	edges := make([]vg.Length, len(widths))
+	if l.Left {
+		x := c.Min.X
+		for i, w := range widths {
+			edges[i] = x
+			x += w + l.ColumnPadding
+		}
+	} else {
+		x := c.Max.X
+		for i := len(widths) - 1; i >= 0; i-- {
+			edges[i] = x
+			x -= widths[i] + l.ColumnPadding
+		}
 	}
 
-	if l.YPosition < draw.PosBottom || draw.PosTop < l.YPosition {
-		panic("plot: invalid vertical offset for the legend's entries")
+	top := c.Max.Y - enth
+	if !l.Top {
+		top = c.Min.Y + (enth+l.Padding)*vg.Length(rows-1)
 	}
-	yoff := vg.Length(l.YPosition-draw.PosBottom) / 2
-	yoff *= -sty.Font.Extents().Descent
-
-	for _, e := range l.entries {
+	for i, e := range l.entries {
+		col, row := i/rows, i%rows
+		iconx := edges[col] + l.XOffs
+		textx := iconx + l.ThumbnailWidth + em.Max.X
+		if !l.Left {
+			iconx -= l.ThumbnailWidth
+			textx = iconx - em.Max.X
+		}
+		y := top - vg.Length(row)*(enth+l.Padding) + l.YOffs
+		icon := &draw.Canvas{
+			Canvas: c.Canvas,
+			Rectangle: vg.Rectangle{
+				Min: vg.Point{X: iconx, Y: y},
+				Max: vg.Point{X: iconx + l.ThumbnailWidth, Y: y + enth},
+			},
+		}
 		for _, t := range e.thumbs {
 			t.Thumbnail(icon)
 		}
 		yoffs := (enth - sty.Rectangle(e.text).Max.Y) / 2
 		yoffs += yoff
-		c.FillText(sty, vg.Point{X: textx, Y: icon.Min.Y + yoffs}, e.text)
-		icon.Min.Y -= enth + l.Padding
-		icon.Max.Y -= enth + l.Padding
+		c.FillText(sty, vg.Point{X: textx, Y: y + yoffs}, e.text)
 	}
 }
 
 // Rectangle returns the extent of the Legend.
 func (l *Legend) Rectangle(c draw.Canvas) vg.Rectangle {
-	var width, height vg.Length
-	sty := l.TextStyle
-	entryHeight := l.entryHeight()
-	for i, e := range l.entries {
-		width = vg.Length(math.Max(float64(width), float64(l.ThumbnailWidth+sty.Rectangle(" "+e.text).Max.X)))
-		height += entryHeight
-		if i != 0 {
-			height += l.Padding
-		}
-	}
+	width, height := l.size()
 	var r vg.Rectangle
 	if l.Left {
-		r.Max.X = c.Max.X
-		r.Min.X = c.Max.X - width
-	} else {
-		r.Max.X = c.Min.X + width
 		r.Min.X = c.Min.X
+		r.Max.X = c.Min.X + width
+	} else {
+		r.Min.X = c.Max.X - width
+		r.Max.X = c.Max.X
 	}
 	if l.Top {
 		r.Max.Y = c.Max.Y
@@ -168,6 +217,71 @@ func (l *Legend) Rectangle(c draw.Canvas) vg.Rectangle {
 	return r
 }
 
+// size returns the width and the height of the legend.
+func (l *Legend) size() (width, height vg.Length) {
+	if len(l.entries) == 0 {
+		return 0, 0
+	}
+	rows, widths := l.layout()
+	for i, w := range widths {
+		width += w
+		if i != 0 {
+			width += l.ColumnPadding
+		}
+	}
+	height = vg.Length(rows)*l.entryHeight() + vg.Length(rows-1)*l.Padding
+	return width, height
+}
+
+// outside returns the canvas c without the room taken by
+// a legend placed outside of the data area, and the strip
+// of c along which the legend is drawn. The legend is
+// separated from the rest of c by the width of a space
+// in its TextStyle. The canvas c is returned unchanged if
+// the legend is drawn inside the data area or if it has no
+// entries.
+func (l *Legend) outside(c draw.Canvas) (rest, strip draw.Canvas) {
+	rest, strip = c, c
+	if l.Placement == LegendInside || len(l.entries) == 0 {
+		return rest, strip
+	}
+	width, height := l.size()
+	gap := l.TextStyle.Rectangle(" ").Max.X
+	switch l.Placement {
+	case LegendRight:
+		strip.Min.X = c.Max.X - width
+		rest.Max.X = strip.Min.X - gap
+	case LegendBottom:
+		strip.Max.Y = c.Min.Y + height
+		rest.Min.Y = strip.Max.Y + gap
+	default:
+		panic("plot: invalid legend placement")
+	}
+	return rest, strip
+}
+
+// layout returns the number of rows of the legend and the
+// widths of its columns. The legend must have entries.
+func (l *Legend) layout() (rows int, widths []vg.Length) {
+	n := len(l.entries)
+	cols := l.Columns
+	if cols < 1 {
+		cols = 1
+	}
+	if cols > n {
+		cols = n
+	}
+	rows = (n + cols - 1) / cols
+	widths = make([]vg.Length, (n+rows-1)/rows)
+	for i, e := range l.entries {
+		w := l.ThumbnailWidth + l.TextStyle.Rectangle(" "+e.text).Max.X
+		if col := i / rows; w > widths[col] {
+			widths[col] = w
+		}
+	}
+	return rows, widths
+}
+
 // entryHeight returns the height of the tallest legend
 // entry text.
 func (l *Legend) entryHeight() (height vg.Length) {
@@ -185,3 +299,13 @@ func (l *Legend) entryHeight() (height vg.Length) {
 func (l *Legend) Add(name string, thumbs ...Thumbnailer) {
 	l.entries = append(l.entries, legendEntry{text: name, thumbs: thumbs})
 }
+
+// Sort sorts the entries of the legend by their names
+// according to less. The sort is stable, so entries
+// with equivalent names keep the order in which they
+// were added.
+func (l *Legend) Sort(less func(a, b string) bool) {
+	sort.SliceStable(l.entries, func(i, j int) bool {
+		return less(l.entries[i].text, l.entries[j].text)
+	})
+}
@@ -5,11 +5,156 @@
 package plot_test
 
 import (
+	"math"
+	"strings"
 	"testing"
 
+	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 func TestLegend_standalone(t *testing.T) {
 	cmpimg.CheckPlot(ExampleLegend_standalone, t, "legend_standalone.png")
 }
+
+// rectThumbnailer records the canvas rectangles
+// it is asked to draw thumbnails in.
+type rectThumbnailer struct {
+	rects *[]vg.Rectangle
+}
+
+func (t rectThumbnailer) Thumbnail(c *draw.Canvas) {
+	*t.rects = append(*t.rects, c.Rectangle)
+}
+
+func TestLegendColumns(t *testing.T) {
+	l, err := plot.NewLegend()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var icons []vg.Rectangle
+	thumb := rectThumbnailer{rects: &icons}
+	// Digits have the same width, so the columns are equally wide.
+	names := []string{"7", "5", "1", "6", "3", "4", "2"}
+	for _, name := range names {
+		l.Add(name, thumb)
+	}
+	l.Columns = 3
+	l.Left = true
+	l.Top = true
+	l.Padding = 2
+	l.ColumnPadding = 5
+	l.Sort(func(a, b string) bool { return a < b })
+
+	c := draw.NewCanvas(&recorder.Canvas{}, 200, 100)
+	r := l.Rectangle(c)
+
+	// Seven entries in three columns give three rows,
+	// with the last column holding a single entry.
+	h := l.TextStyle.Rectangle("1").Max.Y
+	w := l.ThumbnailWidth + l.TextStyle.Rectangle(" 1").Max.X
+	want := vg.Rectangle{
+		Min: vg.Point{X: 0, Y: 100 - (3*h + 2*l.Padding)},
+		Max: vg.Point{X: 3*w + 2*l.ColumnPadding, Y: 100},
+	}
+	if !closeRect(r, want) {
+		t.Errorf("unexpected legend rectangle: got:%v want:%v", r, want)
+	}
+
+	l.Draw(c)
+	if len(icons) != len(names) {
+		t.Fatalf("unexpected number of thumbnails: got:%d want:%d", len(icons), len(names))
+	}
+	var texts []string
+	for _, a := range c.Canvas.(*recorder.Canvas).Actions {
+		if a, ok := a.(*recorder.FillString); ok {
+			texts = append(texts, a.String)
+		}
+	}
+	if got, want := strings.Join(texts, ""), "1234567"; got != want {
+		t.Errorf("unexpected entry order: got:%q want:%q", got, want)
+	}
+	for i, icon := range icons {
+		col, row := i/3, i%3
+		want := vg.Point{
+			X: vg.Length(col) * (w + l.ColumnPadding),
+			Y: 100 - h - vg.Length(row)*(h+l.Padding),
+		}
+		if !closePoint(icon.Min, want) {
+			t.Errorf("unexpected position of thumbnail %d: got:%v want:%v", i, icon.Min, want)
+		}
+	}
+}
+
+func closePoint(a, b vg.Point) bool {
+	const tol = 1e-9
+	return math.Abs(float64(a.X-b.X)) < tol && math.Abs(float64(a.Y-b.Y)) < tol
+}
+
+func closeRect(a, b vg.Rectangle) bool {
+	return closePoint(a.Min, b.Min) && closePoint(a.Max, b.Max)
+}
+
+// canvasPlotter records the data canvas it is drawn in.
+type canvasPlotter struct {
+	rect *vg.Rectangle
+}
+
+func (p canvasPlotter) Plot(c draw.Canvas, _ *plot.Plot) {
+	*p.rect = c.Rectangle
+}
+
+func TestLegendPlacement(t *testing.T) {
+	const w, h = 300, 200
+	draws := func(placement plot.LegendPlacement) (da vg.Rectangle, legend vg.Rectangle, icons []vg.Rectangle) {
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		p.X.Min, p.X.Max = 0, 10
+		p.Y.Min, p.Y.Max = 0, 10
+		p.Legend.Placement = placement
+		p.Legend.Top = true
+		p.Legend.Add("first series", rectThumbnailer{rects: &icons})
+		p.Legend.Add("second", rectThumbnailer{rects: &icons})
+		p.Add(canvasPlotter{rect: &da})
+
+		c := draw.NewCanvas(&recorder.Canvas{}, w, h)
+		p.Draw(c)
+		if got := p.DataCanvas(c).Rectangle; !closeRect(got, da) {
+			t.Errorf("placement=%d: data canvas differs from drawn data area: got:%v want:%v", placement, got, da)
+		}
+		return da, p.Legend.Rectangle(c), icons
+	}
+
+	inside, legend, _ := draws(plot.LegendInside)
+
+	right, _, icons := draws(plot.LegendRight)
+	if !closeRect(vg.Rectangle{Min: right.Min, Max: vg.Point{X: inside.Max.X, Y: right.Max.Y}}, inside) {
+		t.Errorf("right: unexpected change of data area: got:%v inside:%v", right, inside)
+	}
+	if shrink, width := inside.Max.X-right.Max.X, legend.Max.X-legend.Min.X; shrink <= width {
+		t.Errorf("right: data area not narrowed by the legend width: got:%v want > %v", shrink, width)
+	}
+	for i, icon := range icons {
+		if icon.Min.X <= right.Max.X || icon.Max.X > w {
+			t.Errorf("right: thumbnail %d not right of data area %v: %v", i, right, icon)
+		}
+		if icon.Max.Y > right.Max.Y+1e-9 {
+			t.Errorf("right: thumbnail %d above data area %v: %v", i, right, icon)
+		}
+	}
+
+	bottom, _, icons := draws(plot.LegendBottom)
+	if bottom.Min.Y <= inside.Min.Y || bottom.Max.Y != inside.Max.Y || bottom.Min.X != inside.Min.X || bottom.Max.X != inside.Max.X {
+		t.Errorf("bottom: unexpected data area: got:%v inside:%v", bottom, inside)
+	}
+	for i, icon := range icons {
+		if icon.Min.Y < 0 || icon.Max.Y >= bottom.Min.Y {
+			t.Errorf("bottom: thumbnail %d not below data area %v: %v", i, bottom, icon)
+		}
+	}
+}
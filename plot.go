@@ -5,16 +5,20 @@
 package plot
 
 import (
+	"errors"
+	"image"
 	"image/color"
 	"io"
 	"math"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"gonum.org/v1/plot/text"
 	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgpdf"
 )
 
 var (
@@ -27,6 +31,9 @@ var (
 
 // Plot is the basic type representing a plot.
 type Plot struct {
+	// Title is the title of the plot, drawn at the top.
+	// It is aligned with the left edge, the center or the
+	// right edge of the plot according to its XAlign.
 	Title struct {
 		// Text is the text of the plot title.  If
 		// Text is the empty string then the plot
@@ -41,20 +48,118 @@ type Plot struct {
 		draw.TextStyle
 	}
 
+	// Subtitle is the subtitle of the plot, drawn
+	// beneath the title and aligned as the title is.
+	Subtitle struct {
+		// Text is the text of the subtitle. If
+		// Text is the empty string then the plot
+		// will not have a subtitle.
+		Text string
+
+		// Padding is the amount of padding
+		// between the bottom of the subtitle
+		// and the top of the plot.
+		Padding vg.Length
+
+		draw.TextStyle
+	}
+
 	// BackgroundColor is the background color of the plot.
 	// The default is White.
 	BackgroundColor color.Color
 
+	// backgroundImage is the image drawn behind the
+	// plotters, set by SetBackgroundImage.
+	backgroundImage image.Image
+
 	// X and Y are the horizontal and vertical axes
 	// of the plot respectively.
 	X, Y Axis
 
+	// Y2 is the secondary vertical axis of the plot,
+	// drawn on the right side. It is only drawn if
+	// plotters have been added to it with AddY2.
+	// The X axis is shared with the primary Y axis.
+	Y2 Axis
+
 	// Legend is the plot's legend.
 	Legend Legend
 
+	// ClipPlotters specifies whether the drawing of the
+	// plotters is clipped to the data area of the plot,
+	// between the axes. The clipped area includes the
+	// padding that keeps the glyphs of the plotters from
+	// being clipped. Plotters drawing annotations outside
+	// the data area should not be clipped, so ClipPlotters
+	// is false by default. Drawing is only clipped if the
+	// canvas is a vg.Clipper.
+	ClipPlotters bool
+
+	// Margins are the sizes of the margins between the
+	// edges of the canvas of the plot and its data area,
+	// which hold the title, the axes and the padding
+	// keeping the glyphs of the plotters from being
+	// clipped. By default, the margins are sized to fit
+	// their contents, so that the data areas of plots
+	// with labels of different lengths differ. Plots
+	// drawn with the same margins on canvases of the
+	// same size have the same data areas.
+	Margins Margins
+
 	// plotters are drawn by calling their Plot method
 	// after the axes are drawn.
 	plotters []Plotter
+
+	// y2plotters are drawn against the secondary
+	// Y axis after the plotters.
+	y2plotters []Plotter
+}
+
+// Margins specifies the margins between the edges of the
+// canvas of a plot and its data area. A zero margin is
+// sized to fit its contents.
+type Margins struct {
+	// Left, Right, Bottom and Top are the sizes of
+	// the margins on each side of the data area.
+	Left, Right, Bottom, Top vg.Length
+
+	// Min specifies whether the margins are minimum
+	// sizes of margins sized to fit their contents,
+	// instead of fixed sizes. Fixed margins do not
+	// make room for axes or glyphs that do not fit
+	// in them.
+	Min bool
+}
+
+// isZero returns whether all the margins are sized
+// to fit their contents.
+func (m Margins) isZero() bool {
+	return m.Left == 0 && m.Right == 0 && m.Bottom == 0 && m.Top == 0
+}
+
+// crop returns the data area dc of a plot drawn on the
+// canvas c, sized to fit the contents of the margins,
+// with its margins set by m.
+func (m Margins) crop(c, dc draw.Canvas) draw.Canvas {
+	side := func(margin, fit vg.Length) vg.Length {
+		switch {
+		case margin == 0:
+			return fit
+		case m.Min && fit > margin:
+			return fit
+		default:
+			return margin
+		}
+	}
+	left := side(m.Left, dc.Min.X-c.Min.X)
+	right := side(m.Right, c.Max.X-dc.Max.X)
+	bottom := side(m.Bottom, dc.Min.Y-c.Min.Y)
+	top := side(m.Top, c.Max.Y-dc.Max.Y)
+	dc.Rectangle = vg.Rectangle{
+		Min: vg.Point{X: c.Min.X + left, Y: c.Min.Y + bottom},
+		Max: vg.Point{X: c.Max.X - right, Y: c.Max.Y - top},
+	}
+	return dc
 }
 
 // Plotter is an interface that wraps the Plot method.
@@ -88,6 +193,10 @@ func New() (*Plot, error) {
 	if err != nil {
 		return nil, err
 	}
+	subtitleFont, err := vg.MakeFont(DefaultFont, 10)
+	if err != nil {
+		return nil, err
+	}
 	x, err := makeAxis(horizontal)
 	if err != nil {
 		return nil, err
@@ -96,6 +205,11 @@ func New() (*Plot, error) {
 	if err != nil {
 		return nil, err
 	}
+	y2, err := makeAxis(vertical)
+	if err != nil {
+		return nil, err
+	}
+	y2.Tick.Label.XAlign = draw.XLeft
 	legend, err := NewLegend()
 	if err != nil {
 		return nil, err
@@ -104,6 +218,7 @@ func New() (*Plot, error) {
 		BackgroundColor: color.White,
 		X:               x,
 		Y:               y,
+		Y2:              y2,
 		Legend:          legend,
 	}
 	p.Title.TextStyle = draw.TextStyle{
@@ -113,6 +228,13 @@ func New() (*Plot, error) {
 		YAlign:  draw.YTop,
 		Handler: DefaultTextHandler,
 	}
+	p.Subtitle.TextStyle = draw.TextStyle{
+		Color:   color.Black,
+		Font:    subtitleFont,
+		XAlign:  draw.XCenter,
+		YAlign:  draw.YTop,
+		Handler: DefaultTextHandler,
+	}
 	return p, nil
 }
 
@@ -121,14 +243,17 @@ func New() (*Plot, error) {
 // If the plotters implements DataRanger then the
 // minimum and maximum values of the X and Y
 // axes are changed if necessary to fit the range of
-// the data.
+// the data. The non-positive values of the data are
+// ignored along an axis that has a log scale when the
+// plotters are added.
 //
 // When drawing the plot, Plotters are drawn in the
-// order in which they were added to the plot.
+// order in which they were added to the plot, unless
+// they are reordered with SortPlotters.
 func (p *Plot) Add(ps ...Plotter) {
 	for _, d := range ps {
 		if x, ok := d.(DataRanger); ok {
-			xmin, xmax, ymin, ymax := x.DataRange()
+			xmin, xmax, ymin, ymax := dataRange(x, &p.X, &p.Y)
 			p.X.Min = math.Min(p.X.Min, xmin)
 			p.X.Max = math.Max(p.X.Max, xmax)
 			p.Y.Min = math.Min(p.Y.Min, ymin)
@@ -139,57 +264,283 @@ func (p *Plot) Add(ps ...Plotter) {
 	p.plotters = append(p.plotters, ps...)
 }
 
+// AddY2 adds Plotters to the plot, as Add does, except
+// that their Y values are represented by the secondary
+// Y axis, Y2, and its range is changed to fit their data.
+//
+// Plotters added with AddY2 are drawn after all the
+// plotters added with Add, in the order in which they
+// were added.
+func (p *Plot) AddY2(ps ...Plotter) {
+	for _, d := range ps {
+		if x, ok := d.(DataRanger); ok {
+			xmin, xmax, ymin, ymax := dataRange(x, &p.X, &p.Y2)
+			p.X.Min = math.Min(p.X.Min, xmin)
+			p.X.Max = math.Max(p.X.Max, xmax)
+			p.Y2.Min = math.Min(p.Y2.Min, ymin)
+			p.Y2.Max = math.Max(p.Y2.Max, ymax)
+		}
+	}
+
+	p.y2plotters = append(p.y2plotters, ps...)
+}
+
+// xyer is implemented by plotters holding their data as
+// points, such as those embedding a plotter.XYs.
+type xyer interface {
+	Len() int
+	XY(int) (x, y float64)
+}
+
+// dataRange returns the data range of d along the axes x and y.
+// The non-positive values are left out of the range along an
+// axis with a log scale. The smallest positive value is then
+// found among the points of d, if it holds points, and the axis
+// is otherwise left to the range of the other plotters.
+func dataRange(d DataRanger, x, y *Axis) (xmin, xmax, ymin, ymax float64) {
+	xmin, xmax, ymin, ymax = d.DataRange()
+	logX := isLogScale(x.Scale) && xmin <= 0
+	logY := isLogScale(y.Scale) && ymin <= 0
+	if !logX && !logY {
+		return xmin, xmax, ymin, ymax
+	}
+
+	pxmin, pymin := math.Inf(1), math.Inf(1)
+	if pts, ok := d.(xyer); ok {
+		for i := 0; i < pts.Len(); i++ {
+			px, py := pts.XY(i)
+			if px > 0 {
+				pxmin = math.Min(pxmin, px)
+			}
+			if py > 0 {
+				pymin = math.Min(pymin, py)
+			}
+		}
+	}
+	if logX {
+		xmin = pxmin
+		if xmax <= 0 {
+			xmin, xmax = math.Inf(1), math.Inf(-1)
+		}
+	}
+	if logY {
+		ymin = pymin
+		if ymax <= 0 {
+			ymin, ymax = math.Inf(1), math.Inf(-1)
+		}
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// SortPlotters sorts the plotters of the plot with the given
+// less function, changing the order in which they are drawn.
+// The sort is stable, so that plotters that are neither less
+// than the other keep the order in which they were added. For
+// example, to draw filled regions behind lines, regardless of
+// the order in which they were added, use a less function
+// reporting whether a is a fill and b is not.
+//
+// The plotters added with Add and those added with AddY2 are
+// sorted separately, and those added with AddY2 are still drawn
+// last. The order of the entries of the legend is not changed.
+func (p *Plot) SortPlotters(less func(a, b Plotter) bool) {
+	for _, ps := range [][]Plotter{p.plotters, p.y2plotters} {
+		sort.SliceStable(ps, func(i, j int) bool {
+			return less(ps[i], ps[j])
+		})
+	}
+}
+
+// hasY2 returns whether the secondary Y axis is in use.
+func (p *Plot) hasY2() bool { return len(p.y2plotters) != 0 }
+
+// secondary returns a shallow copy of the plot whose Y axis
+// is the secondary Y axis, and whose plotters are the plotters
+// added with AddY2.
+func (p *Plot) secondary() *Plot {
+	sp := *p
+	sp.Y = p.Y2
+	sp.plotters = p.y2plotters
+	sp.y2plotters = nil
+	return &sp
+}
+
+// y2size returns the width of the secondary Y axis,
+// or zero if it is not in use.
+func (p *Plot) y2size() vg.Length {
+	if !p.hasY2() {
+		return 0
+	}
+	p.Y2.sanitizeRange()
+	return rightAxis{verticalAxis{p.Y2}}.size()
+}
+
+// SetBackgroundImage sets an image to be drawn behind the
+// plotters, such as a map or a reference diagram that the
+// data are overlaid on. The image is scaled to fill the data
+// area of the plot and is drawn over the BackgroundColor,
+// before the axes and plotters. A nil image removes the
+// background image.
+func (p *Plot) SetBackgroundImage(img image.Image) {
+	p.backgroundImage = img
+}
+
 // Draw draws a plot to a draw.Canvas.
 //
 // Plotters are drawn in the order in which they were
-// added to the plot.  Plotters that  implement the
-// GlyphBoxer interface will have their GlyphBoxes
-// taken into account when padding the plot so that
-// none of their glyphs are clipped.
+// added to the plot, unless reordered by SortPlotters.
+// Plotters that implement the GlyphBoxer interface will
+// have their GlyphBoxes taken into account when padding
+// the plot so that none of their glyphs are clipped.
 func (p *Plot) Draw(c draw.Canvas) {
 	if p.BackgroundColor != nil {
 		c.SetColor(p.BackgroundColor)
 		c.Fill(c.Rectangle.Path())
 	}
+	full := c
 	if p.Title.Text != "" {
-		c.FillText(p.Title.TextStyle, vg.Point{X: c.Center().X, Y: c.Max.Y}, p.Title.Text)
-		_, h, d := p.Title.Handler.Box(p.Title.Text, p.Title.Font)
-		c.Max.Y -= h + d
-		c.Max.Y -= p.Title.Padding
+		drawHeading(&c, p.Title.Text, p.Title.TextStyle, p.Title.Padding)
 	}
+	if p.Subtitle.Text != "" {
+		drawHeading(&c, p.Subtitle.Text, p.Subtitle.TextStyle, p.Subtitle.Padding)
+	}
+	c, legendC := p.Legend.outside(c)
 
 	p.X.sanitizeRange()
 	x := horizontalAxis{p.X}
 	p.Y.sanitizeRange()
 	y := verticalAxis{p.Y}
 
-	ywidth := y.size()
-
-	xheight := x.size()
-	x.draw(padX(p, draw.Crop(c, ywidth, 0, 0, 0)))
-	y.draw(padY(p, draw.Crop(c, 0, 0, xheight, 0)))
+	xn, xcross := p.X.crossing(&p.Y)
+	yn, ycross := p.Y.crossing(&p.X)
+	xheight, ywidth := p.axisSizes()
+	y2width := p.y2size()
 
-	dataC := padY(p, padX(p, draw.Crop(c, ywidth, 0, xheight, 0)))
+	area := draw.Crop(c, ywidth, -y2width, xheight, 0)
+	dataC := padY(p, padX(p, area))
+	if !p.Margins.isZero() {
+		dataC = p.Margins.crop(full, dataC)
+		area = dataC
+	}
+	if p.backgroundImage != nil {
+		dataC.DrawImage(dataC.Rectangle, p.backgroundImage)
+	}
+	if p.Margins.isZero() {
+		if !xcross {
+			x.draw(padX(p, draw.Crop(c, ywidth, -y2width, 0, 0)))
+		}
+		if !ycross {
+			y.draw(padY(p, draw.Crop(c, 0, 0, xheight, 0)))
+		}
+		if p.hasY2() {
+			y2 := rightAxis{verticalAxis{p.Y2}}
+			y2.draw(padY(p, draw.Crop(c, c.Size().X-y2width, 0, xheight, 0)))
+		}
+	} else {
+		// The axes are drawn against the data area
+		// set by the margins, as crossing axes are.
+		if !xcross {
+			ac := dataC
+			ac.Min.Y = dataC.Min.Y - xheight
+			x.draw(ac)
+		}
+		if !ycross {
+			ac := dataC
+			ac.Min.X = dataC.Min.X - ywidth
+			y.draw(ac)
+		}
+		if p.hasY2() {
+			ac := dataC
+			ac.Min.X = dataC.Max.X
+			ac.Max.X = dataC.Max.X + y2width
+			rightAxis{verticalAxis{p.Y2}}.draw(ac)
+		}
+	}
+	if xcross {
+		ac := dataC
+		ac.Min.Y = dataC.Y(xn) - x.lineOffset()
+		x.draw(ac)
+	}
+	if ycross {
+		ac := dataC
+		ac.Min.X = dataC.X(yn) - y.lineOffset()
+		y.draw(ac)
+	}
+	if p.ClipPlotters {
+		c.Push()
+		c.Clip(area.Rectangle.Path())
+	}
 	for _, data := range p.plotters {
 		data.Plot(dataC, p)
 	}
+	if p.hasY2() {
+		sp := p.secondary()
+		for _, data := range sp.plotters {
+			data.Plot(dataC, sp)
+		}
+	}
+	if p.ClipPlotters {
+		c.Pop()
+	}
 
-	p.Legend.Draw(draw.Crop(c, ywidth, 0, xheight, 0))
+	switch p.Legend.Placement {
+	case LegendRight:
+		legendC.Min.Y, legendC.Max.Y = dataC.Min.Y, dataC.Max.Y
+	case LegendBottom:
+		legendC.Min.X, legendC.Max.X = dataC.Min.X, dataC.Max.X
+	default:
+		legendC = area
+	}
+	p.Legend.Draw(legendC)
+}
+
+// drawHeading draws the text of a title or a subtitle at the
+// top of c, aligned horizontally according to the XAlign of
+// the style, and removes the height of the text and the
+// padding beneath it from the top of c.
+func drawHeading(c *draw.Canvas, txt string, sty draw.TextStyle, pad vg.Length) {
+	x := c.Min.X - vg.Length(sty.XAlign)*(c.Max.X-c.Min.X)
+	c.FillText(sty, vg.Point{X: x, Y: c.Max.Y}, txt)
+	_, h, d := sty.Box(txt)
+	c.Max.Y -= h + d
+	c.Max.Y -= pad
 }
 
 // DataCanvas returns a new draw.Canvas that
 // is the subset of the given draw area into which
 // the plot data will be drawn.
 func (p *Plot) DataCanvas(da draw.Canvas) draw.Canvas {
+	full := da
 	if p.Title.Text != "" {
 		da.Max.Y -= p.Title.Height(p.Title.Text) - p.Title.Font.Extents().Descent
 		da.Max.Y -= p.Title.Padding
 	}
+	if p.Subtitle.Text != "" {
+		da.Max.Y -= p.Subtitle.Height(p.Subtitle.Text) - p.Subtitle.Font.Extents().Descent
+		da.Max.Y -= p.Subtitle.Padding
+	}
+	da, _ = p.Legend.outside(da)
 	p.X.sanitizeRange()
-	x := horizontalAxis{p.X}
 	p.Y.sanitizeRange()
-	y := verticalAxis{p.Y}
-	return padY(p, padX(p, draw.Crop(da, y.size(), 0, x.size(), 0)))
+	xheight, ywidth := p.axisSizes()
+	dc := padY(p, padX(p, draw.Crop(da, ywidth, -p.y2size(), xheight, 0)))
+	if p.Margins.isZero() {
+		return dc
+	}
+	return p.Margins.crop(full, dc)
+}
+
+// axisSizes returns the height of the X axis and the width
+// of the Y axis along the border of the plot. The size of an
+// axis drawn across the data area is zero.
+func (p *Plot) axisSizes() (xheight, ywidth vg.Length) {
+	if _, cross := p.X.crossing(&p.Y); !cross {
+		xheight = horizontalAxis{p.X}.size()
+	}
+	if _, cross := p.Y.crossing(&p.X); !cross {
+		ywidth = verticalAxis{p.Y}.size()
+	}
+	return xheight, ywidth
 }
 
 // DrawGlyphBoxes draws red outlines around the plot's
@@ -207,9 +558,16 @@ func (p *Plot) DrawGlyphBoxes(c *draw.Canvas) {
 // so that glyphs will no be clipped.
 func padX(p *Plot, c draw.Canvas) draw.Canvas {
 	glyphs := p.GlyphBoxes(p)
-	l := leftMost(&c, glyphs)
 	xAxis := horizontalAxis{p.X}
-	glyphs = append(glyphs, xAxis.GlyphBoxes(p)...)
+	_, yCross := p.Y.crossing(&p.X)
+	if yCross {
+		// There is no Y axis left of the tick labels.
+		glyphs = append(glyphs, xAxis.GlyphBoxes(p)...)
+	}
+	l := leftMost(&c, glyphs)
+	if !yCross {
+		glyphs = append(glyphs, xAxis.GlyphBoxes(p)...)
+	}
 	r := rightMost(&c, glyphs)
 
 	minx := c.Min.X - l.Min.X
@@ -263,9 +621,20 @@ func leftMost(c *draw.Canvas, boxes []GlyphBox) GlyphBox {
 // so that glyphs will no be clipped.
 func padY(p *Plot, c draw.Canvas) draw.Canvas {
 	glyphs := p.GlyphBoxes(p)
-	b := bottomMost(&c, glyphs)
 	yAxis := verticalAxis{p.Y}
-	glyphs = append(glyphs, yAxis.GlyphBoxes(p)...)
+	_, xCross := p.X.crossing(&p.Y)
+	if xCross {
+		// There is no X axis below the tick labels.
+		glyphs = append(glyphs, yAxis.GlyphBoxes(p)...)
+	}
+	b := bottomMost(&c, glyphs)
+	if !xCross {
+		glyphs = append(glyphs, yAxis.GlyphBoxes(p)...)
+	}
+	if p.hasY2() {
+		y2Axis := verticalAxis{p.Y2}
+		glyphs = append(glyphs, y2Axis.GlyphBoxes(p)...)
+	}
 	t := topMost(&c, glyphs)
 
 	miny := c.Min.Y - b.Min.Y
@@ -325,6 +694,25 @@ func (p *Plot) Transforms(c *draw.Canvas) (x, y func(float64) vg.Length) {
 	return
 }
 
+// InverseTransforms returns functions to transform
+// from the draw coordinate system of the given draw
+// area to the x and y data coordinate system. They
+// are the inverses of the functions returned by
+// Transforms.
+//
+// The functions may be used for hit-testing and to
+// place overlays, with the draw area given by the
+// DataCanvas of the canvas the plot is drawn on.
+func (p *Plot) InverseTransforms(c *draw.Canvas) (x, y func(vg.Length) float64) {
+	x = func(x vg.Length) float64 {
+		return p.X.Denorm(float64((x - c.Min.X) / (c.Max.X - c.Min.X)))
+	}
+	y = func(y vg.Length) float64 {
+		return p.Y.Denorm(float64((y - c.Min.Y) / (c.Max.Y - c.Min.Y)))
+	}
+	return
+}
+
 // GlyphBoxer wraps the GlyphBoxes method.
 // It should be implemented by things that meet
 // the Plotter interface that draw glyphs so that
@@ -368,8 +756,19 @@ type GlyphBox struct {
 }
 
 // GlyphBoxes returns the GlyphBoxes for all plot
-// data that meet the GlyphBoxer interface.
+// data that meet the GlyphBoxer interface, including
+// the data represented by the secondary Y axis.
 func (p *Plot) GlyphBoxes(*Plot) (boxes []GlyphBox) {
+	boxes = p.glyphBoxes()
+	if p.hasY2() {
+		boxes = append(boxes, p.secondary().glyphBoxes()...)
+	}
+	return boxes
+}
+
+// glyphBoxes returns the GlyphBoxes for the plotters
+// of p, normalized with the axes of p.
+func (p *Plot) glyphBoxes() (boxes []GlyphBox) {
 	for _, d := range p.plotters {
 		gb, ok := d.(GlyphBoxer)
 		if !ok {
@@ -395,11 +794,16 @@ func (p *Plot) GlyphBoxes(*Plot) (boxes []GlyphBox) {
 // 1 is above the second name, etc.  Labels for x values
 // that do not end up in range of the X axis will not have
 // tick marks.
+//
+// The padding of the Y axis is set so that the first name,
+// drawn with the current X tick label style, is not clipped.
+// The tick label style should therefore be set, including
+// its rotation, before calling NominalX.
 func (p *Plot) NominalX(names ...string) {
 	p.X.Tick.Width = 0
 	p.X.Tick.Length = 0
 	p.X.Width = 0
-	p.Y.Padding = p.X.Tick.Label.Width(names[0]) / 2
+	p.Y.Padding = vg.Length(math.Max(0, float64(-p.X.Tick.Label.Rectangle(names[0]).Min.X)))
 	ticks := make([]Tick, len(names))
 	for i, name := range names {
 		ticks[i] = Tick{float64(i), name}
@@ -432,7 +836,7 @@ func (p *Plot) NominalY(names ...string) {
 	p.Y.Tick.Width = 0
 	p.Y.Tick.Length = 0
 	p.Y.Width = 0
-	p.X.Padding = p.Y.Tick.Label.Height(names[0]) / 2
+	p.X.Padding = vg.Length(math.Max(0, float64(-p.Y.Tick.Label.Rectangle(names[0]).Min.Y)))
 	ticks := make([]Tick, len(names))
 	for i, name := range names {
 		ticks[i] = Tick{float64(i), name}
@@ -445,7 +849,7 @@ func (p *Plot) NominalY(names ...string) {
 //
 // Supported formats are:
 //
-//  eps, jpg|jpeg, pdf, png, svg, tex and tif|tiff.
+//  eps, jpg|jpeg, pdf, png, svg, tex, tif|tiff and webp.
 func (p *Plot) WriterTo(w, h vg.Length, format string) (io.WriterTo, error) {
 	c, err := draw.NewFormattedCanvas(w, h, format)
 	if err != nil {
@@ -455,13 +859,34 @@ func (p *Plot) WriterTo(w, h vg.Length, format string) (io.WriterTo, error) {
 	return c, nil
 }
 
+// Bounds returns the bounding box of the plot drawn on a canvas
+// of width w and height h, measured by a vg.BoundsCanvas without
+// drawing the plot. Parts of the plot lying outside of the canvas,
+// such as a title wider than the canvas, are clipped when the plot
+// is drawn on a canvas of that size; the bounding box can be used
+// to pick a size for the plot to fit before saving it.
+func (p *Plot) Bounds(w, h vg.Length) vg.Rectangle {
+	c := vg.NewBoundsCanvas(w, h)
+	p.Draw(draw.New(c))
+	r, _ := c.Bounds()
+	return r
+}
+
 // Save saves the plot to an image file.  The file format is determined
 // by the extension.
 //
 // Supported extensions are:
 //
-//  .eps, .jpg, .jpeg, .pdf, .png, .svg, .tex, .tif and .tiff.
-func (p *Plot) Save(w, h vg.Length, file string) (err error) {
+//  .eps, .jpg, .jpeg, .pdf, .png, .svg, .tex, .tif, .tiff and .webp.
+func (p *Plot) Save(w, h vg.Length, file string) error {
+	return save(file, func(format string) (io.WriterTo, error) {
+		return p.WriterTo(w, h, format)
+	})
+}
+
+// save writes the io.WriterTo returned by writerTo for
+// the format given by the extension of file to the file.
+func save(file string, writerTo func(format string) (io.WriterTo, error)) (err error) {
 	f, err := os.Create(file)
 	if err != nil {
 		return err
@@ -477,7 +902,7 @@ func (p *Plot) Save(w, h vg.Length, file string) (err error) {
 	if len(format) != 0 {
 		format = format[1:]
 	}
-	c, err := p.WriterTo(w, h, format)
+	c, err := writerTo(format)
 	if err != nil {
 		return err
 	}
@@ -485,3 +910,49 @@ func (p *Plot) Save(w, h vg.Length, file string) (err error) {
 	_, err = c.WriteTo(f)
 	return err
 }
+
+// Page is a plot drawn on a page of the given size
+// of a multi-page document.
+type Page struct {
+	Plot          *Plot
+	Width, Height vg.Length
+}
+
+// WriterToPDF returns an io.WriterTo that will write the plots
+// of the pages as a single PDF document, with a page for each plot.
+func WriterToPDF(pages ...Page) (io.WriterTo, error) {
+	if len(pages) == 0 {
+		return nil, errors.New("plot: no pages")
+	}
+	c := vgpdf.New(pages[0].Width, pages[0].Height)
+	for i, pg := range pages {
+		if i > 0 {
+			c.NextPageSize(pg.Width, pg.Height)
+		}
+		pg.Plot.Draw(draw.New(c))
+	}
+	return c, nil
+}
+
+// SavePDF saves the plots of the pages to a single PDF file,
+// with a page for each plot.
+func SavePDF(file string, pages ...Page) (err error) {
+	c, err := WriterToPDF(pages...)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		e := f.Close()
+		if err == nil {
+			err = e
+		}
+	}()
+
+	_, err = c.WriteTo(f)
+	return err
+}
@@ -7,17 +7,24 @@ package plot_test
 import (
 	"bytes"
 	"fmt"
+	"image"
 	"image/color"
+	"io/ioutil"
 	"math"
 	"reflect"
+	"regexp"
+	"sync"
 	"testing"
 	"time"
 
+	"gonum.org/v1/gonum/floats"
 	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
 	"gonum.org/v1/plot/plotter"
 	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
 	"gonum.org/v1/plot/vg/recorder"
+	"gonum.org/v1/plot/vg/vgimg"
 )
 
 func TestLegendAlignment(t *testing.T) {
@@ -290,3 +297,773 @@ func TestIssue514(t *testing.T) {
 		})
 	}
 }
+
+func TestSecondaryYAxis(t *testing.T) {
+	cmpimg.CheckPlot(ExamplePlot_AddY2, t, "secondary_y.png")
+}
+
+func TestSecondaryYAxisLabelPadding(t *testing.T) {
+	cmpimg.CheckPlot(ExamplePlot_AddY2_labelPadding, t, "secondary_y_padding.png")
+
+	const pad = 5
+	draws := func(padding vg.Length) (da draw.Canvas, label vg.Point) {
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("could not create plot: %v", err)
+		}
+		l, err := plotter.NewLine(plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 200}})
+		if err != nil {
+			t.Fatalf("could not create line: %v", err)
+		}
+		p.AddY2(l)
+		p.Y2.Tick.Marker = plot.ConstantTicks{{Value: 100, Label: "100"}}
+		p.Y2.Tick.LabelPadding = padding
+
+		var rec recorder.Canvas
+		c := draw.NewCanvas(&rec, 200, 200)
+		p.Draw(c)
+		for _, a := range rec.Actions {
+			if s, ok := a.(*recorder.FillString); ok && s.String == "100" {
+				label = s.Point
+			}
+		}
+		return p.DataCanvas(c), label
+	}
+	da0, label0 := draws(0)
+	da, label := draws(pad)
+
+	// The right axis moves away from its tick labels,
+	// which stay at the edge of the plot.
+	if got, want := da.Max.X, da0.Max.X-pad; math.Abs(float64(got-want)) > 1e-9 {
+		t.Errorf("unexpected right of the data area: got:%v want:%v", got, want)
+	}
+	if label != label0 {
+		t.Errorf("unexpected position of Y2 tick label: got:%v want:%v", label, label0)
+	}
+}
+
+func TestSecondaryYAxisTicks(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	left, err := plotter.NewLine(plotter.XYs{{X: 0, Y: -10}, {X: 1, Y: 30}})
+	if err != nil {
+		t.Fatalf("could not create line: %v", err)
+	}
+	right, err := plotter.NewLine(plotter.XYs{{X: 0, Y: 0}, {X: 2, Y: 200}})
+	if err != nil {
+		t.Fatalf("could not create line: %v", err)
+	}
+	p.Add(left)
+	p.AddY2(right)
+
+	if p.X.Min != 0 || p.X.Max != 2 {
+		t.Errorf("unexpected shared X range: got:[%v, %v] want:[0, 2]", p.X.Min, p.X.Max)
+	}
+	if p.Y.Min != -10 || p.Y.Max != 30 {
+		t.Errorf("unexpected Y range: got:[%v, %v] want:[-10, 30]", p.Y.Min, p.Y.Max)
+	}
+	if p.Y2.Min != 0 || p.Y2.Max != 200 {
+		t.Errorf("unexpected Y2 range: got:[%v, %v] want:[0, 200]", p.Y2.Min, p.Y2.Max)
+	}
+
+	var r recorder.Canvas
+	c := draw.NewCanvas(&r, 10*vg.Centimeter, 10*vg.Centimeter)
+	p.Draw(c)
+	dc := p.DataCanvas(c)
+
+	var strokes []vg.Path
+	for _, a := range r.Actions {
+		if s, ok := a.(*recorder.Stroke); ok && len(s.Path) == 2 && s.Path[0].Pos.Y == s.Path[1].Pos.Y {
+			strokes = append(strokes, s.Path)
+		}
+	}
+
+	for _, test := range []struct {
+		name  string
+		axis  plot.Axis
+		right bool
+	}{
+		{name: "Y", axis: p.Y},
+		{name: "Y2", axis: p.Y2, right: true},
+	} {
+		var n int
+		for _, tick := range test.axis.Tick.Marker.Ticks(test.axis.Min, test.axis.Max) {
+			y := dc.Y(test.axis.Norm(tick.Value))
+			if !dc.ContainsY(y) {
+				continue
+			}
+			n++
+			found := false
+			for _, s := range strokes {
+				x0, x1 := s[0].Pos.X, s[1].Pos.X
+				onSide := x0 < dc.Min.X && x1 < dc.Min.X
+				if test.right {
+					onSide = x0 > dc.Max.X && x1 > dc.Max.X
+				}
+				if onSide && math.Abs(float64(s[0].Pos.Y-y)) < 1e-9 {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Errorf("%s: no tick mark for %v at y=%v", test.name, tick.Value, y)
+			}
+		}
+		if n == 0 {
+			t.Errorf("%s: no tick marks in range", test.name)
+		}
+	}
+}
+
+func TestBackgroundImage(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	p.Title.Text = "Background"
+	p.X.Label.Text = "X"
+	p.Y.Label.Text = "Y"
+	s, err := plotter.NewScatter(plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 1}})
+	if err != nil {
+		t.Fatalf("could not create scatter: %v", err)
+	}
+	p.Add(s)
+
+	img := image.NewUniform(color.NRGBA{R: 255, A: 255})
+	p.SetBackgroundImage(img)
+
+	var r recorder.Canvas
+	c := draw.NewCanvas(&r, 10*vg.Centimeter, 10*vg.Centimeter)
+	p.Draw(c)
+	dc := p.DataCanvas(c)
+
+	var (
+		images  []*recorder.DrawImage
+		glyphAt = -1
+		imageAt = -1
+		fillAt  = -1
+	)
+	for i, a := range r.Actions {
+		switch a := a.(type) {
+		case *recorder.DrawImage:
+			images = append(images, a)
+			imageAt = i
+		case *recorder.Fill:
+			if fillAt < 0 {
+				fillAt = i // The first fill is the background color.
+			}
+		case *recorder.Stroke:
+			if glyphAt >= 0 || imageAt < 0 {
+				break
+			}
+			for _, comp := range a.Path {
+				if comp.Type == vg.ArcComp {
+					glyphAt = i // The glyphs of the scatter are circles.
+				}
+			}
+		}
+	}
+	if len(images) != 1 {
+		t.Fatalf("unexpected number of images drawn: got:%d want:1", len(images))
+	}
+	if images[0].Image != img {
+		t.Error("unexpected image drawn")
+	}
+	if images[0].Rectangle != dc.Rectangle {
+		t.Errorf("unexpected image rectangle: got:%v want:%v", images[0].Rectangle, dc.Rectangle)
+	}
+	if !(fillAt < imageAt) {
+		t.Error("background image not drawn over the background color")
+	}
+	if glyphAt < 0 {
+		t.Error("background image not drawn before the plotters")
+	}
+
+	p.SetBackgroundImage(nil)
+	r.Reset()
+	p.Draw(c)
+	for _, a := range r.Actions {
+		if _, ok := a.(*recorder.DrawImage); ok {
+			t.Error("unexpected image drawn after removing the background image")
+		}
+	}
+}
+
+func TestBackgroundImageInwardTicks(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		margins plot.Margins
+	}{
+		{name: "fit"},
+		{name: "margins", margins: plot.Margins{Left: vg.Centimeter, Right: vg.Centimeter, Bottom: vg.Centimeter, Top: vg.Centimeter}},
+	} {
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("could not create plot: %v", err)
+		}
+		p.Margins = test.margins
+		p.X.Tick.Direction = plot.TicksInward
+		p.Y.Tick.Direction = plot.TicksBoth
+		p.X.Max = 10
+		p.Y.Max = 10
+		p.SetBackgroundImage(image.NewUniform(color.NRGBA{R: 255, A: 255}))
+
+		var r recorder.Canvas
+		p.Draw(draw.NewCanvas(&r, 10*vg.Centimeter, 10*vg.Centimeter))
+
+		imageAt, strokeAt := -1, -1
+		for i, a := range r.Actions {
+			switch a.(type) {
+			case *recorder.DrawImage:
+				imageAt = i
+			case *recorder.Stroke:
+				if strokeAt < 0 {
+					strokeAt = i
+				}
+			}
+		}
+		if imageAt < 0 || strokeAt < 0 {
+			t.Fatalf("%s: missing actions: image at %d, first stroke at %d", test.name, imageAt, strokeAt)
+		}
+		if imageAt > strokeAt {
+			t.Errorf("%s: background image drawn over the axes: image at %d, first stroke at %d", test.name, imageAt, strokeAt)
+		}
+	}
+}
+
+func TestConcurrentRendering(t *testing.T) {
+	const n = 100
+	formats := []string{"png", "svg", "pdf", "eps"}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			p, err := plot.New()
+			if err != nil {
+				errs <- err
+				return
+			}
+			p.Title.Text = fmt.Sprintf("Plot %d", i)
+			p.X.Label.Text = "X"
+			p.Y.Label.Text = "Y"
+			pts := make(plotter.XYs, 10)
+			for j := range pts {
+				pts[j] = plotter.XY{X: float64(j), Y: float64(i * j)}
+			}
+			l, s, err := plotter.NewLinePoints(pts)
+			if err != nil {
+				errs <- err
+				return
+			}
+			p.Add(l, s, plotter.NewGrid())
+			p.Legend.Add("line", l, s)
+
+			w, err := p.WriterTo(5*vg.Centimeter, 5*vg.Centimeter, formats[i%len(formats)])
+			if err != nil {
+				errs <- err
+				return
+			}
+			_, err = w.WriteTo(ioutil.Discard)
+			if err != nil {
+				errs <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestWriterToPDF(t *testing.T) {
+	var pages []plot.Page
+	for i, size := range []vg.Length{5 * vg.Centimeter, 8 * vg.Centimeter, 10 * vg.Centimeter} {
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		p.Title.Text = fmt.Sprintf("Plot %d", i+1)
+		pages = append(pages, plot.Page{Plot: p, Width: size, Height: size / 2})
+	}
+
+	w, err := plot.WriterToPDF(pages...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var buf bytes.Buffer
+	_, err = w.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := regexp.MustCompile(`/Type /Page\b`).FindAll(buf.Bytes(), -1)
+	if len(got) != len(pages) {
+		t.Errorf("unexpected number of pages: got:%d want:%d", len(got), len(pages))
+	}
+	for _, pg := range pages {
+		box := fmt.Sprintf("/MediaBox [0 0 %.2f %.2f]", pg.Width.Points(), pg.Height.Points())
+		if !bytes.Contains(buf.Bytes(), []byte(box)) {
+			t.Errorf("missing page of size %vx%v", pg.Width, pg.Height)
+		}
+	}
+
+	_, err = plot.WriterToPDF()
+	if err == nil {
+		t.Error("expected error for empty document")
+	}
+}
+
+// grayPlotter is a Plotter and Thumbnailer filling
+// its canvas with the gray level Y, identifying the plotter
+// in the actions of a recorder.
+type grayPlotter color.Gray
+
+func (g grayPlotter) Plot(c draw.Canvas, _ *plot.Plot) {
+	c.SetColor(color.Gray(g))
+	c.Fill(c.Rectangle.Path())
+}
+
+func (g grayPlotter) Thumbnail(c *draw.Canvas) {
+	g.Plot(*c, nil)
+}
+
+func TestSortPlotters(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	p.BackgroundColor = nil
+	p.HideAxes()
+	p.Add(grayPlotter{Y: 3}, grayPlotter{Y: 1}, grayPlotter{Y: 2}, grayPlotter{Y: 1})
+	p.AddY2(grayPlotter{Y: 5}, grayPlotter{Y: 4})
+	p.Legend.Add("first", grayPlotter{Y: 3})
+	p.Legend.Add("second", grayPlotter{Y: 1})
+
+	order := func() []uint8 {
+		var r recorder.Canvas
+		p.Draw(draw.NewCanvas(&r, 10*vg.Centimeter, 10*vg.Centimeter))
+		var ys []uint8
+		for _, a := range r.Actions {
+			if a, ok := a.(*recorder.SetColor); ok {
+				if g, ok := a.Color.(color.Gray); ok {
+					ys = append(ys, g.Y)
+				}
+			}
+		}
+		return ys
+	}
+
+	got := order()
+	want := []uint8{3, 1, 2, 1, 5, 4, 3, 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected drawing order before sorting: got:%v want:%v", got, want)
+	}
+
+	p.SortPlotters(func(a, b plot.Plotter) bool {
+		return a.(grayPlotter).Y < b.(grayPlotter).Y
+	})
+	got = order()
+	// The secondary plotters are sorted separately and
+	// the legend keeps its order.
+	want = []uint8{1, 1, 2, 3, 4, 5, 3, 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected drawing order after sorting: got:%v want:%v", got, want)
+	}
+}
+
+func TestSubtitle(t *testing.T) {
+	const w, h = 10 * vg.Centimeter, 10 * vg.Centimeter
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	p.Title.Text = "Title"
+	c := draw.NewCanvas(new(recorder.Canvas), w, h)
+	top := p.DataCanvas(c).Max.Y
+
+	p.Subtitle.Text = "Subtitle"
+	p.Subtitle.Padding = vg.Points(2)
+	p.Title.XAlign = draw.XLeft
+	p.Subtitle.XAlign = draw.XRight
+
+	var r recorder.Canvas
+	c = draw.NewCanvas(&r, w, h)
+	dc := p.DataCanvas(c)
+	sub := p.Subtitle.Height(p.Subtitle.Text) - p.Subtitle.Font.Extents().Descent + p.Subtitle.Padding
+	if got, want := dc.Max.Y, top-sub; math.Abs(float64(got-want)) > 1e-9 {
+		t.Errorf("unexpected top of data area with a subtitle: got:%v want:%v", got, want)
+	}
+
+	p.Draw(c)
+	strs := make(map[string]vg.Point)
+	for _, a := range r.Actions {
+		if a, ok := a.(*recorder.FillString); ok {
+			strs[a.String] = a.Point
+		}
+	}
+	title, ok := strs["Title"]
+	if !ok {
+		t.Fatal("missing title")
+	}
+	if title.X != 0 {
+		t.Errorf("unexpected position of left aligned title: got:%v want:0", title.X)
+	}
+	subtitle, ok := strs["Subtitle"]
+	if !ok {
+		t.Fatal("missing subtitle")
+	}
+	if got, want := subtitle.X, w-p.Subtitle.Width(p.Subtitle.Text); math.Abs(float64(got-want)) > 1e-9 {
+		t.Errorf("unexpected position of right aligned subtitle: got:%v want:%v", got, want)
+	}
+	if !(subtitle.Y < title.Y && subtitle.Y > dc.Max.Y) {
+		t.Errorf("subtitle not drawn between the title and the data area: title:%v subtitle:%v data area top:%v",
+			title.Y, subtitle.Y, dc.Max.Y)
+	}
+}
+
+// sqrtScale is a Normalizer that is not a Denormalizer.
+type sqrtScale struct{}
+
+func (sqrtScale) Normalize(min, max, x float64) float64 {
+	return (math.Sqrt(x) - math.Sqrt(min)) / (math.Sqrt(max) - math.Sqrt(min))
+}
+
+func TestInverseTransforms(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		scale plot.Normalizer
+		min   float64
+		max   float64
+		vals  []float64
+	}{
+		{name: "linear", scale: plot.LinearScale{}, min: -2, max: 5, vals: []float64{-2, -1.5, 0, 3, 5, 7}},
+		{name: "log", scale: plot.LogScale{}, min: 0.1, max: 1000, vals: []float64{0.1, 1, 42, 1000, 5000}},
+		{name: "symlog", scale: plot.SymlogScale{Threshold: 2}, min: -1000, max: 100, vals: []float64{-1000, -3, 0, 1.5, 20, 100}},
+		{name: "inverted", scale: plot.InvertedScale{Normalizer: plot.LogScale{}}, min: 1, max: 100, vals: []float64{1, 5, 50, 100}},
+		{name: "custom", scale: sqrtScale{}, min: 1, max: 16, vals: []float64{1, 2, 9, 16, 25}},
+		{name: "inverted custom", scale: plot.InvertedScale{Normalizer: sqrtScale{}}, min: 1, max: 16, vals: []float64{1, 4, 10, 16}},
+	} {
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("could not create plot: %v", err)
+		}
+		p.X.Scale = test.scale
+		p.X.Min, p.X.Max = test.min, test.max
+		p.Y.Scale = test.scale
+		p.Y.Min, p.Y.Max = test.min, test.max
+
+		c := draw.NewCanvas(&recorder.Canvas{}, 10*vg.Centimeter, 5*vg.Centimeter)
+		c.Min = vg.Point{X: 2 * vg.Centimeter, Y: 1 * vg.Centimeter}
+		trX, trY := p.Transforms(&c)
+		invX, invY := p.InverseTransforms(&c)
+		for _, v := range test.vals {
+			x, y := trX(v), trY(v)
+			if got := invX(x); !floats.EqualWithinAbsOrRel(got, v, 1e-9, 1e-9) {
+				t.Errorf("%s: unexpected X round trip of %v: got:%v", test.name, v, got)
+			}
+			if got := invY(y); !floats.EqualWithinAbsOrRel(got, v, 1e-9, 1e-9) {
+				t.Errorf("%s: unexpected Y round trip of %v: got:%v", test.name, v, got)
+			}
+			if got := trX(invX(x)); !floats.EqualWithinAbsOrRel(float64(got), float64(x), 1e-9, 1e-9) {
+				t.Errorf("%s: unexpected canvas X round trip of %v: got:%v", test.name, x, got)
+			}
+		}
+	}
+}
+
+// crossingLine is a plotter drawing a horizontal line at
+// the middle of the Y range, from X to Y, without clipping.
+type crossingLine struct{ x0, x1 float64 }
+
+func (l crossingLine) Plot(c draw.Canvas, p *plot.Plot) {
+	trX, trY := p.Transforms(&c)
+	sty := draw.LineStyle{Color: color.Black, Width: vg.Points(2)}
+	y := trY((p.Y.Min + p.Y.Max) / 2)
+	c.StrokeLine2(sty, trX(l.x0), y, trX(l.x1), y)
+}
+
+func TestClipPlotters(t *testing.T) {
+	const (
+		w = 3 * vg.Inch
+		h = 2 * vg.Inch
+	)
+	newPlot := func(clip bool) *plot.Plot {
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("could not create plot: %v", err)
+		}
+		p.ClipPlotters = clip
+		p.X.Min, p.X.Max = 0, 1
+		p.Y.Min, p.Y.Max = 0, 1
+		p.Add(crossingLine{x0: -1, x1: 2})
+		return p
+	}
+
+	var rec recorder.Canvas
+	dc := draw.NewCanvas(&rec, w, h)
+	newPlot(true).Draw(dc)
+	var clip *recorder.Clip
+	for _, a := range rec.Actions {
+		if a, ok := a.(*recorder.Clip); ok {
+			if clip != nil {
+				t.Fatal("unexpected number of clips")
+			}
+			clip = a
+		}
+	}
+	if clip == nil {
+		t.Fatal("plotters were not clipped")
+	}
+	min, max := clip.Path[0].Pos, clip.Path[0].Pos
+	for _, c := range clip.Path[1:] {
+		if c.Type == vg.CloseComp {
+			continue
+		}
+		if c.Pos.X < min.X {
+			min.X = c.Pos.X
+		}
+		if c.Pos.Y < min.Y {
+			min.Y = c.Pos.Y
+		}
+		if c.Pos.X > max.X {
+			max.X = c.Pos.X
+		}
+		if c.Pos.Y > max.Y {
+			max.Y = c.Pos.Y
+		}
+	}
+	// The clip must not cut the glyphs drawn
+	// in the padding of the data area.
+	data := newPlot(true).DataCanvas(dc)
+	if min.X > data.Min.X || max.X < data.Max.X || min.Y > data.Min.Y || max.Y < data.Max.Y {
+		t.Errorf("clip %v-%v does not contain the data area %v", min, max, data.Rectangle)
+	}
+
+	const dpi = 96
+	for _, clip := range []bool{false, true} {
+		c := vgimg.NewWith(vgimg.UseWH(w, h), vgimg.UseDPI(dpi))
+		newPlot(clip).Draw(draw.New(c))
+		img := c.Image()
+		y := img.Bounds().Dy() - int(math.Round(((data.Min.Y + data.Max.Y) / 2).Dots(dpi)))
+		inside := int((min.X + 2).Dots(dpi))
+		outside := int((min.X - 2).Dots(dpi))
+		if r, _, _, _ := img.At(inside, y).RGBA(); r != 0 {
+			t.Errorf("clip=%t: line not drawn inside the data area", clip)
+		}
+		r, _, _, _ := img.At(outside, y).RGBA()
+		if clipped := r == 0xffff; clipped != clip {
+			t.Errorf("clip=%t: unexpected drawing outside the data area: got clipped=%t", clip, clipped)
+		}
+	}
+}
+
+// pointsRange is a plotter holding points for the range
+// of a plot, without drawing them.
+type pointsRange struct{ plotter.XYs }
+
+func (pointsRange) Plot(draw.Canvas, *plot.Plot) {}
+
+func (r pointsRange) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return plotter.XYRange(r)
+}
+
+func TestLogAxes(t *testing.T) {
+	pts := plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 0.5}, {X: 2, Y: -3}, {X: 5, Y: 5}, {X: 10, Y: 500}}
+	swapped := make(plotter.XYs, len(pts))
+	for i, p := range pts {
+		swapped[i] = plotter.XY{X: p.Y, Y: p.X}
+	}
+	xColor := color.RGBA{R: 255, A: 255}
+	yColor := color.RGBA{B: 255, A: 255}
+
+	for _, test := range []struct {
+		name       string
+		logX       bool
+		logTicks   bool
+		pts        plotter.XYs
+		wantX      [2]float64
+		wantY      [2]float64
+		wantXTicks []string
+		wantYTicks []string
+	}{
+		{
+			name:       "linear-X/log-Y",
+			logTicks:   true,
+			pts:        pts,
+			wantX:      [2]float64{0, 10},
+			wantY:      [2]float64{0.5, 500},
+			wantXTicks: []string{"0", "5", "10"},
+			wantYTicks: []string{"1", "10", "100"},
+		},
+		{
+			name:       "log-X/linear-Y",
+			logX:       true,
+			logTicks:   true,
+			pts:        swapped,
+			wantX:      [2]float64{0.5, 500},
+			wantY:      [2]float64{0, 10},
+			wantXTicks: []string{"1", "10", "100"},
+			wantYTicks: []string{"0", "5", "10"},
+		},
+		{
+			name:       "log-X/linear-Y default ticks",
+			logX:       true,
+			pts:        swapped,
+			wantX:      [2]float64{0.5, 500},
+			wantY:      [2]float64{0, 10},
+			wantXTicks: []string{"100", "300", "500"},
+			wantYTicks: []string{"0", "5", "10"},
+		},
+	} {
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("could not create plot: %v", err)
+		}
+		log := &p.Y
+		if test.logX {
+			log = &p.X
+		}
+		log.Scale = plot.LogScale{}
+		if test.logTicks {
+			log.Tick.Marker = plot.LogTicks{}
+		}
+		p.X.Tick.Label.Color = xColor
+		p.Y.Tick.Label.Color = yColor
+
+		p.Add(pointsRange{test.pts}, plotter.NewGrid())
+		if got := [2]float64{p.X.Min, p.X.Max}; got != test.wantX {
+			t.Errorf("%s: unexpected X range: got:%v want:%v", test.name, got, test.wantX)
+		}
+		if got := [2]float64{p.Y.Min, p.Y.Max}; got != test.wantY {
+			t.Errorf("%s: unexpected Y range: got:%v want:%v", test.name, got, test.wantY)
+		}
+
+		var rec recorder.Canvas
+		p.Draw(draw.NewCanvas(&rec, 10*vg.Centimeter, 10*vg.Centimeter))
+		var gotX, gotY []string
+		for _, paint := range rec.Paints() {
+			s, ok := paint.Action.(*recorder.FillString)
+			if !ok {
+				continue
+			}
+			switch paint.Color {
+			case xColor:
+				gotX = append(gotX, s.String)
+			case yColor:
+				gotY = append(gotY, s.String)
+			}
+		}
+		if !reflect.DeepEqual(gotX, test.wantXTicks) {
+			t.Errorf("%s: unexpected X tick labels: got:%q want:%q", test.name, gotX, test.wantXTicks)
+		}
+		if !reflect.DeepEqual(gotY, test.wantYTicks) {
+			t.Errorf("%s: unexpected Y tick labels: got:%q want:%q", test.name, gotY, test.wantYTicks)
+		}
+	}
+}
+
+func TestMargins(t *testing.T) {
+	const w, h = 300, 200
+	newPlot := func(label string, m plot.Margins) *plot.Plot {
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("error: %+v", err)
+		}
+		p.Title.Text = "title"
+		p.X.Min, p.X.Max = 0, 10
+		p.Y.Min, p.Y.Max = 0, 10
+		p.Y.Label.Text = "Y"
+		p.Y.Tick.Marker = plot.ConstantTicks{{Value: 0, Label: "0"}, {Value: 10, Label: label}}
+		p.Margins = m
+		return p
+	}
+	draws := func(p *plot.Plot) (da draw.Canvas, line vg.Length) {
+		var rec recorder.Canvas
+		c := draw.NewCanvas(&rec, w, h)
+		p.Draw(c)
+		da = p.DataCanvas(c)
+		for _, a := range rec.Actions {
+			s, ok := a.(*recorder.Stroke)
+			if !ok || len(s.Path) != 2 {
+				continue
+			}
+			p0, p1 := s.Path[0].Pos, s.Path[1].Pos
+			if p0.X == p1.X && p1.Y-p0.Y > h/2 {
+				line = p0.X
+			}
+		}
+		return da, line
+	}
+
+	short, _ := draws(newPlot("10", plot.Margins{}))
+	long, longLine := draws(newPlot("1000000", plot.Margins{}))
+	if short.Rectangle == long.Rectangle {
+		t.Fatalf("unexpected identical data areas without margins: %v", short.Rectangle)
+	}
+
+	fixed := plot.Margins{Left: 60, Right: 10, Bottom: 30, Top: 25}
+	want := vg.Rectangle{Min: vg.Point{X: 60, Y: 30}, Max: vg.Point{X: w - 10, Y: h - 25}}
+	for _, label := range []string{"10", "1000000"} {
+		da, line := draws(newPlot(label, fixed))
+		if da.Rectangle != want {
+			t.Errorf("label=%q: unexpected data area: got:%v want:%v", label, da.Rectangle, want)
+		}
+		// The Y axis is as far from the data area
+		// as without margins.
+		if got, want := da.Min.X-line, long.Min.X-longLine; math.Abs(float64(got-want)) > 1e-9 {
+			t.Errorf("label=%q: unexpected Y axis line: got:%v want:%v", label, got, want)
+		}
+	}
+
+	// Minimum margins only widen the margins
+	// sized to fit their contents.
+	min := plot.Margins{Left: 1, Min: true}
+	if da, _ := draws(newPlot("1000000", min)); da.Rectangle != long.Rectangle {
+		t.Errorf("unexpected data area with small minimum margin: got:%v want:%v", da.Rectangle, long.Rectangle)
+	}
+	min.Left = 100
+	for _, label := range []string{"10", "1000000"} {
+		da, _ := draws(newPlot(label, min))
+		got, want := da.Rectangle, long.Rectangle
+		want.Min.X = 100
+		if got != want {
+			t.Errorf("label=%q: unexpected data area with minimum margin: got:%v want:%v", label, got, want)
+		}
+	}
+}
+
+func TestBounds(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Title.Text = "A title much too long to fit across the width of a small plot"
+	p.X.Label.Text = "x"
+	p.Y.Label.Text = "y"
+
+	const w, h = 5 * vg.Centimeter, 4 * vg.Centimeter
+	b := p.Bounds(w, h)
+	if got, title := b.Size().Y, p.Title.Height(p.Title.Text); got <= title {
+		t.Errorf("bounds not taller than the title: got:%v title:%v", got, title)
+	}
+	if b.Max.Y > h {
+		t.Errorf("bounds above the canvas: got:%v want:<=%v", b.Max.Y, h)
+	}
+	if b.Min.X >= 0 || b.Max.X <= w {
+		t.Errorf("title not measured beyond the canvas: got:[%v, %v] canvas:[0, %v]", b.Min.X, b.Max.X, w)
+	}
+
+	// Drawing the plot on a canvas as wide as the measured
+	// bounds fits the title on the canvas.
+	fit := p.Bounds(b.Size().X, h)
+	if fit.Min.X < 0 || fit.Max.X > b.Size().X {
+		t.Errorf("plot does not fit the measured width: got:[%v, %v] canvas:[0, %v]", fit.Min.X, fit.Max.X, b.Size().X)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Arrow implements the Plotter interface, drawing an
+// arrow from a start point to an end point, with an
+// arrowhead at the end.
+type Arrow struct {
+	// Start and End are the points of the
+	// tail and the tip of the arrow.
+	Start, End XY
+
+	// LineStyle is the style of the arrow.
+	draw.LineStyle
+
+	// HeadLength is the length of the arrowhead,
+	// from its tip to the ends of its barbs.
+	// The arrowhead is not drawn if HeadLength
+	// is zero.
+	HeadLength vg.Length
+
+	// HeadAngle is the angle in radians between
+	// the shaft and each barb of the arrowhead.
+	HeadAngle float64
+
+	// Filled specifies whether the arrowhead is
+	// drawn as a triangle filled with the color of
+	// the LineStyle, instead of two open barbs.
+	Filled bool
+}
+
+// NewArrow returns an Arrow from start to end, in the
+// default line style, with an open arrowhead.
+func NewArrow(start, end XY) (*Arrow, error) {
+	if err := CheckFloats(start.X, start.Y, end.X, end.Y); err != nil {
+		return nil, err
+	}
+	return &Arrow{
+		Start:      start,
+		End:        end,
+		LineStyle:  DefaultLineStyle,
+		HeadLength: vg.Points(8),
+		HeadAngle:  math.Pi / 8,
+	}, nil
+}
+
+// Plot draws the Arrow, implementing the plot.Plotter interface.
+func (a *Arrow) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	tail := vg.Point{X: trX(a.Start.X), Y: trY(a.Start.Y)}
+	tip := vg.Point{X: trX(a.End.X), Y: trY(a.End.Y)}
+
+	left, right, ok := a.head(tail, tip)
+	if !ok {
+		c.StrokeLines(a.LineStyle, c.ClipLinesXY([]vg.Point{tail, tip})...)
+		return
+	}
+	if !a.Filled {
+		c.StrokeLines(a.LineStyle, c.ClipLinesXY([]vg.Point{tail, tip})...)
+		c.StrokeLines(a.LineStyle, c.ClipLinesXY([]vg.Point{left, tip, right})...)
+		return
+	}
+
+	// End the shaft at the base of the head so
+	// that its cap does not show past the tip.
+	base := vg.Point{X: (left.X + right.X) / 2, Y: (left.Y + right.Y) / 2}
+	c.StrokeLines(a.LineStyle, c.ClipLinesXY([]vg.Point{tail, base})...)
+	c.FillPolygon(a.Color, c.ClipPolygonXY([]vg.Point{left, tip, right}))
+}
+
+// head returns the ends of the barbs of the arrowhead of an
+// arrow from tail to tip on the canvas, to the left and right
+// of the arrow. The boolean is false if the arrowhead is not
+// drawn.
+func (a *Arrow) head(tail, tip vg.Point) (left, right vg.Point, ok bool) {
+	d := tip.Sub(tail)
+	l := math.Hypot(float64(d.X), float64(d.Y))
+	if a.HeadLength <= 0 || l == 0 {
+		return left, right, false
+	}
+	ux, uy := float64(d.X)/l, float64(d.Y)/l
+	barb := func(angle float64) vg.Point {
+		sin, cos := math.Sincos(angle)
+		return vg.Point{
+			X: tip.X - a.HeadLength*vg.Length(ux*cos-uy*sin),
+			Y: tip.Y - a.HeadLength*vg.Length(ux*sin+uy*cos),
+		}
+	}
+	return barb(-a.HeadAngle), barb(a.HeadAngle), true
+}
+
+// DataRange returns the minimum and maximum x and y values
+// of the end points of the arrow, implementing the
+// plot.DataRanger interface.
+func (a *Arrow) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return math.Min(a.Start.X, a.End.X), math.Max(a.Start.X, a.End.X),
+		math.Min(a.Start.Y, a.End.Y), math.Max(a.Start.Y, a.End.Y)
+}
+
+// GlyphBoxes returns a GlyphBox covering the arrowhead,
+// implementing the plot.GlyphBoxer interface.
+func (a *Arrow) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	r := a.HeadLength
+	return []plot.GlyphBox{{
+		X: plt.X.Norm(a.End.X),
+		Y: plt.Y.Norm(a.End.Y),
+		Rectangle: vg.Rectangle{
+			Min: vg.Point{X: -r, Y: -r},
+			Max: vg.Point{X: +r, Y: +r},
+		},
+	}}
+}
+
+// Callout implements the Plotter interface, drawing a
+// text label with an arrow pointing from the label to
+// a point of the plot.
+type Callout struct {
+	// Arrow is the arrow from the
+	// label to the point.
+	Arrow *Arrow
+
+	// Label is the label at the
+	// start of the arrow.
+	Label *Labels
+}
+
+// NewCallout returns a Callout with the text drawn at the
+// point at, aligned away from the target point, and an
+// arrow from at to target, using the DefaultFont and the
+// DefaultFontSize.
+func NewCallout(text string, at, target XY) (*Callout, error) {
+	arrow, err := NewArrow(at, target)
+	if err != nil {
+		return nil, err
+	}
+	label, err := NewLabels(XYLabels{XYs: XYs{at}, Labels: []string{text}})
+	if err != nil {
+		return nil, err
+	}
+
+	const pad = vg.Length(2)
+	sty := &label.TextStyle[0]
+	switch {
+	case target.X > at.X:
+		sty.XAlign = draw.XRight
+		label.XOffset = -pad
+	case target.X < at.X:
+		sty.XAlign = draw.XLeft
+		label.XOffset = pad
+	default:
+		sty.XAlign = draw.XCenter
+	}
+	switch {
+	case target.Y > at.Y:
+		sty.YAlign = draw.YTop
+		label.YOffset = -pad
+	case target.Y < at.Y:
+		sty.YAlign = draw.YBottom
+		label.YOffset = pad
+	default:
+		sty.YAlign = draw.YCenter
+	}
+	return &Callout{Arrow: arrow, Label: label}, nil
+}
+
+// Plot draws the Callout, implementing the plot.Plotter interface.
+func (c *Callout) Plot(cnv draw.Canvas, plt *plot.Plot) {
+	c.Arrow.Plot(cnv, plt)
+	c.Label.Plot(cnv, plt)
+}
+
+// DataRange returns the minimum and maximum x and y values
+// of the points of the label and the arrow, implementing
+// the plot.DataRanger interface.
+func (c *Callout) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, xmax, ymin, ymax = c.Arrow.DataRange()
+	lxmin, lxmax, lymin, lymax := c.Label.DataRange()
+	return math.Min(xmin, lxmin), math.Max(xmax, lxmax),
+		math.Min(ymin, lymin), math.Max(ymax, lymax)
+}
+
+// GlyphBoxes returns the GlyphBoxes of the label and the
+// arrowhead, implementing the plot.GlyphBoxer interface.
+func (c *Callout) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	return append(c.Arrow.GlyphBoxes(plt), c.Label.GlyphBoxes(plt)...)
+}
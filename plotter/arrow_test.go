@@ -0,0 +1,113 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestCallout(t *testing.T) {
+	cmpimg.CheckPlot(ExampleCallout, t, "callout.png")
+}
+
+func TestArrowHead(t *testing.T) {
+	plt, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	plt.X.Min, plt.X.Max = 0, 10
+	plt.Y.Min, plt.Y.Max = 0, 10
+
+	a, err := plotter.NewArrow(plotter.XY{X: 2, Y: 2}, plotter.XY{X: 8, Y: 6})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	a.HeadLength = vg.Points(10)
+	a.HeadAngle = math.Pi / 6
+
+	xmin, xmax, ymin, ymax := a.DataRange()
+	if xmin != 2 || xmax != 8 || ymin != 2 || ymax != 6 {
+		t.Errorf("unexpected data range: got:%v want:[2 8 2 6]", []float64{xmin, xmax, ymin, ymax})
+	}
+
+	near := func(a, b vg.Point) bool {
+		return math.Abs(float64(a.X-b.X)) < 1e-9 && math.Abs(float64(a.Y-b.Y)) < 1e-9
+	}
+	for _, filled := range []bool{false, true} {
+		a.Filled = filled
+		c := new(recorder.Canvas)
+		dc := draw.NewCanvas(c, 10*vg.Centimeter, 10*vg.Centimeter)
+		a.Plot(dc, plt)
+
+		trX, trY := plt.Transforms(&dc)
+		tail := vg.Point{X: trX(2), Y: trY(2)}
+		tip := vg.Point{X: trX(8), Y: trY(6)}
+		dir := math.Atan2(float64(tip.Y-tail.Y), float64(tip.X-tail.X))
+		barb := func(angle float64) vg.Point {
+			sin, cos := math.Sincos(dir + angle)
+			return vg.Point{X: tip.X - a.HeadLength*vg.Length(cos), Y: tip.Y - a.HeadLength*vg.Length(sin)}
+		}
+		left, right := barb(-a.HeadAngle), barb(a.HeadAngle)
+
+		var paths [][]vg.Point
+		var fills int
+		for _, act := range c.Actions {
+			var path vg.Path
+			switch act := act.(type) {
+			case *recorder.Stroke:
+				path = act.Path
+			case *recorder.Fill:
+				path = act.Path
+				fills++
+			default:
+				continue
+			}
+			var pts []vg.Point
+			for _, p := range path {
+				if p.Type != vg.CloseComp {
+					pts = append(pts, p.Pos)
+				}
+			}
+			paths = append(paths, pts)
+		}
+		if len(paths) != 2 {
+			t.Fatalf("filled=%t: unexpected number of paths: got:%d want:2", filled, len(paths))
+		}
+
+		shaft, head := paths[0], paths[1]
+		wantEnd := tip
+		wantFills := 0
+		if filled {
+			// The shaft ends at the base of the head.
+			base := math.Cos(a.HeadAngle) * float64(a.HeadLength)
+			wantEnd = vg.Point{X: tip.X - vg.Length(base*math.Cos(dir)), Y: tip.Y - vg.Length(base*math.Sin(dir))}
+			wantFills = 1
+		}
+		if len(shaft) != 2 || !near(shaft[0], tail) || !near(shaft[1], wantEnd) {
+			t.Errorf("filled=%t: unexpected shaft: got:%v want:%v", filled, shaft, []vg.Point{tail, wantEnd})
+		}
+		if fills != wantFills {
+			t.Errorf("filled=%t: unexpected number of fills: got:%d want:%d", filled, fills, wantFills)
+		}
+		want := []vg.Point{left, tip, right}
+		if len(head) != len(want) {
+			t.Errorf("filled=%t: unexpected head: got:%v want:%v", filled, head, want)
+			continue
+		}
+		for i := range head {
+			if !near(head[i], want[i]) {
+				t.Errorf("filled=%t: unexpected head point %d: got:%v want:%v", filled, i, head[i], want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Band implements the Plotter interface, filling the region
+// between a lower and an upper curve sharing the same X values,
+// such as a confidence band around a regression line.
+//
+// Where the curves cross, the region is split at the crossing,
+// so that the filled area is always the area between the curves.
+type Band struct {
+	// Lower and Upper are copies of the points
+	// of the curves bounding the band.
+	Lower, Upper XYs
+
+	// Color is the fill color of the band.
+	Color color.Color
+
+	// LineStyle is the style of the lines drawn
+	// along the curves. Use zero width to disable
+	// the lines.
+	draw.LineStyle
+}
+
+// NewBand returns a Band between the lower and upper curves,
+// filled with a semi-transparent gray, without lines along
+// the curves. An error is returned if the curves do not have
+// the same X values.
+func NewBand(lower, upper XYer) (*Band, error) {
+	lo, err := CopyXYs(lower)
+	if err != nil {
+		return nil, err
+	}
+	up, err := CopyXYs(upper)
+	if err != nil {
+		return nil, err
+	}
+	if len(lo) != len(up) {
+		return nil, errors.New("plotter: band curves have different lengths")
+	}
+	for i := range lo {
+		if lo[i].X != up[i].X {
+			return nil, errors.New("plotter: band curves have different X values")
+		}
+	}
+
+	sty := DefaultLineStyle
+	sty.Width = 0
+
+	return &Band{
+		Lower:     lo,
+		Upper:     up,
+		Color:     color.NRGBA{A: 0x40},
+		LineStyle: sty,
+	}, nil
+}
+
+// NewLineBand returns a Line through the center points and
+// a Band between the lower and upper curves around it. The
+// band is filled with a semi-transparent version of the
+// color of the line.
+func NewLineBand(center, lower, upper XYer) (*Line, *Band, error) {
+	l, err := NewLine(center)
+	if err != nil {
+		return nil, nil, err
+	}
+	b, err := NewBand(lower, upper)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, g, bl, _ := color.NRGBAModel.Convert(l.Color).RGBA()
+	b.Color = color.NRGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(bl >> 8), A: 0x40}
+	return l, b, nil
+}
+
+// Plot draws the Band, implementing the plot.Plotter interface.
+func (b *Band) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	lo := make([]vg.Point, len(b.Lower))
+	up := make([]vg.Point, len(b.Upper))
+	for i := range b.Lower {
+		lo[i] = vg.Point{X: trX(b.Lower[i].X), Y: trY(b.Lower[i].Y)}
+		up[i] = vg.Point{X: trX(b.Upper[i].X), Y: trY(b.Upper[i].Y)}
+	}
+
+	if b.Color != nil {
+		var pa vg.Path
+		for _, r := range bandRegions(lo, up) {
+			r = c.ClipPolygonXY(r)
+			if len(r) == 0 {
+				continue
+			}
+			pa.Move(r[0])
+			for _, p := range r[1:] {
+				pa.Line(p)
+			}
+			pa.Close()
+		}
+		if len(pa) != 0 {
+			c.SetColor(b.Color)
+			c.Fill(pa)
+		}
+	}
+
+	if b.LineStyle.Width != 0 {
+		c.StrokeLines(b.LineStyle, c.ClipLinesXY(lo)...)
+		c.StrokeLines(b.LineStyle, c.ClipLinesXY(up)...)
+	}
+}
+
+// bandRegions returns the polygons bounded by the lower
+// and upper curves, split where the curves cross. Each
+// polygon follows the lower curve forward and the upper
+// curve back.
+func bandRegions(lo, up []vg.Point) [][]vg.Point {
+	if len(lo) == 0 {
+		return nil
+	}
+	var regions [][]vg.Point
+	region := func(a, b []vg.Point) []vg.Point {
+		r := append([]vg.Point(nil), a...)
+		for i := len(b) - 1; i >= 0; i-- {
+			r = append(r, b[i])
+		}
+		return r
+	}
+
+	a, b := []vg.Point{lo[0]}, []vg.Point{up[0]}
+	for i := 1; i < len(lo); i++ {
+		d0 := up[i-1].Y - lo[i-1].Y
+		d1 := up[i].Y - lo[i].Y
+		if d0*d1 < 0 {
+			// The curves cross between i-1 and i.
+			t := d0 / (d0 - d1)
+			x := lo[i-1].Add(lo[i].Sub(lo[i-1]).Scale(t))
+			regions = append(regions, region(append(a, x), b))
+			a, b = []vg.Point{x}, nil
+		}
+		a = append(a, lo[i])
+		b = append(b, up[i])
+	}
+	return append(regions, region(a, b))
+}
+
+// DataRange returns the minimum and maximum x and y values
+// of both curves, implementing the plot.DataRanger interface.
+func (b *Band) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, xmax, ymin, ymax = XYRange(b.Lower)
+	uxmin, uxmax, uymin, uymax := XYRange(b.Upper)
+	return math.Min(xmin, uxmin), math.Max(xmax, uxmax), math.Min(ymin, uymin), math.Max(ymax, uymax)
+}
+
+// Thumbnail fills the thumbnail with the color of the band,
+// implementing the plot.Thumbnailer interface.
+func (b *Band) Thumbnail(c *draw.Canvas) {
+	if b.Color == nil {
+		return
+	}
+	pts := []vg.Point{
+		{X: c.Min.X, Y: c.Min.Y},
+		{X: c.Min.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Min.Y},
+	}
+	c.FillPolygon(b.Color, c.ClipPolygonY(pts))
+}
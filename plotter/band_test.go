@@ -0,0 +1,110 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestBand(t *testing.T) {
+	cmpimg.CheckPlot(ExampleBand, t, "band.png")
+}
+
+func TestBandCrossing(t *testing.T) {
+	b, err := plotter.NewBand(
+		plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 2}, {X: 2, Y: 2}},
+		plotter.XYs{{X: 0, Y: 2}, {X: 1, Y: 0}, {X: 2, Y: 4}},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	xmin, xmax, ymin, ymax := b.DataRange()
+	if xmin != 0 || xmax != 2 || ymin != 0 || ymax != 4 {
+		t.Errorf("unexpected data range: got:[%v,%v]x[%v,%v] want:[0,2]x[0,4]", xmin, xmax, ymin, ymax)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.X.Min, p.X.Max = 0, 2
+	p.Y.Min, p.Y.Max = 0, 4
+
+	// Draw at a scale of 100 points per unit.
+	var rec recorder.Canvas
+	b.Plot(draw.NewCanvas(&rec, 200, 400), p)
+
+	var fills []*recorder.Fill
+	for _, a := range rec.Actions {
+		if f, ok := a.(*recorder.Fill); ok {
+			fills = append(fills, f)
+		}
+	}
+	if len(fills) != 1 {
+		t.Fatalf("unexpected number of fills: got:%d want:1", len(fills))
+	}
+
+	// The band is split into three regions at the
+	// crossings at (0.5, 1) and (1.5, 2), each following
+	// the lower curve forward and the upper curve back.
+	want := [][]vg.Point{
+		{{X: 0, Y: 0}, {X: 50, Y: 100}, {X: 0, Y: 200}},
+		{{X: 50, Y: 100}, {X: 100, Y: 200}, {X: 150, Y: 200}, {X: 100, Y: 0}},
+		{{X: 150, Y: 200}, {X: 200, Y: 200}, {X: 200, Y: 400}},
+	}
+	var got [][]vg.Point
+	for _, comp := range fills[0].Path {
+		switch comp.Type {
+		case vg.MoveComp:
+			got = append(got, []vg.Point{comp.Pos})
+		case vg.LineComp:
+			got[len(got)-1] = append(got[len(got)-1], comp.Pos)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected fill regions:\ngot: %v\nwant:%v", got, want)
+	}
+}
+
+func TestNewBand(t *testing.T) {
+	_, err := plotter.NewBand(
+		plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 0}},
+		plotter.XYs{{X: 0, Y: 1}},
+	)
+	if err == nil {
+		t.Error("expected error for curves of different lengths")
+	}
+	_, err = plotter.NewBand(
+		plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 0}},
+		plotter.XYs{{X: 0, Y: 1}, {X: 2, Y: 1}},
+	)
+	if err == nil {
+		t.Error("expected error for curves with different X values")
+	}
+
+	l, b, err := plotter.NewLineBand(
+		plotter.XYs{{X: 0, Y: 1}, {X: 1, Y: 1}},
+		plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 0}},
+		plotter.XYs{{X: 0, Y: 2}, {X: 1, Y: 2}},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r, g, bl, _ := l.Color.RGBA()
+	want := color.NRGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(bl >> 8), A: 0x40}
+	if b.Color != want {
+		t.Errorf("unexpected band color: got:%v want:%v", b.Color, want)
+	}
+}
@@ -45,11 +45,47 @@ type BarChart struct {
 	// locations and distances.
 	Horizontal bool
 
+	// ValueFormat returns the label of a bar given its
+	// value. The label of a bar of a stacked chart is
+	// the value of its segment.
+	// No value labels are drawn if ValueFormat is nil.
+	ValueFormat func(v float64) string
+
+	// ValueLabel is the style of the value labels. Its
+	// alignment is set when the labels are drawn,
+	// according to the ValuePlacement.
+	ValueLabel draw.TextStyle
+
+	// ValuePlacement specifies whether the value labels
+	// are drawn outside the end of the bars, the default,
+	// or inside the bars along their end. The labels of
+	// the lower charts of a stack are best drawn inside
+	// the bars.
+	ValuePlacement BarLabelPlacement
+
+	// ValuePadding is the distance between the end
+	// of the bars and their value labels.
+	ValuePadding vg.Length
+
 	// stackedOn is the bar chart upon which
 	// this bar chart is stacked.
 	stackedOn *BarChart
 }
 
+// BarLabelPlacement specifies where the value labels
+// of a bar chart are drawn.
+type BarLabelPlacement int
+
+const (
+	// BarLabelOutside draws the labels beyond
+	// the end of the bars.
+	BarLabelOutside BarLabelPlacement = iota
+
+	// BarLabelInside draws the labels within
+	// the bars, along their end.
+	BarLabelInside
+)
+
 // NewBarChart returns a new bar chart with a single bar for each value.
 // The bars heights correspond to the values and their x locations correspond
 // to the index of their value in the Valuer.
@@ -61,11 +97,21 @@ func NewBarChart(vs Valuer, width vg.Length) (*BarChart, error) {
 	if err != nil {
 		return nil, err
 	}
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
 	return &BarChart{
 		Values:    values,
 		Width:     width,
 		Color:     color.Black,
 		LineStyle: DefaultLineStyle,
+		ValueLabel: draw.TextStyle{
+			Color:   color.Black,
+			Font:    fnt,
+			Handler: plot.DefaultTextHandler,
+		},
+		ValuePadding: vg.Points(2),
 	}, nil
 }
 
@@ -87,11 +133,13 @@ func (b *BarChart) BarHeight(i int) float64 {
 }
 
 // StackOn stacks a bar chart on top of another,
-// and sets the XMin and Offset to that of the
-// chart upon which it is being stacked.
+// and sets the XMin, Offset and orientation to that
+// of the chart upon which it is being stacked.
+// Horizontal bar charts are stacked from left to right.
 func (b *BarChart) StackOn(on *BarChart) {
 	b.XMin = on.XMin
 	b.Offset = on.Offset
+	b.Horizontal = on.Horizontal
 	b.stackedOn = on
 }
 
@@ -150,9 +198,51 @@ func (b *BarChart) Plot(c draw.Canvas, plt *plot.Plot) {
 			outline = c.ClipLinesX(pts)
 		}
 		c.StrokeLines(b.LineStyle, outline...)
+
+		if b.ValueFormat == nil {
+			continue
+		}
+		sty, off, label := b.valueLabel(i, valMax >= valMin)
+		pt := vg.Point{X: catMin + b.Width/2, Y: valMax}
+		if b.Horizontal {
+			pt = vg.Point{X: valMax, Y: pt.X}
+		}
+		if c.Contains(pt) {
+			c.FillText(sty, pt.Add(off), label)
+		}
 	}
 }
 
+// valueLabel returns the style, the offset from the middle of
+// the end of the bar and the text of the value label of the
+// ith bar. The bar extends towards increasing canvas coordinates
+// if up is true.
+func (b *BarChart) valueLabel(i int, up bool) (sty draw.TextStyle, off vg.Point, label string) {
+	sty = b.ValueLabel
+	pad := b.ValuePadding
+	// The label is drawn beyond the end of the bar
+	// if it is outside a bar extending upwards, or
+	// inside a bar extending downwards.
+	beyond := up == (b.ValuePlacement == BarLabelOutside)
+	if !beyond {
+		pad = -pad
+	}
+	if !b.Horizontal {
+		sty.XAlign, sty.YAlign = draw.XCenter, draw.YBottom
+		if !beyond {
+			sty.YAlign = draw.YTop
+		}
+		off.Y = pad
+	} else {
+		sty.XAlign, sty.YAlign = draw.XLeft, draw.YCenter
+		if !beyond {
+			sty.XAlign = draw.XRight
+		}
+		off.X = pad
+	}
+	return sty, off, b.ValueFormat(b.Values[i])
+}
+
 // DataRange implements the plot.DataRanger interface.
 func (b *BarChart) DataRange() (xmin, xmax, ymin, ymax float64) {
 	catMin := b.XMin
@@ -173,6 +263,8 @@ func (b *BarChart) DataRange() (xmin, xmax, ymin, ymax float64) {
 }
 
 // GlyphBoxes implements the GlyphBoxer interface.
+// The value labels drawn outside the bars have
+// GlyphBoxes of their own.
 func (b *BarChart) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
 	boxes := make([]plot.GlyphBox, len(b.Values))
 	for i := range b.Values {
@@ -191,6 +283,31 @@ func (b *BarChart) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
 			}
 		}
 	}
+	if b.ValueFormat == nil || b.ValuePlacement != BarLabelOutside {
+		return boxes
+	}
+	for i, ht := range b.Values {
+		cat := b.XMin + float64(i)
+		bottom := b.stackedOn.BarHeight(i)
+		var box plot.GlyphBox
+		var up bool
+		if !b.Horizontal {
+			box.X, box.Y = plt.X.Norm(cat), plt.Y.Norm(bottom+ht)
+			up = box.Y >= plt.Y.Norm(bottom)
+		} else {
+			box.X, box.Y = plt.X.Norm(bottom+ht), plt.Y.Norm(cat)
+			up = box.X >= plt.X.Norm(bottom)
+		}
+		sty, off, label := b.valueLabel(i, up)
+		if !b.Horizontal {
+			off.X = b.Offset
+		} else {
+			off.Y = b.Offset
+		}
+		r := sty.Rectangle(label)
+		box.Rectangle = vg.Rectangle{Min: r.Min.Add(off), Max: r.Max.Add(off)}
+		boxes = append(boxes, box)
+	}
 	return boxes
 }
 
@@ -5,9 +5,16 @@
 package plotter_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
+	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 func TestBarChart(t *testing.T) {
@@ -19,3 +26,191 @@ func TestBarChart(t *testing.T) {
 func TestBarChart_positiveNegative(t *testing.T) {
 	cmpimg.CheckPlot(ExampleBarChart_positiveNegative, t, "barChart_positiveNegative.png")
 }
+
+func TestBarChart_horizontalStack(t *testing.T) {
+	a, err := plotter.NewBarChart(plotter.Values{1, 2, 3}, 10)
+	if err != nil {
+		t.Fatalf("could not create bar chart: %+v", err)
+	}
+	a.Horizontal = true
+	a.XMin = 1
+	a.Offset = 2
+	b, err := plotter.NewBarChart(plotter.Values{4, 5, 6}, 10)
+	if err != nil {
+		t.Fatalf("could not create bar chart: %+v", err)
+	}
+	b.StackOn(a)
+	c, err := plotter.NewBarChart(plotter.Values{-1, 7, 8}, 10)
+	if err != nil {
+		t.Fatalf("could not create bar chart: %+v", err)
+	}
+	c.StackOn(b)
+
+	for i, test := range []struct {
+		bars                   *plotter.BarChart
+		xmin, xmax, ymin, ymax float64
+	}{
+		{bars: a, xmin: 0, xmax: 3, ymin: 1, ymax: 3},
+		{bars: b, xmin: 1, xmax: 9, ymin: 1, ymax: 3},
+		{bars: c, xmin: 4, xmax: 17, ymin: 1, ymax: 3},
+	} {
+		if !test.bars.Horizontal {
+			t.Errorf("bars %d: stacked bar chart is not horizontal", i)
+		}
+		xmin, xmax, ymin, ymax := test.bars.DataRange()
+		if xmin != test.xmin || xmax != test.xmax || ymin != test.ymin || ymax != test.ymax {
+			t.Errorf("bars %d: got range (%v, %v, %v, %v), want (%v, %v, %v, %v)",
+				i, xmin, xmax, ymin, ymax, test.xmin, test.xmax, test.ymin, test.ymax)
+		}
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %+v", err)
+	}
+	p.Add(a, b, c)
+	if p.X.Min != 0 || p.X.Max != 17 || p.Y.Min != 1 || p.Y.Max != 3 {
+		t.Errorf("got plot range X=[%v, %v] Y=[%v, %v], want X=[0, 17] Y=[1, 3]",
+			p.X.Min, p.X.Max, p.Y.Min, p.Y.Max)
+	}
+	for _, box := range c.GlyphBoxes(p) {
+		if box.Min.Y != -3 || box.Max.Y != 7 || box.Size().X != 0 {
+			t.Errorf("invalid glyph box for horizontal bars: %+v", box.Rectangle)
+		}
+	}
+}
+
+func TestBarChartValueLabels(t *testing.T) {
+	format := func(v float64) string { return fmt.Sprintf("v=%g", v) }
+	newBars := func(vs plotter.Values, placement plotter.BarLabelPlacement) *plotter.BarChart {
+		b, err := plotter.NewBarChart(vs, 10)
+		if err != nil {
+			t.Fatalf("could not create bar chart: %+v", err)
+		}
+		b.ValueFormat = format
+		b.ValuePlacement = placement
+		return b
+	}
+	simple := newBars(plotter.Values{3, -2, 5}, plotter.BarLabelOutside)
+	lower := newBars(plotter.Values{3, 2}, plotter.BarLabelInside)
+	upper := newBars(plotter.Values{1, 4}, plotter.BarLabelInside)
+	upper.StackOn(lower)
+
+	type label struct {
+		text     string
+		cat, end float64
+		below    bool
+	}
+	for _, test := range []struct {
+		name string
+		bars []*plotter.BarChart
+		want []label
+	}{
+		{
+			name: "simple",
+			bars: []*plotter.BarChart{simple},
+			want: []label{
+				{text: "v=3", cat: 0, end: 3},
+				{text: "v=-2", cat: 1, end: -2, below: true},
+				{text: "v=5", cat: 2, end: 5},
+			},
+		},
+		{
+			// The labels of the stacked segments
+			// are their own values.
+			name: "stacked",
+			bars: []*plotter.BarChart{lower, upper},
+			want: []label{
+				{text: "v=3", cat: 0, end: 3, below: true},
+				{text: "v=2", cat: 1, end: 2, below: true},
+				{text: "v=1", cat: 0, end: 4, below: true},
+				{text: "v=4", cat: 1, end: 6, below: true},
+			},
+		},
+	} {
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("could not create plot: %+v", err)
+		}
+		for _, b := range test.bars {
+			p.Add(b)
+		}
+		p.X.Min, p.X.Max = -1, 3
+		p.Y.Min, p.Y.Max = -5, 10
+
+		var rec recorder.Canvas
+		c := draw.NewCanvas(&rec, 300, 200)
+		p.Draw(c)
+		texts := func(rec *recorder.Canvas) []*recorder.FillString {
+			var fs []*recorder.FillString
+			for _, a := range rec.Actions {
+				if a, ok := a.(*recorder.FillString); ok && strings.HasPrefix(a.String, "v=") {
+					fs = append(fs, a)
+				}
+			}
+			return fs
+		}
+		got := texts(&rec)
+
+		// The labels are drawn as the text of the
+		// label style centered on the bar, just
+		// beyond or before its end.
+		da := p.DataCanvas(c)
+		var want recorder.Canvas
+		wc := draw.NewCanvas(&want, 300, 200)
+		for _, l := range test.want {
+			b := test.bars[0]
+			sty := b.ValueLabel
+			sty.XAlign, sty.YAlign = draw.XCenter, draw.YBottom
+			pt := vg.Point{X: da.X(p.X.Norm(l.cat)), Y: da.Y(p.Y.Norm(l.end)) + b.ValuePadding}
+			if l.below {
+				sty.YAlign = draw.YTop
+				pt.Y -= 2 * b.ValuePadding
+			}
+			wc.FillText(sty, pt, l.text)
+		}
+		wantTexts := texts(&want)
+		if len(got) != len(wantTexts) {
+			t.Fatalf("%s: unexpected number of labels: got:%d want:%d", test.name, len(got), len(wantTexts))
+		}
+		for i, w := range wantTexts {
+			if got[i].String != w.String || !closeTo(got[i].Point.X, w.Point.X) || !closeTo(got[i].Point.Y, w.Point.Y) {
+				t.Errorf("%s: unexpected label %d: got:%q at %v want:%q at %v",
+					test.name, i, got[i].String, got[i].Point, w.String, w.Point)
+			}
+		}
+	}
+
+	// Only the labels drawn outside the
+	// bars have glyph boxes.
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %+v", err)
+	}
+	p.Add(simple)
+	boxes := simple.GlyphBoxes(p)
+	if len(boxes) != 2*len(simple.Values) {
+		t.Fatalf("unexpected number of glyph boxes: got:%d want:%d", len(boxes), 2*len(simple.Values))
+	}
+	for i, v := range simple.Values {
+		box := boxes[len(simple.Values)+i]
+		if box.X != p.X.Norm(float64(i)) || box.Y != p.Y.Norm(v) {
+			t.Errorf("unexpected location of label box %d: got:(%v, %v)", i, box.X, box.Y)
+		}
+		h := simple.ValueLabel.Height(format(v))
+		w := simple.ValueLabel.Width(format(v))
+		want := vg.Rectangle{
+			Min: vg.Point{X: -w / 2, Y: simple.ValuePadding},
+			Max: vg.Point{X: w / 2, Y: simple.ValuePadding + h},
+		}
+		if v < 0 {
+			want.Min.Y, want.Max.Y = -simple.ValuePadding-h, -simple.ValuePadding
+		}
+		if !closeRect(box.Rectangle, want) {
+			t.Errorf("unexpected label box %d: got:%v want:%v", i, box.Rectangle, want)
+		}
+	}
+	if n := len(lower.GlyphBoxes(p)); n != len(lower.Values) {
+		t.Errorf("unexpected number of glyph boxes for inside labels: got:%d want:%d", n, len(lower.Values))
+	}
+}
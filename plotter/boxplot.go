@@ -92,7 +92,8 @@ type BoxPlot struct {
 // value that is outside of the fences are drawn as
 // Outside points.  The adjacent values (to which the
 // whiskers stretch) are the minimum and maximum
-// values that are not outside the fences.
+// values that are not outside the fences. Other fences
+// can be set with SetWhiskers.
 func NewBoxPlot(w vg.Length, loc float64, values Valuer) (*BoxPlot, error) {
 	if w < 0 {
 		return nil, errors.New("plotter: negative boxplot width")
@@ -151,10 +152,19 @@ func newFiveStat(w vg.Length, loc float64, values Valuer) (fiveStatPlot, error)
 	b.Min = sorted[0]
 	b.Max = sorted[len(sorted)-1]
 
-	low := b.Quartile1 - 1.5*(b.Quartile3-b.Quartile1)
-	high := b.Quartile3 + 1.5*(b.Quartile3-b.Quartile1)
+	b.setWhiskers(sorted, TukeyWhiskers)
+
+	return b, nil
+}
+
+// setWhiskers sets the adjacent values and the outside
+// points of the plot from the fences returned by f for the
+// sorted values.
+func (b *fiveStatPlot) setWhiskers(sorted Values, f WhiskerFunc) {
+	low, high := f(sorted, b.Quartile1, b.Quartile3)
 	b.AdjLow = math.Inf(1)
 	b.AdjHigh = math.Inf(-1)
+	b.Outside = nil
 	for i, v := range b.Values {
 		if v > high || v < low {
 			b.Outside = append(b.Outside, i)
@@ -167,8 +177,51 @@ func newFiveStat(w vg.Length, loc float64, values Valuer) (fiveStatPlot, error)
 			b.AdjHigh = v
 		}
 	}
+}
 
-	return b, nil
+// WhiskerFunc returns the low and high fences of a box plot
+// of the given sorted values, with the given first and third
+// quartiles. The values outside of the fences are drawn as
+// outside points, and the whiskers are drawn to the extreme
+// values within the fences.
+type WhiskerFunc func(sorted Values, q1, q3 float64) (low, high float64)
+
+// TukeyWhiskers is a WhiskerFunc placing the fences 1.5 times
+// the interquartile range below the first quartile and above
+// the third quartile, as in Tukey's schematic plots. It is the
+// default of NewBoxPlot.
+func TukeyWhiskers(_ Values, q1, q3 float64) (low, high float64) {
+	iqr := q3 - q1
+	return q1 - 1.5*iqr, q3 + 1.5*iqr
+}
+
+// MinMaxWhiskers is a WhiskerFunc placing the fences at the
+// extreme values, so that the whiskers span all the values
+// and there are no outside points.
+func MinMaxWhiskers(sorted Values, _, _ float64) (low, high float64) {
+	return sorted[0], sorted[len(sorted)-1]
+}
+
+// PercentileWhiskers returns a WhiskerFunc placing the fences
+// at the low and high percentiles of the values, between 0 and
+// 100. The percentiles are interpolated linearly between the
+// values, so the whiskers are drawn to the closest values within
+// the percentiles.
+func PercentileWhiskers(low, high float64) WhiskerFunc {
+	return func(sorted Values, _, _ float64) (float64, float64) {
+		return percentile(sorted, low), percentile(sorted, high)
+	}
+}
+
+// percentile returns the pth percentile of the sorted values,
+// interpolating linearly between the closest ranks.
+func percentile(sorted Values, p float64) float64 {
+	h := float64(len(sorted)-1) * math.Max(0, math.Min(100, p)) / 100
+	i := int(h)
+	if i >= len(sorted)-1 {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[i] + (h-float64(i))*(sorted[i+1]-sorted[i])
 }
 
 // median returns the median value from a
@@ -185,6 +238,19 @@ func median(vs Values) float64 {
 	return med
 }
 
+// SetWhiskers sets the adjacent values and the outside points
+// of the box plot from the fences returned by f, replacing the
+// default Tukey fences.
+func (b *BoxPlot) SetWhiskers(f WhiskerFunc) {
+	if len(b.Values) == 0 {
+		return
+	}
+	sorted := make(Values, len(b.Values))
+	copy(sorted, b.Values)
+	sort.Float64s(sorted)
+	b.setWhiskers(sorted, f)
+}
+
 // Plot draws the BoxPlot on Canvas c and Plot plt.
 func (b *BoxPlot) Plot(c draw.Canvas, plt *plot.Plot) {
 	if b.Horizontal {
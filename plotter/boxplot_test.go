@@ -5,12 +5,49 @@
 package plotter_test
 
 import (
+	"reflect"
 	"testing"
 
 	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
 )
 
 func TestBoxPlot(t *testing.T) {
 	cmpimg.CheckPlot(ExampleBoxPlot, t, "verticalBoxPlot.png",
 		"horizontalBoxPlot.png", "groupedBoxPlot.png")
 }
+
+func TestBoxPlotWhiskers(t *testing.T) {
+	// The values 1 to 10, with an outlier at index 3.
+	values := plotter.Values{1, 2, 3, 100, 4, 5, 6, 7, 8, 9, 10}
+
+	for _, test := range []struct {
+		name    string
+		f       plotter.WhiskerFunc
+		low     float64
+		high    float64
+		outside []int
+	}{
+		// The quartiles are 3 and 8.5, so the Tukey fences
+		// are at -5.25 and 16.75.
+		{name: "default", low: 1, high: 10, outside: []int{3}},
+		{name: "tukey", f: plotter.TukeyWhiskers, low: 1, high: 10, outside: []int{3}},
+		{name: "minmax", f: plotter.MinMaxWhiskers, low: 1, high: 100},
+		{name: "percentile", f: plotter.PercentileWhiskers(10, 90), low: 2, high: 10, outside: []int{0, 3}},
+	} {
+		b, err := plotter.NewBoxPlot(10, 0, values)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if test.f != nil {
+			b.SetWhiskers(test.f)
+		}
+		if b.AdjLow != test.low || b.AdjHigh != test.high {
+			t.Errorf("%s: unexpected adjacent values: got:[%v,%v] want:[%v,%v]",
+				test.name, b.AdjLow, b.AdjHigh, test.low, test.high)
+		}
+		if !reflect.DeepEqual(b.Outside, test.outside) {
+			t.Errorf("%s: unexpected outside points: got:%v want:%v", test.name, b.Outside, test.outside)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// BubbleLegend implements the Plotter interface, drawing a
+// legend for a chart encoding two values of each point by the
+// size and the color of its marker, such as a Scatter with a
+// GlyphStyleFunc or Bubbles. The legend shows a column of
+// markers of representative sizes labelled with their values,
+// above a strip of the colors of the color values, labelled
+// at the ticks of its range.
+//
+// The legend is drawn from the top left corner of the data
+// area of the plot it is added to, ignoring the axes. Like a
+// ColorBar, it is usually drawn as a separate narrow plot next
+// to the chart it describes, with hidden axes.
+type BubbleLegend struct {
+	// Radius returns the radius of the marker of a size
+	// value. It is the size scaling function of the chart,
+	// such as the Radius of Bubbles.
+	Radius func(v float64) vg.Length
+
+	// Sizes are the size values of the markers
+	// of the legend, in increasing order.
+	Sizes []float64
+
+	// ColorMap maps the color values of the chart to
+	// colors. The strip of colors is not drawn if the
+	// ColorMap is nil.
+	ColorMap palette.ColorMap
+
+	// Color is the fill color of the markers.
+	Color color.Color
+
+	// LineStyle is the style of the outline of
+	// the markers. Use zero width to disable
+	// outlines.
+	draw.LineStyle
+
+	// TextStyle is the style of the labels.
+	TextStyle draw.TextStyle
+
+	// Ticker returns the labels of the strip of colors.
+	// Only major ticks in the range of the ColorMap are
+	// labelled.
+	Ticker plot.Ticker
+
+	// StripWidth is the width of the strip of colors.
+	// The strip takes the height of the data area left
+	// below the markers.
+	StripWidth vg.Length
+
+	// Padding is the distance between the markers, the
+	// strip and their labels.
+	Padding vg.Length
+}
+
+// NewBubbleLegend returns a BubbleLegend for the size scaling
+// function radius of values between min and max and for the
+// color map, showing at most n markers with sizes picked by
+// BubbleLegendSizes. The markers are filled with a semi-transparent
+// gray and are outlined with the default line style.
+//
+// An error is returned if n is not positive, or if min or max is
+// infinite or NaN.
+func NewBubbleLegend(radius func(float64) vg.Length, min, max float64, n int, cm palette.ColorMap) (*BubbleLegend, error) {
+	if n <= 0 {
+		return nil, errors.New("plotter: bubble legend with non-positive number of sizes")
+	}
+	if err := CheckFloats(min, max); err != nil {
+		return nil, err
+	}
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
+	return &BubbleLegend{
+		Radius:    radius,
+		Sizes:     BubbleLegendSizes(min, max, n),
+		ColorMap:  cm,
+		Color:     color.NRGBA{A: 0x80},
+		LineStyle: DefaultLineStyle,
+		TextStyle: draw.TextStyle{
+			Color:   color.Black,
+			Font:    fnt,
+			Handler: plot.DefaultTextHandler,
+		},
+		Ticker:     plot.DefaultTicks{},
+		StripWidth: vg.Points(10),
+		Padding:    vg.Points(4),
+	}, nil
+}
+
+// BubbleLegendSizes returns at most n representative values
+// between min and max, in increasing order, for the markers of
+// a BubbleLegend. The values are round values chosen as the
+// labels of an axis over the range by plot.DefaultTicks, thinned
+// out evenly to n values keeping the smallest and the largest.
+// The range itself is returned if it has no round values, and
+// the single value if min equals max.
+func BubbleLegendSizes(min, max float64, n int) []float64 {
+	if min > max {
+		min, max = max, min
+	}
+	if min == max || n == 1 {
+		return []float64{max}
+	}
+	var vs []float64
+	for _, t := range (plot.DefaultTicks{}).Ticks(min, max) {
+		if !t.IsMinor() && min <= t.Value && t.Value <= max {
+			vs = append(vs, t.Value)
+		}
+	}
+	if len(vs) < 2 {
+		vs = []float64{min, max}
+	}
+	if len(vs) <= n {
+		return vs
+	}
+	sizes := make([]float64, n)
+	for i := range sizes {
+		sizes[i] = vs[int(math.Round(float64(i*(len(vs)-1))/float64(n-1)))]
+	}
+	return sizes
+}
+
+// Plot draws the BubbleLegend, implementing the plot.Plotter
+// interface.
+func (l *BubbleLegend) Plot(c draw.Canvas, plt *plot.Plot) {
+	var rmax vg.Length
+	for _, v := range l.Sizes {
+		rmax = vg.Length(math.Max(float64(rmax), float64(l.Radius(v))))
+	}
+	rmax += l.LineStyle.Width / 2
+
+	sty := l.TextStyle
+	sty.XAlign = draw.XLeft
+	sty.YAlign = draw.YCenter
+	y := c.Max.Y
+	for _, v := range l.Sizes {
+		// The markers are centered on a vertical line,
+		// with their labels aligned on their right.
+		r := l.Radius(v)
+		pt := vg.Point{X: c.Min.X + rmax, Y: y - r - l.LineStyle.Width/2}
+		l.drawMarker(&c, pt, r)
+		c.FillText(sty, vg.Point{X: c.Min.X + 2*rmax + l.Padding, Y: pt.Y}, strconv.FormatFloat(v, 'g', -1, 64))
+		y = pt.Y - r - l.LineStyle.Width/2 - l.Padding
+	}
+
+	if l.ColorMap == nil || !(l.ColorMap.Min() < l.ColorMap.Max()) {
+		return
+	}
+	if len(l.Sizes) != 0 {
+		y -= l.Padding
+	}
+	min, max := l.ColorMap.Min(), l.ColorMap.Max()
+	bottom := c.Min.Y
+	if y <= bottom {
+		return
+	}
+	n := int(y - bottom)
+	if n < 1 {
+		n = 1
+	}
+	img := image.NewNRGBA64(image.Rect(0, 0, 1, n))
+	for i := 0; i < n; i++ {
+		col, err := l.ColorMap.At(min + (max-min)*(float64(i)+0.5)/float64(n))
+		if err != nil {
+			panic(err)
+		}
+		img.Set(0, n-1-i, col)
+	}
+	c.DrawImage(vg.Rectangle{
+		Min: vg.Point{X: c.Min.X, Y: bottom},
+		Max: vg.Point{X: c.Min.X + l.StripWidth, Y: y},
+	}, img)
+	if l.Ticker == nil {
+		return
+	}
+	for _, t := range l.Ticker.Ticks(min, max) {
+		if t.IsMinor() || t.Value < min || t.Value > max {
+			continue
+		}
+		ty := bottom + (y-bottom)*vg.Length((t.Value-min)/(max-min))
+		c.FillText(sty, vg.Point{X: c.Min.X + l.StripWidth + l.Padding, Y: ty}, t.Label)
+	}
+}
+
+// drawMarker draws a marker of radius r at pt.
+func (l *BubbleLegend) drawMarker(c *draw.Canvas, pt vg.Point, r vg.Length) {
+	var p vg.Path
+	p.Move(vg.Point{X: pt.X + r, Y: pt.Y})
+	p.Arc(pt, r, 0, 2*math.Pi)
+	p.Close()
+	if l.Color != nil {
+		c.SetColor(l.Color)
+		c.Fill(p)
+	}
+	if l.LineStyle.Width != 0 {
+		c.SetLineStyle(l.LineStyle)
+		c.Stroke(p)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestBubbleLegend(t *testing.T) {
+	cmpimg.CheckPlot(ExampleBubbleLegend, t, "bubbleLegend.png")
+}
+
+func TestBubbleLegendSizes(t *testing.T) {
+	for _, test := range []struct {
+		min, max float64
+		n        int
+		want     []float64
+	}{
+		{min: 0, max: 100, n: 10, want: []float64{0, 50, 100}},
+		{min: 0, max: 100, n: 2, want: []float64{0, 100}},
+		{min: 0, max: 100, n: 1, want: []float64{100}},
+		{min: 100, max: 0, n: 3, want: []float64{0, 50, 100}},
+		{min: 3, max: 17, n: 3, want: []float64{5, 10, 15}},
+		{min: 5, max: 5, n: 4, want: []float64{5}},
+	} {
+		got := plotter.BubbleLegendSizes(test.min, test.max, test.n)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected sizes for [%v,%v] n=%d: got:%v want:%v", test.min, test.max, test.n, got, test.want)
+		}
+	}
+}
+
+func TestBubbleLegendMarkers(t *testing.T) {
+	radius := plotter.AreaRadius(0, 100, 2, 10)
+	l, err := plotter.NewBubbleLegend(radius, 0, 100, 3, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	l.LineStyle.Width = 0
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The markers are drawn with the radii given by
+	// the size scaling function, in a column from the
+	// top, and are labelled with their values.
+	var rec recorder.Canvas
+	l.Plot(draw.NewCanvas(&rec, 100, 100), p)
+	var (
+		radii  []vg.Length
+		labels []string
+	)
+	for _, a := range rec.Actions {
+		switch a := a.(type) {
+		case *recorder.Fill:
+			for _, c := range a.Path {
+				if c.Type == vg.ArcComp {
+					radii = append(radii, c.Radius)
+				}
+			}
+		case *recorder.FillString:
+			labels = append(labels, a.String)
+		}
+	}
+	var want []vg.Length
+	for _, v := range l.Sizes {
+		want = append(want, radius(v))
+	}
+	if !reflect.DeepEqual(radii, want) {
+		t.Errorf("unexpected radii: got:%v want:%v", radii, want)
+	}
+	if want := []string{"0", "50", "100"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("unexpected labels: got:%v want:%v", labels, want)
+	}
+
+	if _, err := plotter.NewBubbleLegend(radius, 0, 100, 0, moreland.SmoothBlueRed()); err == nil {
+		t.Errorf("expected an error for zero sizes")
+	}
+}
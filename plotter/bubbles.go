@@ -0,0 +1,164 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"math"
+	"sort"
+	"strconv"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Bubbles implements the Plotter interface, drawing a
+// bubble chart: a circle at the X and Y values of each
+// point, with a radius given by its Z value.
+type Bubbles struct {
+	// XYZs is a copy of the points of the chart.
+	XYZs
+
+	// Radius returns the radius of the bubble
+	// of a point with the given Z value.
+	Radius func(z float64) vg.Length
+
+	// Color is the fill color of the bubbles.
+	Color color.Color
+
+	// LineStyle is the style of the outline of the
+	// bubbles. Use zero width to disable outlines.
+	draw.LineStyle
+}
+
+// NewBubbles returns a bubble chart of the points, with bubbles
+// whose areas vary linearly with the Z values of the points, from
+// minRadius for the smallest Z value to maxRadius for the largest.
+// The bubbles are filled with a semi-transparent gray and are
+// outlined with the default line style.
+func NewBubbles(xyzs XYZer, minRadius, maxRadius vg.Length) (*Bubbles, error) {
+	data, err := CopyXYZs(xyzs)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	zmin, zmax := math.Inf(1), math.Inf(-1)
+	for _, p := range data {
+		zmin = math.Min(zmin, p.Z)
+		zmax = math.Max(zmax, p.Z)
+	}
+	return &Bubbles{
+		XYZs:      data,
+		Radius:    AreaRadius(zmin, zmax, minRadius, maxRadius),
+		Color:     color.NRGBA{A: 0x80},
+		LineStyle: DefaultLineStyle,
+	}, nil
+}
+
+// AreaRadius returns a function mapping values between min and
+// max to radii between minRadius and maxRadius, such that the
+// area of a circle of the returned radius varies linearly with
+// the value. Values outside of [min, max] are clamped to it.
+// If min equals max, all values are mapped to maxRadius.
+func AreaRadius(min, max float64, minRadius, maxRadius vg.Length) func(float64) vg.Length {
+	r0 := float64(minRadius * minRadius)
+	r1 := float64(maxRadius * maxRadius)
+	return func(v float64) vg.Length {
+		if min == max {
+			return maxRadius
+		}
+		f := math.Max(0, math.Min(1, (v-min)/(max-min)))
+		return vg.Length(math.Sqrt(r0 + f*(r1-r0)))
+	}
+}
+
+// Plot draws the Bubbles, implementing the plot.Plotter
+// interface. The largest bubbles are drawn first, so that
+// they do not hide the smaller ones.
+func (b *Bubbles) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+
+	order := make([]int, len(b.XYZs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return b.XYZs[order[i]].Z > b.XYZs[order[j]].Z
+	})
+
+	for _, i := range order {
+		p := b.XYZs[i]
+		pt := vg.Point{X: trX(p.X), Y: trY(p.Y)}
+		if !c.Contains(pt) {
+			continue
+		}
+		b.drawBubble(&c, pt, b.Radius(p.Z))
+	}
+}
+
+// drawBubble draws a bubble of radius r at pt.
+func (b *Bubbles) drawBubble(c *draw.Canvas, pt vg.Point, r vg.Length) {
+	var p vg.Path
+	p.Move(vg.Point{X: pt.X + r, Y: pt.Y})
+	p.Arc(pt, r, 0, 2*math.Pi)
+	p.Close()
+	if b.Color != nil {
+		c.SetColor(b.Color)
+		c.Fill(p)
+	}
+	if b.LineStyle.Width != 0 {
+		c.SetLineStyle(b.LineStyle)
+		c.Stroke(p)
+	}
+}
+
+// DataRange returns the minimum and maximum
+// x and y values, implementing the plot.DataRanger
+// interface.
+func (b *Bubbles) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return XYRange(XYValues{b.XYZs})
+}
+
+// GlyphBoxes returns a GlyphBox covering the bubble of
+// each point, implementing the plot.GlyphBoxer interface.
+func (b *Bubbles) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	bs := make([]plot.GlyphBox, len(b.XYZs))
+	for i, p := range b.XYZs {
+		r := b.Radius(p.Z) + b.LineStyle.Width/2
+		bs[i].X = plt.X.Norm(p.X)
+		bs[i].Y = plt.Y.Norm(p.Y)
+		bs[i].Rectangle = vg.Rectangle{
+			Min: vg.Point{X: -r, Y: -r},
+			Max: vg.Point{X: +r, Y: +r},
+		}
+	}
+	return bs
+}
+
+// AddToLegend adds an entry to the legend for each of the
+// given Z values, with the bubble of the value as its
+// thumbnail, so that the legend shows the scale of the
+// bubbles.
+func (b *Bubbles) AddToLegend(l *plot.Legend, zs ...float64) {
+	for _, z := range zs {
+		l.Add(strconv.FormatFloat(z, 'g', -1, 64), bubbleThumbnail{b: b, r: b.Radius(z)})
+	}
+}
+
+// bubbleThumbnail is the legend thumbnail
+// of a bubble of radius r.
+type bubbleThumbnail struct {
+	b *Bubbles
+	r vg.Length
+}
+
+// Thumbnail draws the bubble at the center of the
+// canvas, implementing the plot.Thumbnailer interface.
+func (t bubbleThumbnail) Thumbnail(c *draw.Canvas) {
+	t.b.drawBubble(c, c.Center(), t.r)
+}
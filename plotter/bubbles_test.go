@@ -5,11 +5,102 @@
 package plotter_test
 
 import (
+	"math"
+	"reflect"
 	"testing"
 
+	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 func TestNewBubbles(t *testing.T) {
 	cmpimg.CheckPlot(ExampleScatter_bubbles, t, "bubbles.png")
 }
+
+func TestBubbles(t *testing.T) {
+	cmpimg.CheckPlot(ExampleBubbles, t, "bubbleChart.png")
+}
+
+func TestAreaRadius(t *testing.T) {
+	r := plotter.AreaRadius(10, 20, 3, 4)
+	for _, test := range []struct {
+		v    float64
+		want vg.Length
+	}{
+		{v: 10, want: 3},
+		{v: 20, want: 4},
+		// Halfway between the areas of the smallest
+		// and largest bubbles.
+		{v: 15, want: vg.Length(math.Sqrt((9 + 16) / 2.0))},
+		// Clamped to the range.
+		{v: 0, want: 3},
+		{v: 30, want: 4},
+	} {
+		got := r(test.v)
+		if math.Abs(float64(got-test.want)) > 1e-12 {
+			t.Errorf("unexpected radius for %v: got:%v want:%v", test.v, got, test.want)
+		}
+	}
+
+	if got := plotter.AreaRadius(1, 1, 3, 4)(1); got != 4 {
+		t.Errorf("unexpected radius for empty range: got:%v want:4", got)
+	}
+}
+
+func TestBubblesGlyphBoxes(t *testing.T) {
+	b, err := plotter.NewBubbles(plotter.XYZs{
+		{X: 0, Y: 0, Z: 1},
+		{X: 1, Y: 1, Z: 4},
+		{X: 2, Y: 0, Z: 2.5},
+	}, 1, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b.LineStyle.Width = 0
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Add(b)
+	wantRadii := []vg.Length{1, 2, vg.Length(math.Sqrt(2.5))}
+	for i, box := range b.GlyphBoxes(p) {
+		want := vg.Rectangle{
+			Min: vg.Point{X: -wantRadii[i], Y: -wantRadii[i]},
+			Max: vg.Point{X: wantRadii[i], Y: wantRadii[i]},
+		}
+		if box.Rectangle != want {
+			t.Errorf("unexpected glyph box for point %d: got:%v want:%v", i, box.Rectangle, want)
+		}
+	}
+
+	b.AddToLegend(&p.Legend, 1, 4)
+	var rec recorder.Canvas
+	p.Legend.Draw(draw.NewCanvas(&rec, 100, 100))
+	var (
+		labels []string
+		radii  []vg.Length
+	)
+	for _, a := range rec.Actions {
+		switch a := a.(type) {
+		case *recorder.FillString:
+			labels = append(labels, a.String)
+		case *recorder.Fill:
+			for _, comp := range a.Path {
+				if comp.Type == vg.ArcComp {
+					radii = append(radii, comp.Radius)
+				}
+			}
+		}
+	}
+	if !reflect.DeepEqual(labels, []string{"1", "4"}) {
+		t.Errorf("unexpected legend labels: got:%q want:%q", labels, []string{"1", "4"})
+	}
+	if !reflect.DeepEqual(radii, []vg.Length{1, 2}) {
+		t.Errorf("unexpected legend bubble radii: got:%v want:%v", radii, []vg.Length{1, 2})
+	}
+}
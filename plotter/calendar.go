@@ -0,0 +1,298 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// DateValue is a value on a day.
+type DateValue struct {
+	// Date is the day of the value. Only its
+	// year, month and day are used.
+	Date time.Time
+
+	Value float64
+}
+
+// CalendarHeatmap implements the Plotter interface, drawing
+// a heat map of daily values laid out as a calendar: each
+// week is a column, at the positions 0 to n-1 along the X axis
+// for n weeks, and each day of a week is a cell of the column,
+// from Sunday at the top, at Y=6, to Saturday at the bottom,
+// at Y=0.
+//
+// The months are separated by lines along the borders of
+// their cells and labeled above the grid.
+type CalendarHeatmap struct {
+	// Cells is the heat map of the cells of the days,
+	// whose GridXYZ has a value for each day of the
+	// weeks of the calendar. The Palette, Min and Max
+	// of Cells can be used for a matching ColorBar.
+	//
+	// Days without a value, including the days of
+	// the first and the last weeks that are not in
+	// the range of the dates, are NaN and drawn with
+	// the NaN color of Cells, which is not drawn by
+	// default.
+	Cells *HeatMap
+
+	// Separator is the style of the lines
+	// separating the months.
+	// Use zero width to disable lines.
+	Separator draw.LineStyle
+
+	// MonthLabel is the style of the labels
+	// of the months.
+	MonthLabel draw.TextStyle
+
+	// MonthFormat is the time layout of the
+	// labels of the months.
+	// Use an empty format to disable labels.
+	MonthFormat string
+
+	// Padding is the distance between the
+	// labels of the months and the grid.
+	Padding vg.Length
+
+	// start is the first day of the dates.
+	start time.Time
+
+	// days is the number of days from
+	// start to the last day of the dates.
+	days int
+}
+
+// NewCalendarHeatmap returns a CalendarHeatmap for the given
+// values, colored through the palette. The values of dates on
+// the same day are summed. The months are separated by lines
+// in the default line style and labeled with their abbreviated
+// names in the DefaultFont and the DefaultFontSize.
+//
+// An error is returned if there are no values or if a value
+// is infinite or NaN.
+func NewCalendarHeatmap(vals []DateValue, p palette.Palette) (*CalendarHeatmap, error) {
+	if len(vals) == 0 {
+		return nil, ErrNoData
+	}
+	start, end := day(vals[0].Date), day(vals[0].Date)
+	for _, v := range vals {
+		if err := CheckFloats(v.Value); err != nil {
+			return nil, err
+		}
+		d := day(v.Date)
+		if d.Before(start) {
+			start = d
+		}
+		if d.After(end) {
+			end = d
+		}
+	}
+
+	h := &CalendarHeatmap{
+		start: start,
+		days:  daysBetween(start, end),
+	}
+	g := calendarGrid{z: make([]float64, 7*h.weeks())}
+	for i := range g.z {
+		g.z[i] = math.NaN()
+	}
+	for _, v := range vals {
+		i := int(start.Weekday()) + daysBetween(start, day(v.Date))
+		if math.IsNaN(g.z[i]) {
+			g.z[i] = 0
+		}
+		g.z[i] += v.Value
+	}
+	h.Cells = NewHeatMap(g, p)
+
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
+	h.Separator = DefaultLineStyle
+	h.MonthLabel = draw.TextStyle{
+		Color:   color.Black,
+		Font:    fnt,
+		YAlign:  draw.YBottom,
+		Handler: plot.DefaultTextHandler,
+	}
+	h.MonthFormat = "Jan"
+	h.Padding = vg.Points(2)
+	return h, nil
+}
+
+// day returns the midnight UTC of the day of t.
+func day(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween returns the number of days from
+// the day a to the day b.
+func daysBetween(a, b time.Time) int {
+	return int(b.Sub(a).Hours()/24 + 0.5)
+}
+
+// weeks returns the number of weeks of the calendar.
+func (h *CalendarHeatmap) weeks() int {
+	return (int(h.start.Weekday())+h.days)/7 + 1
+}
+
+// Cell returns the column and the row of the cell
+// of the given date in the grid of Cells, which are
+// its X and Y coordinates. The boolean is false if
+// the date is not in the range of the dates.
+func (h *CalendarHeatmap) Cell(date time.Time) (c, r int, ok bool) {
+	n := daysBetween(h.start, day(date))
+	if n < 0 || n > h.days {
+		return 0, 0, false
+	}
+	n += int(h.start.Weekday())
+	return n / 7, 6 - n%7, true
+}
+
+// month is a month of the calendar, starting on its first day,
+// or on the first day of the calendar for the first month.
+type month struct {
+	first time.Time
+
+	// col and row are the cell of the first day.
+	col, row int
+}
+
+// months returns the months of the calendar.
+func (h *CalendarHeatmap) months() []month {
+	var ms []month
+	end := h.start.AddDate(0, 0, h.days)
+	for d := h.start; !d.After(end); {
+		c, r, _ := h.Cell(d)
+		ms = append(ms, month{first: d, col: c, row: r})
+		d = time.Date(d.Year(), d.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return ms
+}
+
+// Plot draws the CalendarHeatmap, implementing the plot.Plotter
+// interface.
+func (h *CalendarHeatmap) Plot(c draw.Canvas, plt *plot.Plot) {
+	h.Cells.Plot(c, plt)
+	trX, trY := plt.Transforms(&c)
+
+	months := h.months()
+	if h.Separator.Width > 0 {
+		for _, m := range months[1:] {
+			x, y := float64(m.col), float64(m.row)
+			var line []vg.Point
+			if m.row == 6 {
+				line = []vg.Point{
+					{X: trX(x - 0.5), Y: trY(6.5)},
+					{X: trX(x - 0.5), Y: trY(-0.5)},
+				}
+			} else {
+				line = []vg.Point{
+					{X: trX(x + 0.5), Y: trY(6.5)},
+					{X: trX(x + 0.5), Y: trY(y + 0.5)},
+					{X: trX(x - 0.5), Y: trY(y + 0.5)},
+					{X: trX(x - 0.5), Y: trY(-0.5)},
+				}
+			}
+			c.StrokeLines(h.Separator, c.ClipLinesXY(line)...)
+		}
+	}
+
+	if h.MonthFormat == "" {
+		return
+	}
+	for _, m := range months {
+		col, ok := h.labelColumn(m)
+		if !ok {
+			continue
+		}
+		x := trX(float64(col) - 0.5)
+		if !c.ContainsX(x) {
+			continue
+		}
+		pt := vg.Point{X: x, Y: trY(6.5) + h.Padding}
+		c.FillText(h.MonthLabel, pt, m.first.Format(h.MonthFormat))
+	}
+}
+
+// labelColumn returns the column of the label of the month,
+// the first column whose Sunday is in the month. The boolean
+// is false if there is no such column.
+func (h *CalendarHeatmap) labelColumn(m month) (int, bool) {
+	col := m.col
+	if m.row != 6 {
+		col++
+	}
+	sunday := m.first.AddDate(0, 0, (7-int(m.first.Weekday()))%7)
+	if sunday.Month() != m.first.Month() || col >= h.weeks() {
+		return 0, false
+	}
+	return col, true
+}
+
+// DataRange returns the minimum and maximum x and y values,
+// implementing the plot.DataRanger interface. The range
+// covers the cells of the weeks of the calendar.
+func (h *CalendarHeatmap) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return h.Cells.DataRange()
+}
+
+// GlyphBoxes returns a GlyphBox for each of the labels of the
+// months, implementing the plot.GlyphBoxer interface.
+func (h *CalendarHeatmap) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	if h.MonthFormat == "" {
+		return nil
+	}
+	var boxes []plot.GlyphBox
+	for _, m := range h.months() {
+		col, ok := h.labelColumn(m)
+		if !ok {
+			continue
+		}
+		r := h.MonthLabel.Rectangle(m.first.Format(h.MonthFormat))
+		off := vg.Point{Y: h.Padding}
+		boxes = append(boxes, plot.GlyphBox{
+			X:         plt.X.Norm(float64(col) - 0.5),
+			Y:         plt.Y.Norm(6.5),
+			Rectangle: vg.Rectangle{Min: r.Min.Add(off), Max: r.Max.Add(off)},
+		})
+	}
+	return boxes
+}
+
+// calendarGrid implements the GridXYZ interface for the
+// cells of a calendar, with a column for each week and
+// the days of the week from Saturday, in the first row,
+// to Sunday, in the last row.
+type calendarGrid struct {
+	// z holds the values of the days of the weeks, from
+	// the Sunday of the week of the first day.
+	z []float64
+}
+
+func (g calendarGrid) Dims() (c, r int)   { return len(g.z) / 7, 7 }
+func (g calendarGrid) Z(c, r int) float64 { return g.z[7*c+6-r] }
+func (g calendarGrid) X(c int) float64 {
+	if c < 0 || c >= len(g.z)/7 {
+		panic("index out of range")
+	}
+	return float64(c)
+}
+func (g calendarGrid) Y(r int) float64 {
+	if r < 0 || r >= 7 {
+		panic("index out of range")
+	}
+	return float64(r)
+}
@@ -0,0 +1,123 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestCalendarHeatmap(t *testing.T) {
+	cmpimg.CheckPlot(ExampleCalendarHeatmap, t, "calendarHeatmap.png")
+}
+
+func TestCalendarHeatmapCells(t *testing.T) {
+	// From Wednesday 29 January to Tuesday 4 February 2020,
+	// in the weeks starting on Sunday 26 January and Sunday
+	// 2 February.
+	date := func(m time.Month, d int) time.Time {
+		return time.Date(2020, m, d, 12, 0, 0, 0, time.UTC)
+	}
+	var vals []plotter.DateValue
+	for d := date(time.January, 29); !d.After(date(time.February, 4)); d = d.AddDate(0, 0, 1) {
+		vals = append(vals, plotter.DateValue{Date: d, Value: float64(d.Day())})
+	}
+	vals = append(vals, plotter.DateValue{Date: date(time.February, 1), Value: 10})
+
+	h, err := plotter.NewCalendarHeatmap(vals, palette.Heat(10, 1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	c, r := h.Cells.GridXYZ.Dims()
+	if c != 2 || r != 7 {
+		t.Fatalf("unexpected grid dimensions: got:%dx%d want:2x7", c, r)
+	}
+	for _, test := range []struct {
+		date time.Time
+		c, r int
+		z    float64
+	}{
+		{date: date(time.January, 29), c: 0, r: 3, z: 29},
+		{date: date(time.January, 31), c: 0, r: 1, z: 31},
+		{date: date(time.February, 1), c: 0, r: 0, z: 11},
+		{date: date(time.February, 2), c: 1, r: 6, z: 2},
+		{date: date(time.February, 4), c: 1, r: 4, z: 4},
+	} {
+		c, r, ok := h.Cell(test.date)
+		if !ok || c != test.c || r != test.r {
+			t.Errorf("unexpected cell of %v: got:(%d, %d, %t) want:(%d, %d, true)",
+				test.date.Format("Jan 2"), c, r, ok, test.c, test.r)
+			continue
+		}
+		if z := h.Cells.GridXYZ.Z(c, r); z != test.z {
+			t.Errorf("unexpected value of %v: got:%v want:%v", test.date.Format("Jan 2"), z, test.z)
+		}
+	}
+	for _, cell := range [][2]int{{0, 6}, {0, 4}, {1, 3}, {1, 0}} {
+		if z := h.Cells.GridXYZ.Z(cell[0], cell[1]); !math.IsNaN(z) {
+			t.Errorf("unexpected value of day out of range at %v: got:%v want:NaN", cell, z)
+		}
+	}
+	if _, _, ok := h.Cell(date(time.February, 5)); ok {
+		t.Error("unexpected cell for day out of range")
+	}
+
+	xmin, xmax, ymin, ymax := h.DataRange()
+	if xmin != -0.5 || xmax != 1.5 || ymin != -0.5 || ymax != 6.5 {
+		t.Errorf("unexpected data range: got:%v want:[-0.5 1.5 -0.5 6.5]", []float64{xmin, xmax, ymin, ymax})
+	}
+
+	// The month separator is a step between
+	// Friday 31 January and Saturday 1 February.
+	plt, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	plt.X.Min, plt.X.Max, plt.Y.Min, plt.Y.Max = xmin, xmax, ymin, ymax
+	rec := new(recorder.Canvas)
+	dc := draw.NewCanvas(rec, 10*vg.Centimeter, 10*vg.Centimeter)
+	h.Plot(dc, plt)
+	trX, trY := plt.Transforms(&dc)
+	want := []vg.Point{
+		{X: trX(0.5), Y: trY(6.5)},
+		{X: trX(0.5), Y: trY(0.5)},
+		{X: trX(-0.5), Y: trY(0.5)},
+		{X: trX(-0.5), Y: trY(-0.5)},
+	}
+	var found bool
+	for _, a := range rec.Actions {
+		s, ok := a.(*recorder.Stroke)
+		if !ok {
+			continue
+		}
+		if found {
+			t.Errorf("unexpected extra stroke: %+v", s.Path)
+			continue
+		}
+		found = true
+		if len(s.Path) != len(want) {
+			t.Errorf("unexpected separator: got:%+v want:%v", s.Path, want)
+			continue
+		}
+		for i, p := range s.Path {
+			if math.Abs(float64(p.Pos.X-want[i].X)) > 1e-9 || math.Abs(float64(p.Pos.Y-want[i].Y)) > 1e-9 {
+				t.Errorf("unexpected separator point %d: got:%v want:%v", i, p.Pos, want[i])
+			}
+		}
+	}
+	if !found {
+		t.Error("missing month separator")
+	}
+}
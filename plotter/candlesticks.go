@@ -0,0 +1,180 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// OHLC is the open, high, low and close prices
+// of an asset over a period starting at Time.
+type OHLC struct {
+	Time                   float64
+	Open, High, Low, Close float64
+}
+
+// up returns whether the close price is at least the open price.
+func (r OHLC) up() bool { return r.Close >= r.Open }
+
+// Candlesticks implements the Plotter interface, drawing
+// a candlestick chart of open-high-low-close prices.
+type Candlesticks struct {
+	// Data is a copy of the records used
+	// to create the chart.
+	Data []OHLC
+
+	// Width is the width of the body of each candle.
+	// For OHLC bars, it is the length of the open and
+	// close ticks on both sides of the bar.
+	Width vg.Length
+
+	// UpColor and DownColor are the colors of candles
+	// whose close price is respectively not lower and
+	// lower than their open price.
+	UpColor, DownColor color.Color
+
+	// LineStyle is the style of the wicks and of the
+	// outline of the bodies. The color of the line style
+	// is ignored, the color of the candle is used instead.
+	draw.LineStyle
+
+	// Bars specifies whether the prices are drawn as
+	// OHLC bars instead of candlesticks. OHLC bars are
+	// vertical lines from the low to the high price with a
+	// tick on the left at the open price and a tick on the
+	// right at the close price.
+	Bars bool
+}
+
+// NewCandlesticks returns a Candlesticks plotter for the given
+// records, drawn with green up candles and red down candles.
+//
+// An error is returned if a record has a non-finite value or
+// if its high or low price does not bound its open and close
+// prices.
+func NewCandlesticks(data []OHLC) (*Candlesticks, error) {
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	cpy := make([]OHLC, len(data))
+	for i, r := range data {
+		if err := CheckFloats(r.Time, r.Open, r.High, r.Low, r.Close); err != nil {
+			return nil, err
+		}
+		if r.High < math.Max(r.Open, r.Close) || r.Low > math.Min(r.Open, r.Close) {
+			return nil, errors.New("plotter: open or close price outside high-low range")
+		}
+		cpy[i] = r
+	}
+	return &Candlesticks{
+		Data:      cpy,
+		Width:     vg.Points(6),
+		UpColor:   color.RGBA{G: 160, A: 255},
+		DownColor: color.RGBA{R: 196, A: 255},
+		LineStyle: DefaultLineStyle,
+	}, nil
+}
+
+// Plot draws the Candlesticks, implementing the plot.Plotter interface.
+func (cs *Candlesticks) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+
+	for _, r := range cs.Data {
+		x := trX(r.Time)
+		if !c.ContainsX(x) {
+			continue
+		}
+		col := cs.DownColor
+		if r.up() {
+			col = cs.UpColor
+		}
+		sty := cs.LineStyle
+		sty.Color = col
+
+		yo, yc := trY(r.Open), trY(r.Close)
+		wick := []vg.Point{{X: x, Y: trY(r.Low)}, {X: x, Y: trY(r.High)}}
+		c.StrokeLines(sty, c.ClipLinesY(wick)...)
+
+		half := cs.Width / 2
+		if cs.Bars {
+			c.StrokeLines(sty, c.ClipLinesY(
+				[]vg.Point{{X: x - half, Y: yo}, {X: x, Y: yo}},
+				[]vg.Point{{X: x, Y: yc}, {X: x + half, Y: yc}},
+			)...)
+			continue
+		}
+
+		body := []vg.Point{
+			{X: x - half, Y: yo},
+			{X: x + half, Y: yo},
+			{X: x + half, Y: yc},
+			{X: x - half, Y: yc},
+		}
+		if col != nil {
+			c.FillPolygon(col, c.ClipPolygonY(body))
+		}
+		body = append(body, body[0])
+		c.StrokeLines(sty, c.ClipLinesY(body)...)
+	}
+}
+
+// DataRange returns the minimum and maximum times
+// and prices, implementing the plot.DataRanger interface.
+func (cs *Candlesticks) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, xmax = math.Inf(1), math.Inf(-1)
+	ymin, ymax = math.Inf(1), math.Inf(-1)
+	for _, r := range cs.Data {
+		xmin = math.Min(xmin, r.Time)
+		xmax = math.Max(xmax, r.Time)
+		ymin = math.Min(ymin, r.Low)
+		ymax = math.Max(ymax, r.High)
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// GlyphBoxes returns a GlyphBox covering the width of
+// each candle, implementing the plot.GlyphBoxer interface.
+func (cs *Candlesticks) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	half := cs.Width/2 + cs.LineStyle.Width/2
+	bs := make([]plot.GlyphBox, len(cs.Data))
+	for i, r := range cs.Data {
+		bs[i].X = plt.X.Norm(r.Time)
+		bs[i].Y = plt.Y.Norm((r.Low + r.High) / 2)
+		bs[i].Rectangle = vg.Rectangle{
+			Min: vg.Point{X: -half},
+			Max: vg.Point{X: half},
+		}
+	}
+	return bs
+}
+
+// Thumbnail draws an up candle body, implementing
+// the plot.Thumbnailer interface.
+func (cs *Candlesticks) Thumbnail(c *draw.Canvas) {
+	sty := cs.LineStyle
+	sty.Color = cs.UpColor
+	if cs.Bars {
+		y := (c.Min.Y + c.Max.Y) / 2
+		c.StrokeLine2(sty, c.Min.X, y, c.Max.X, y)
+		return
+	}
+	pts := []vg.Point{
+		{X: c.Min.X, Y: c.Min.Y},
+		{X: c.Min.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Min.Y},
+	}
+	if cs.UpColor != nil {
+		c.FillPolygon(cs.UpColor, c.ClipPolygonY(pts))
+	}
+	pts = append(pts, pts[0])
+	c.StrokeLines(sty, c.ClipLinesY(pts)...)
+}
@@ -0,0 +1,108 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestCandlesticks(t *testing.T) {
+	cmpimg.CheckPlot(ExampleCandlesticks, t, "candlesticks.png")
+	cmpimg.CheckPlot(ExampleCandlesticks_bars, t, "ohlcBars.png")
+}
+
+func TestCandlesticksUpDown(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		data plotter.OHLC
+		up   bool
+	}{
+		{name: "up", data: plotter.OHLC{Time: 1, Open: 10, High: 14, Low: 9, Close: 13}, up: true},
+		{name: "down", data: plotter.OHLC{Time: 1, Open: 13, High: 14, Low: 9, Close: 10}, up: false},
+	} {
+		for _, bars := range []bool{false, true} {
+			cs, err := plotter.NewCandlesticks([]plotter.OHLC{test.data})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			cs.Bars = bars
+
+			xmin, xmax, ymin, ymax := cs.DataRange()
+			if xmin != 1 || xmax != 1 || ymin != 9 || ymax != 14 {
+				t.Errorf("%s: unexpected data range: got:[%v, %v]x[%v, %v] want:[1, 1]x[9, 14]",
+					test.name, xmin, xmax, ymin, ymax)
+			}
+
+			plt, err := plot.New()
+			if err != nil {
+				t.Fatalf("could not create plot: %+v", err)
+			}
+			plt.Add(cs)
+			plt.X.Min, plt.X.Max = 0, 2
+			c := new(recorder.Canvas)
+			cs.Plot(draw.NewCanvas(c, 10*vg.Centimeter, 10*vg.Centimeter), plt)
+
+			want, other := cs.UpColor, cs.DownColor
+			if !test.up {
+				want, other = other, want
+			}
+			var (
+				colors  []color.Color
+				strokes int
+			)
+			for _, a := range c.Actions {
+				switch a := a.(type) {
+				case *recorder.SetColor:
+					colors = append(colors, a.Color)
+				case *recorder.Stroke:
+					strokes++
+				}
+			}
+			for _, col := range colors {
+				if col == other {
+					t.Errorf("%s: unexpected use of color %v", test.name, other)
+				}
+				if col != want {
+					t.Errorf("%s: unexpected color: got:%v want:%v", test.name, col, want)
+				}
+			}
+			if len(colors) == 0 {
+				t.Errorf("%s: no color set", test.name)
+			}
+
+			// Candles have a wick and a body outline;
+			// bars have a wick and two ticks.
+			wantStrokes := 2
+			if bars {
+				wantStrokes = 3
+			}
+			if strokes != wantStrokes {
+				t.Errorf("%s: unexpected number of strokes with bars=%t: got:%d want:%d",
+					test.name, bars, strokes, wantStrokes)
+			}
+		}
+	}
+}
+
+func TestCandlesticksErrors(t *testing.T) {
+	for _, data := range [][]plotter.OHLC{
+		nil,
+		{{Open: 10, High: 12, Low: 9, Close: 13}},
+		{{Open: 8, High: 12, Low: 9, Close: 10}},
+	} {
+		_, err := plotter.NewCandlesticks(data)
+		if err == nil {
+			t.Errorf("expected error for %v", data)
+		}
+	}
+}
@@ -13,6 +13,17 @@ import (
 )
 
 // ColorBar is a plot.Plotter that draws a color bar legend for a ColorMap.
+//
+// The color bar fills the data area of the plot it is added to, and the
+// values of the color map are labeled by the ticks of the axis along the
+// bar. It is usually drawn as a separate narrow plot next to the plot it
+// describes, with the other axis hidden.
+//
+// The colors of the bar are spread uniformly along the axis. On an axis
+// with a linear scale, the color at the tick for a value is the color of
+// that value in the ColorMap. On an axis with a non-linear scale, such as
+// plot.LogScale, the bar matches a HeatMap using the same Scale and a
+// palette created from the ColorMap.
 type ColorBar struct {
 	ColorMap palette.ColorMap
 
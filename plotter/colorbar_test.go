@@ -5,9 +5,16 @@
 package plotter_test
 
 import (
+	"image/color"
 	"testing"
 
+	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
 )
 
 func TestColorBar_horizontal(t *testing.T) {
@@ -21,3 +28,85 @@ func TestColorBar_horizontal_log(t *testing.T) {
 func TestColorBar_vertical(t *testing.T) {
 	cmpimg.CheckPlot(ExampleColorBar_vertical, t, "colorBarVertical.png")
 }
+
+func TestColorBar_heatMap(t *testing.T) {
+	cmpimg.CheckPlot(ExampleColorBar_heatMap, t, "colorBarHeatMap.png")
+}
+
+func TestColorBarTicks(t *testing.T) {
+	for _, vertical := range []bool{false, true} {
+		cm := moreland.ExtendedBlackBody()
+		cm.SetMin(0)
+		cm.SetMax(50)
+
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("could not create plot: %v", err)
+		}
+		p.Add(&plotter.ColorBar{ColorMap: cm, Vertical: vertical})
+		axis := &p.X
+		if vertical {
+			p.HideX()
+			axis = &p.Y
+		} else {
+			p.HideY()
+		}
+		axis.Padding = 0
+
+		img := vgimg.NewWith(vgimg.UseWH(200, 200), vgimg.UseDPI(72))
+		dc := draw.New(img)
+		p.Draw(dc)
+		da := p.DataCanvas(dc)
+
+		// The color of the bar at each labeled tick must be
+		// the color of the tick value in the color map.
+		var n int
+		for _, tick := range axis.Tick.Marker.Ticks(axis.Min, axis.Max) {
+			if tick.IsMinor() {
+				continue
+			}
+			n++
+			pt := da.Center()
+			if vertical {
+				pt.Y = da.Y(axis.Norm(tick.Value))
+			} else {
+				pt.X = da.X(axis.Norm(tick.Value))
+			}
+			// Sample the pixel inside the bar, next to the tick.
+			var d vg.Length
+			switch tick.Value {
+			case axis.Min:
+				d = 1
+			case axis.Max:
+				d = -1
+			}
+			if vertical {
+				pt.Y += d
+			} else {
+				pt.X += d
+			}
+			x, y := int(pt.X), int(200-pt.Y)
+			got := color.NRGBAModel.Convert(img.Image().At(x, y)).(color.NRGBA)
+			wantc, err := cm.At(tick.Value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := color.NRGBAModel.Convert(wantc).(color.NRGBA)
+			if !near(got.R, want.R) || !near(got.G, want.G) || !near(got.B, want.B) {
+				t.Errorf("unexpected color at tick %v with vertical=%t: got:%v want:%v", tick.Value, vertical, got, want)
+			}
+		}
+		if n == 0 {
+			t.Errorf("no labeled ticks with vertical=%t", vertical)
+		}
+	}
+}
+
+// near returns whether the color components a and b
+// are within the color resolution of a ColorBar
+// drawn with one color per point.
+func near(a, b uint8) bool {
+	const tol = 12
+	d := int(a) - int(b)
+	return -tol <= d && d <= tol
+}
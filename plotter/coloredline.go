@@ -0,0 +1,132 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// ColoredLine implements the Plotter interface, drawing a line
+// connecting the X and Y values of the points, in order, with
+// each segment of the line colored according to the Z values
+// of the points, such as the time or the speed along a track.
+type ColoredLine struct {
+	// XYZs is a copy of the points of the line.
+	XYZs
+
+	// LineStyle is the style of the line. The color
+	// of the LineStyle is only used if the ColorMap
+	// is nil.
+	draw.LineStyle
+
+	// ColorMap is used to color the segments according
+	// to their values. The value of the segment between
+	// two points is the mean of the Z values of the points.
+	// Values outside the range of the ColorMap are clamped
+	// to it.
+	ColorMap palette.ColorMap
+
+	// min and max are the range of
+	// the Z values of the points.
+	min, max float64
+}
+
+// NewColoredLine returns a ColoredLine of the points drawn in the
+// default line style and colored by the color map. The range of
+// the color map is not changed, it can be set to the range of the
+// Z values returned by ValueRange.
+//
+// An error is returned if there are no points, or if a value is
+// infinite or NaN.
+func NewColoredLine(xyzs XYZer, cm palette.ColorMap) (*ColoredLine, error) {
+	data, err := CopyXYZs(xyzs)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, p := range data {
+		min = math.Min(min, p.Z)
+		max = math.Max(max, p.Z)
+	}
+	return &ColoredLine{
+		XYZs:      data,
+		LineStyle: DefaultLineStyle,
+		ColorMap:  cm,
+		min:       min,
+		max:       max,
+	}, nil
+}
+
+// ValueRange returns the minimum and maximum
+// Z values of the points. It can be used to set
+// the range of the ColorMap and of a ColorBar.
+func (l *ColoredLine) ValueRange() (min, max float64) {
+	return l.min, l.max
+}
+
+// SegmentColor returns the color of the ith segment of the
+// line, between the points i and i+1.
+func (l *ColoredLine) SegmentColor(i int) color.Color {
+	return l.color((l.XYZs[i].Z + l.XYZs[i+1].Z) / 2)
+}
+
+// color returns the color of the value v.
+func (l *ColoredLine) color(v float64) color.Color {
+	if l.ColorMap == nil {
+		return l.LineStyle.Color
+	}
+	col, err := l.ColorMap.At(math.Max(l.ColorMap.Min(), math.Min(l.ColorMap.Max(), v)))
+	if err != nil {
+		panic(err)
+	}
+	return col
+}
+
+// Plot draws the ColoredLine, implementing the plot.Plotter
+// interface.
+func (l *ColoredLine) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	for i := 0; i < len(l.XYZs)-1; i++ {
+		a, b := l.XYZs[i], l.XYZs[i+1]
+		sty := l.LineStyle
+		sty.Color = l.SegmentColor(i)
+		c.StrokeLines(sty, c.ClipLinesXY([]vg.Point{
+			{X: trX(a.X), Y: trY(a.Y)},
+			{X: trX(b.X), Y: trY(b.Y)},
+		})...)
+	}
+}
+
+// DataRange returns the minimum and maximum
+// x and y values, implementing the plot.DataRanger
+// interface.
+func (l *ColoredLine) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return XYRange(XYValues{l.XYZs})
+}
+
+// Thumbnail draws a line across the middle of the thumbnail,
+// colored from the smallest to the largest value of the points,
+// implementing the plot.Thumbnailer interface.
+func (l *ColoredLine) Thumbnail(c *draw.Canvas) {
+	const n = 8
+	y := c.Center().Y
+	w := c.Max.X - c.Min.X
+	for i := 0; i < n; i++ {
+		sty := l.LineStyle
+		sty.Color = l.color(l.min + (l.max-l.min)*(float64(i)+0.5)/n)
+		x0 := c.Min.X + w*vg.Length(i)/n
+		x1 := c.Min.X + w*vg.Length(i+1)/n
+		c.StrokeLine2(sty, x0, y, x1, y)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestColoredLine(t *testing.T) {
+	cmpimg.CheckPlot(ExampleColoredLine, t, "coloredLine.png")
+}
+
+func TestColoredLineSegments(t *testing.T) {
+	xyzs := plotter.XYZs{
+		{X: 0, Y: 0, Z: 0},
+		{X: 1, Y: 2, Z: 1},
+		{X: 2, Y: 1, Z: 2},
+		{X: 3, Y: 3, Z: 3},
+		{X: 4, Y: -1, Z: 4},
+	}
+	cm := moreland.SmoothBlueRed()
+	l, err := plotter.NewColoredLine(xyzs, cm)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	min, max := l.ValueRange()
+	if min != 0 || max != 4 {
+		t.Errorf("unexpected value range: got:[%g, %g] want:[0, 4]", min, max)
+	}
+	cm.SetMin(min)
+	cm.SetMax(max)
+	xmin, xmax, ymin, ymax := l.DataRange()
+	if xmin != 0 || xmax != 4 || ymin != -1 || ymax != 3 {
+		t.Errorf("unexpected data range: got:[%g, %g]×[%g, %g] want:[0, 4]×[-1, 3]",
+			xmin, xmax, ymin, ymax)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.X.Min, p.X.Max, p.Y.Min, p.Y.Max = xmin, xmax, ymin, ymax
+
+	var rec recorder.Canvas
+	l.Plot(draw.NewCanvas(&rec, 10*vg.Centimeter, 6*vg.Centimeter), p)
+
+	var colors []color.Color
+	for _, a := range rec.Actions {
+		switch a := a.(type) {
+		case *recorder.SetColor:
+			colors = append(colors, a.Color)
+		case *recorder.Stroke:
+			if len(colors) == 0 {
+				t.Fatal("segment stroked before setting its color")
+			}
+		}
+	}
+	if len(colors) != len(xyzs)-1 {
+		t.Fatalf("unexpected number of segment colors: got:%d want:%d", len(colors), len(xyzs)-1)
+	}
+	// The values increase along the line, so the segments
+	// step through the color map at the midpoints of the
+	// values of their points.
+	for i, col := range colors {
+		want, err := cm.At(float64(i) + 0.5)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !sameColor(col, want) || !sameColor(l.SegmentColor(i), want) {
+			t.Errorf("unexpected color of segment %d: got:%v want:%v", i, col, want)
+		}
+	}
+}
+
+func TestColoredLineNoData(t *testing.T) {
+	_, err := plotter.NewColoredLine(plotter.XYZs{}, moreland.SmoothBlueRed())
+	if err != plotter.ErrNoData {
+		t.Errorf("unexpected error: got:%v want:%v", err, plotter.ErrNoData)
+	}
+}
+
+func sameColor(a, b color.Color) bool {
+	r0, g0, b0, a0 := a.RGBA()
+	r1, g1, b1, a1 := b.RGBA()
+	return r0 == r1 && g0 == g1 && b0 == b1 && a0 == a1
+}
@@ -43,6 +43,16 @@ type Contour struct {
 	// Min and Max define the dynamic range of the
 	// heat map.
 	Min, Max float64
+
+	// Smoothing is the amount of smoothing applied to
+	// the contour lines, in the range [0, 1]. If Smoothing
+	// is zero, contours are drawn as polylines through the
+	// points computed on the grid cell edges. Otherwise
+	// they are drawn as Catmull-Rom splines through these
+	// points, with tangents scaled by Smoothing. The spline
+	// between two points deviates from the straight segment
+	// joining them by at most Smoothing/3 of its length.
+	Smoothing float64
 }
 
 // NewContour creates as new contour plotter for the given data, using
@@ -161,7 +171,11 @@ func (h *Contour) Plot(c draw.Canvas, plt *plot.Plot) {
 			continue
 		}
 		for _, pa := range cp[z] {
-			if isLoop(pa) {
+			loop := isLoop(pa)
+			if h.Smoothing > 0 {
+				pa = smoothPath(pa, h.Smoothing, loop)
+			}
+			if loop {
 				pa.Close()
 			}
 
@@ -289,6 +303,63 @@ func isLoop(p vg.Path) bool {
 	return s.Pos == e.Pos
 }
 
+// smoothPath returns a path of cubic Bézier curves following the
+// Catmull-Rom spline through the points of the polyline pa, with
+// tangents scaled by s. If loop is true, pa is treated as a closed
+// loop. The control points are limited to s/3 of the length of each
+// segment from its end points so that the curve stays close to the
+// original polyline and contours remain topologically correct.
+func smoothPath(pa vg.Path, s float64, loop bool) vg.Path {
+	if len(pa) < 3 {
+		return pa
+	}
+	s = math.Min(s, 1)
+
+	pts := make([]vg.Point, len(pa))
+	for i, c := range pa {
+		pts[i] = c.Pos
+	}
+	n := len(pts)
+
+	// at returns the ith point of the polyline, wrapping
+	// around loops and clamping to the ends otherwise.
+	at := func(i int) vg.Point {
+		switch {
+		case i < 0 && loop:
+			i += n - 1
+		case i >= n && loop:
+			i -= n - 1
+		case i < 0:
+			i = 0
+		case i >= n:
+			i = n - 1
+		}
+		return pts[i]
+	}
+
+	// tangent returns the tangent offset of the spline at the
+	// point between prev and next, bounded in length by lim.
+	tangent := func(prev, next vg.Point, lim vg.Length) vg.Point {
+		t := next.Sub(prev).Scale(vg.Length(s / 6))
+		if l := vg.Length(math.Hypot(float64(t.X), float64(t.Y))); l > lim {
+			t = t.Scale(lim / l)
+		}
+		return t
+	}
+
+	var sm vg.Path
+	sm.Move(pts[0])
+	for i := 0; i < n-1; i++ {
+		p1, p2 := pts[i], pts[i+1]
+		d := p2.Sub(p1)
+		lim := vg.Length(s/3) * vg.Length(math.Hypot(float64(d.X), float64(d.Y)))
+		c1 := p1.Add(tangent(at(i-1), p2, lim))
+		c2 := p2.Sub(tangent(p1, at(i+2), lim))
+		sm.CubeTo(c1, c2, p2)
+	}
+	return sm
+}
+
 // contourPaths returns a collection of vg.Paths describing contour lines based
 // on the input data in m cut at the given levels. The trX and trY function
 // are coordinate transforms. The returned map contains slices of paths keyed
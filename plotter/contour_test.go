@@ -7,6 +7,7 @@ package plotter
 import (
 	"flag"
 	"fmt"
+	"image/color"
 	"math"
 	"reflect"
 	"sort"
@@ -16,6 +17,7 @@ import (
 
 	"gonum.org/v1/gonum/mat"
 	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
 	"gonum.org/v1/plot/palette"
 	"gonum.org/v1/plot/vg"
 )
@@ -75,6 +77,50 @@ func TestHeatMapWithContour(t *testing.T) {
 	}
 }
 
+func TestContourSmoothing(t *testing.T) {
+	cmpimg.CheckPlot(func() {
+		const n = 8
+		data := make([]float64, n*n)
+		for i := range data {
+			r := float64(i/n) - 3.5
+			c := float64(i%n) - 3.5
+			data[i] = math.Hypot(r, 1.5*c) + math.Sin(r)
+		}
+
+		var (
+			grid   = unitGrid{mat.NewDense(n, n, data)}
+			levels = []float64{1, 2, 3, 4}
+			pal    = palette.Rainbow(len(levels), palette.Blue, palette.Red, 1, 1, 1)
+		)
+
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("could not create plot: %+v", err)
+		}
+
+		p.Title.Text = "Smoothed contour"
+		p.X.Padding = 0
+		p.Y.Padding = 0
+		p.X.Max = n - 1
+		p.Y.Max = n - 1
+
+		raw := NewContour(grid, levels, pal)
+		raw.LineStyles[0].Color = color.Gray{Y: 192}
+		raw.Palette = nil
+		p.Add(raw)
+
+		smooth := NewContour(grid, levels, pal)
+		smooth.Smoothing = 1
+		smooth.LineStyles[0].Width = vg.Points(1.5)
+		p.Add(smooth)
+
+		err = p.Save(10*vg.Centimeter, 10*vg.Centimeter, "testdata/contourSmoothing.png")
+		if err != nil {
+			t.Fatalf("could not save plot: %+v", err)
+		}
+	}, t, "contourSmoothing.png")
+}
+
 func TestComplexContours(t *testing.T) {
 	rnd := rand.New(rand.NewSource(1))
 
@@ -187,6 +233,73 @@ func TestContourPaths(t *testing.T) {
 	}
 }
 
+func TestSmoothPath(t *testing.T) {
+	m := unitGrid{mat.NewDense(3, 4, []float64{
+		2, 1, 4, 3,
+		6, 7, 2, 5,
+		9, 10, 11, 12,
+	})}
+
+	levels := []float64{1.5, 2.5, 3.5, 4.5, 5.5, 6.5, 7.5, 8.5, 9.5, 10.5}
+	paths := contourPaths(m, levels, unity, unity)
+
+	for _, smoothing := range []float64{0.25, 0.5, 1} {
+		for _, z := range levels {
+			for _, pa := range paths[z] {
+				sm := smoothPath(pa, smoothing, isLoop(pa))
+				if len(pa) < 3 {
+					if !reflect.DeepEqual(sm, pa) {
+						t.Errorf("unexpected smoothing of short path: got:%+v want:%+v", sm, pa)
+					}
+					continue
+				}
+				if len(sm) != len(pa) {
+					t.Fatalf("unexpected number of path components for smoothing=%v: got:%d want:%d",
+						smoothing, len(sm), len(pa))
+				}
+				for i := 1; i < len(sm); i++ {
+					p1, p2 := pa[i-1].Pos, pa[i].Pos
+					if sm[i].Pos != p2 {
+						t.Errorf("smoothed path does not pass through %+v: got:%+v", p2, sm[i].Pos)
+					}
+					bound := smoothing / 3 * dist(p1, p2)
+					for j := 0; j <= 20; j++ {
+						pt := cubic(sm[i-1].Pos, sm[i].Control[0], sm[i].Control[1], p2, float64(j)/20)
+						if d := distToSegment(pt, p1, p2); d > bound+1e-12 {
+							t.Errorf("smoothed segment deviates by %v from %+v-%+v, more than %v for smoothing=%v",
+								d, p1, p2, bound, smoothing)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// cubic returns the point at t on the cubic Bézier curve defined by p0, c1, c2 and p3.
+func cubic(p0, c1, c2, p3 vg.Point, t float64) vg.Point {
+	u := 1 - t
+	b := func(a, b, c, d vg.Length) vg.Length {
+		return vg.Length(u*u*u)*a + vg.Length(3*u*u*t)*b + vg.Length(3*u*t*t)*c + vg.Length(t*t*t)*d
+	}
+	return vg.Point{X: b(p0.X, c1.X, c2.X, p3.X), Y: b(p0.Y, c1.Y, c2.Y, p3.Y)}
+}
+
+func dist(a, b vg.Point) float64 {
+	return math.Hypot(float64(a.X-b.X), float64(a.Y-b.Y))
+}
+
+// distToSegment returns the distance from p to the segment a-b.
+func distToSegment(p, a, b vg.Point) float64 {
+	d := b.Sub(a)
+	l2 := float64(d.Dot(d))
+	if l2 == 0 {
+		return dist(p, a)
+	}
+	t := math.Max(0, math.Min(1, float64(p.Sub(a).Dot(d))/l2))
+	return dist(p, a.Add(d.Scale(vg.Length(t))))
+}
+
 type byLength []vg.Path
 
 func (p byLength) Len() int           { return len(p) }
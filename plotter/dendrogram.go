@@ -0,0 +1,195 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Merge is a step of a hierarchical clustering, the
+// merge of the clusters A and B at the given Height.
+//
+// For n observations, the clusters 0 to n-1 are the
+// observations themselves, and the cluster created
+// by the ith merge is the cluster n+i.
+type Merge struct {
+	A, B   int
+	Height float64
+}
+
+// Dendrogram implements the Plotter interface, drawing
+// the tree of a hierarchical clustering: each merge is
+// drawn as a bracket joining its two clusters at the
+// height of the merge.
+//
+// The leaves are drawn at the positions 0 to n-1 along
+// the X axis, or the Y axis if Horizontal is set, in an
+// order where the branches of the tree do not cross.
+type Dendrogram struct {
+	// Horizontal specifies whether the heights of the
+	// tree are along the X axis, with the leaves along
+	// the Y axis.
+	Horizontal bool
+
+	// LineStyle is the style of the brackets.
+	draw.LineStyle
+
+	// merges are the merges of the clustering.
+	merges []Merge
+
+	// leaves are the observations in the
+	// order in which they are drawn.
+	leaves []int
+
+	// pos and height are the position along the leaf
+	// axis and the height of each cluster.
+	pos, height []float64
+}
+
+// NewDendrogram returns a Dendrogram for the merges of
+// a hierarchical clustering of len(merges)+1 observations,
+// such as the linkage of the observations.
+//
+// An error is returned if a merge refers to a cluster
+// that is not yet created or that is already merged, or
+// if a height is negative, infinite or NaN.
+func NewDendrogram(merges []Merge) (*Dendrogram, error) {
+	if len(merges) == 0 {
+		return nil, errors.New("plotter: no merges")
+	}
+	n := len(merges) + 1
+	merged := make([]bool, 2*n-1)
+	children := make([][2]int, len(merges))
+	for i, m := range merges {
+		if err := CheckFloats(m.Height); err != nil {
+			return nil, err
+		}
+		if m.Height < 0 {
+			return nil, fmt.Errorf("plotter: negative height of merge %d", i)
+		}
+		for _, k := range []int{m.A, m.B} {
+			switch {
+			case k < 0 || k >= n+i:
+				return nil, fmt.Errorf("plotter: merge %d of unknown cluster %d", i, k)
+			case merged[k]:
+				return nil, fmt.Errorf("plotter: merge %d of already merged cluster %d", i, k)
+			}
+			merged[k] = true
+		}
+		children[i] = [2]int{m.A, m.B}
+	}
+
+	d := &Dendrogram{
+		merges:    append([]Merge(nil), merges...),
+		LineStyle: DefaultLineStyle,
+		pos:       make([]float64, 2*n-1),
+		height:    make([]float64, 2*n-1),
+	}
+
+	// Order the leaves by a depth first
+	// walk of the tree from its root.
+	stack := []int{2*n - 2}
+	for len(stack) > 0 {
+		k := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if k < n {
+			d.pos[k] = float64(len(d.leaves))
+			d.leaves = append(d.leaves, k)
+			continue
+		}
+		c := children[k-n]
+		stack = append(stack, c[1], c[0])
+	}
+
+	// Place each cluster midway between its
+	// children at the height of its merge.
+	for i, m := range merges {
+		d.pos[n+i] = (d.pos[m.A] + d.pos[m.B]) / 2
+		d.height[n+i] = m.Height
+	}
+	return d, nil
+}
+
+// Leaves returns the observations in the order
+// in which they are drawn, at the positions 0 to
+// n-1, so that, for example, p.NominalX can label
+// them.
+func (d *Dendrogram) Leaves() []int {
+	return append([]int(nil), d.leaves...)
+}
+
+// LeafPosition returns the position of the
+// leaf of the ith observation along the leaf
+// axis.
+func (d *Dendrogram) LeafPosition(i int) float64 {
+	return d.pos[i]
+}
+
+// Node returns the position along the leaf axis
+// and the height of the node of the cluster k,
+// which is a leaf, at height zero, if k is an
+// observation.
+func (d *Dendrogram) Node(k int) (pos, height float64) {
+	return d.pos[k], d.height[k]
+}
+
+// Plot draws the Dendrogram, implementing the plot.Plotter interface.
+func (d *Dendrogram) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	pt := func(pos, height float64) vg.Point {
+		if d.Horizontal {
+			return vg.Point{X: trX(height), Y: trY(pos)}
+		}
+		return vg.Point{X: trX(pos), Y: trY(height)}
+	}
+
+	for _, m := range d.merges {
+		a, ha := d.Node(m.A)
+		b, hb := d.Node(m.B)
+		bracket := []vg.Point{
+			pt(a, ha),
+			pt(a, m.Height),
+			pt(b, m.Height),
+			pt(b, hb),
+		}
+		c.StrokeLines(d.LineStyle, c.ClipLinesXY(bracket)...)
+	}
+}
+
+// DataRange returns the minimum and maximum x and y values,
+// implementing the plot.DataRanger interface. The range of
+// the leaf axis is from 0 to n-1 for n observations, and
+// the range of the height axis is from 0 to the height of
+// the highest merge.
+func (d *Dendrogram) DataRange() (xmin, xmax, ymin, ymax float64) {
+	var top float64
+	for _, m := range d.merges {
+		top = math.Max(top, m.Height)
+	}
+	if d.Horizontal {
+		return 0, top, 0, float64(len(d.leaves) - 1)
+	}
+	return 0, float64(len(d.leaves) - 1), 0, top
+}
+
+// Thumbnail draws a bracket in the given style
+// in a canvas, implementing the plot.Thumbnailer
+// interface.
+func (d *Dendrogram) Thumbnail(c *draw.Canvas) {
+	x0 := c.Min.X + (c.Max.X-c.Min.X)/4
+	x1 := c.Max.X - (c.Max.X-c.Min.X)/4
+	c.StrokeLines(d.LineStyle, []vg.Point{
+		{X: x0, Y: c.Min.Y},
+		{X: x0, Y: c.Max.Y},
+		{X: x1, Y: c.Max.Y},
+		{X: x1, Y: c.Min.Y},
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestDendrogram(t *testing.T) {
+	cmpimg.CheckPlot(ExampleDendrogram, t, "dendrogram.png")
+}
+
+func TestDendrogramNodes(t *testing.T) {
+	// ((0, 3), (1, 2)): the leaves are reordered
+	// so that the brackets do not cross.
+	d, err := plotter.NewDendrogram([]plotter.Merge{
+		{A: 1, B: 2, Height: 1},
+		{A: 0, B: 3, Height: 2},
+		{A: 5, B: 4, Height: 4},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := d.Leaves(), []int{0, 3, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected leaf order: got:%v want:%v", got, want)
+	}
+	for i, want := range []float64{0, 2, 3, 1} {
+		if got := d.LeafPosition(i); got != want {
+			t.Errorf("unexpected position of leaf %d: got:%v want:%v", i, got, want)
+		}
+	}
+	for k, want := range [][2]float64{
+		{0, 0}, {2, 0}, {3, 0}, {1, 0},
+		{2.5, 1}, {0.5, 2}, {1.5, 4},
+	} {
+		pos, height := d.Node(k)
+		if pos != want[0] || height != want[1] {
+			t.Errorf("unexpected node %d: got:(%v, %v) want:(%v, %v)", k, pos, height, want[0], want[1])
+		}
+	}
+
+	xmin, xmax, ymin, ymax := d.DataRange()
+	if xmin != 0 || xmax != 3 || ymin != 0 || ymax != 4 {
+		t.Errorf("unexpected data range: got:[%v, %v]x[%v, %v] want:[0, 3]x[0, 4]", xmin, xmax, ymin, ymax)
+	}
+
+	// The brackets are drawn in data coordinates
+	// scaled by 100 by the canvas.
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	p.X.Min, p.X.Max = 0, 3
+	p.Y.Min, p.Y.Max = 0, 4
+	var rec recorder.Canvas
+	c := draw.NewCanvas(&rec, 300, 400)
+	d.Plot(c, p)
+	var got [][]vg.Point
+	for _, a := range rec.Actions {
+		s, ok := a.(*recorder.Stroke)
+		if !ok {
+			continue
+		}
+		var pts []vg.Point
+		for _, pc := range s.Path {
+			pts = append(pts, pc.Pos)
+		}
+		got = append(got, pts)
+	}
+	want := [][]vg.Point{
+		{{X: 200, Y: 0}, {X: 200, Y: 100}, {X: 300, Y: 100}, {X: 300, Y: 0}},
+		{{X: 0, Y: 0}, {X: 0, Y: 200}, {X: 100, Y: 200}, {X: 100, Y: 0}},
+		{{X: 50, Y: 200}, {X: 50, Y: 400}, {X: 250, Y: 400}, {X: 250, Y: 100}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected brackets:\ngot: %v\nwant:%v", got, want)
+	}
+}
+
+func TestDendrogramErrors(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		merges []plotter.Merge
+	}{
+		{name: "empty"},
+		{name: "unknown", merges: []plotter.Merge{{A: 0, B: 2, Height: 1}}},
+		{name: "merged twice", merges: []plotter.Merge{{A: 0, B: 1, Height: 1}, {A: 0, B: 3, Height: 2}}},
+		{name: "negative height", merges: []plotter.Merge{{A: 0, B: 1, Height: -1}}},
+	} {
+		if _, err := plotter.NewDendrogram(test.merges); err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// EnvelopeBucket holds the range and the mean of the
+// Y values of the points of a series in a bucket of
+// X values.
+type EnvelopeBucket struct {
+	// X is the middle of the range of
+	// X values of the bucket.
+	X float64
+
+	// Min, Max and Mean are the minimum, maximum
+	// and mean of the Y values in the bucket.
+	Min, Max, Mean float64
+
+	// N is the number of points in the bucket.
+	N int
+}
+
+// Envelope implements the Plotter interface, drawing the
+// min/max envelope of a dense series, such as a long time
+// series, reduced to a fixed number of buckets of X values.
+// Drawing the envelope at about one bucket per column of
+// pixels looks like drawing every point of the series, at
+// a fraction of the cost.
+type Envelope struct {
+	// Buckets are the non-empty buckets of
+	// the series, in increasing X order.
+	Buckets []EnvelopeBucket
+
+	// Color is the fill color of the envelope.
+	Color color.Color
+
+	// LineStyle is the style of the outline of
+	// the envelope. Use zero width to disable
+	// the outline.
+	draw.LineStyle
+
+	// MeanStyle is the style of the line through
+	// the means of the buckets. Use zero width to
+	// disable the line.
+	MeanStyle draw.LineStyle
+
+	// xmin, xmax, ymin and ymax are
+	// the range of the series.
+	xmin, xmax, ymin, ymax float64
+}
+
+// NewEnvelope returns an Envelope of the points reduced to n
+// buckets of equal width covering the range of X values of the
+// points. The envelope is filled with a semi-transparent gray,
+// without an outline, and the line through the means is drawn
+// in the default line style. The points do not need to be
+// sorted by X value.
+//
+// An error is returned if n is not positive, if there are no
+// points, or if a coordinate is infinite or NaN.
+func NewEnvelope(xys XYer, n int) (*Envelope, error) {
+	if n < 1 {
+		return nil, errors.New("plotter: envelope needs at least one bucket")
+	}
+	if xys.Len() == 0 {
+		return nil, ErrNoData
+	}
+	e := &Envelope{
+		Color: color.NRGBA{A: 0x40},
+		xmin:  math.Inf(1), xmax: math.Inf(-1),
+		ymin: math.Inf(1), ymax: math.Inf(-1),
+	}
+	for i := 0; i < xys.Len(); i++ {
+		x, y := xys.XY(i)
+		if err := CheckFloats(x, y); err != nil {
+			return nil, err
+		}
+		e.xmin, e.xmax = math.Min(e.xmin, x), math.Max(e.xmax, x)
+		e.ymin, e.ymax = math.Min(e.ymin, y), math.Max(e.ymax, y)
+	}
+
+	buckets := envelopeBuckets(xys, n, e.xmin, e.xmax)
+	for _, b := range buckets {
+		if b.N != 0 {
+			e.Buckets = append(e.Buckets, b)
+		}
+	}
+
+	e.LineStyle = DefaultLineStyle
+	e.LineStyle.Width = 0
+	e.MeanStyle = DefaultLineStyle
+	return e, nil
+}
+
+// envelopeBuckets returns the n buckets of equal width covering
+// [xmin, xmax] of the points. The points at xmax are in the last
+// bucket. All the points are in a single bucket if xmin and xmax
+// are equal.
+func envelopeBuckets(xys XYer, n int, xmin, xmax float64) []EnvelopeBucket {
+	if xmin == xmax {
+		n = 1
+	}
+	width := (xmax - xmin) / float64(n)
+	buckets := make([]EnvelopeBucket, n)
+	for i := range buckets {
+		buckets[i] = EnvelopeBucket{
+			X:   xmin + (float64(i)+0.5)*width,
+			Min: math.Inf(1),
+			Max: math.Inf(-1),
+		}
+	}
+	for i := 0; i < xys.Len(); i++ {
+		x, y := xys.XY(i)
+		j := n - 1
+		if width > 0 {
+			j = int((x - xmin) / width)
+			if j >= n {
+				j = n - 1
+			}
+		}
+		b := &buckets[j]
+		b.Min = math.Min(b.Min, y)
+		b.Max = math.Max(b.Max, y)
+		b.Mean += y
+		b.N++
+	}
+	for i := range buckets {
+		if buckets[i].N != 0 {
+			buckets[i].Mean /= float64(buckets[i].N)
+		}
+	}
+	return buckets
+}
+
+// Plot draws the Envelope, implementing the plot.Plotter interface.
+func (e *Envelope) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	n := len(e.Buckets)
+	lo := make([]vg.Point, n)
+	up := make([]vg.Point, n)
+	for i, b := range e.Buckets {
+		x := trX(b.X)
+		lo[i] = vg.Point{X: x, Y: trY(b.Min)}
+		up[i] = vg.Point{X: x, Y: trY(b.Max)}
+	}
+
+	if e.Color != nil && n != 0 {
+		// The envelope follows the minima forward
+		// and the maxima back.
+		poly := make([]vg.Point, 0, 2*n)
+		poly = append(poly, lo...)
+		for i := n - 1; i >= 0; i-- {
+			poly = append(poly, up[i])
+		}
+		c.FillPolygon(e.Color, c.ClipPolygonXY(poly))
+	}
+
+	if e.LineStyle.Width != 0 {
+		c.StrokeLines(e.LineStyle, c.ClipLinesXY(lo)...)
+		c.StrokeLines(e.LineStyle, c.ClipLinesXY(up)...)
+	}
+
+	if e.MeanStyle.Width != 0 {
+		mean := make([]vg.Point, n)
+		for i, b := range e.Buckets {
+			mean[i] = vg.Point{X: lo[i].X, Y: trY(b.Mean)}
+		}
+		c.StrokeLines(e.MeanStyle, c.ClipLinesXY(mean)...)
+	}
+}
+
+// DataRange returns the minimum and maximum x and y values
+// of the series, implementing the plot.DataRanger interface.
+func (e *Envelope) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return e.xmin, e.xmax, e.ymin, e.ymax
+}
+
+// Thumbnail fills the thumbnail with the color of the
+// envelope and draws the mean line across its middle,
+// implementing the plot.Thumbnailer interface.
+func (e *Envelope) Thumbnail(c *draw.Canvas) {
+	if e.Color != nil {
+		pts := []vg.Point{
+			{X: c.Min.X, Y: c.Min.Y},
+			{X: c.Min.X, Y: c.Max.Y},
+			{X: c.Max.X, Y: c.Max.Y},
+			{X: c.Max.X, Y: c.Min.Y},
+		}
+		c.FillPolygon(e.Color, c.ClipPolygonY(pts))
+	}
+	if e.MeanStyle.Width != 0 {
+		y := c.Center().Y
+		c.StrokeLine2(e.MeanStyle, c.Min.X, y, c.Max.X, y)
+	}
+}
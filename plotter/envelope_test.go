@@ -0,0 +1,132 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+func TestEnvelope(t *testing.T) {
+	cmpimg.CheckPlot(ExampleEnvelope, t, "envelope.png")
+}
+
+func TestEnvelopeBuckets(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		pts  plotter.XYs
+		n    int
+		want []plotter.EnvelopeBucket
+	}{
+		{
+			name: "unsorted",
+			pts: plotter.XYs{
+				{X: 0, Y: 1}, {X: 3.5, Y: 2}, {X: 0.9, Y: 5},
+				{X: 0.5, Y: -1}, {X: 4, Y: 0}, {X: 3, Y: 6},
+			},
+			n: 4,
+			want: []plotter.EnvelopeBucket{
+				{X: 0.5, Min: -1, Max: 5, Mean: 5.0 / 3, N: 3},
+				{X: 3.5, Min: 0, Max: 6, Mean: 8.0 / 3, N: 3},
+			},
+		},
+		{
+			name: "constant x",
+			pts:  plotter.XYs{{X: 2, Y: 3}, {X: 2, Y: -3}},
+			n:    10,
+			want: []plotter.EnvelopeBucket{
+				{X: 2, Min: -3, Max: 3, Mean: 0, N: 2},
+			},
+		},
+		{
+			name: "single bucket",
+			pts:  plotter.XYs{{X: 0, Y: 1}, {X: 1, Y: 2}, {X: 2, Y: 6}},
+			n:    1,
+			want: []plotter.EnvelopeBucket{
+				{X: 1, Min: 1, Max: 6, Mean: 3, N: 3},
+			},
+		},
+	} {
+		e, err := plotter.NewEnvelope(test.pts, test.n)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if !reflect.DeepEqual(e.Buckets, test.want) {
+			t.Errorf("%s: unexpected buckets:\ngot: %+v\nwant:%+v", test.name, e.Buckets, test.want)
+		}
+	}
+
+	e, err := plotter.NewEnvelope(plotter.XYs{{X: -1, Y: 2}, {X: 3, Y: -4}, {X: 1, Y: 7}}, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	xmin, xmax, ymin, ymax := e.DataRange()
+	if xmin != -1 || xmax != 3 || ymin != -4 || ymax != 7 {
+		t.Errorf("unexpected data range: got:[%v,%v]x[%v,%v] want:[-1,3]x[-4,7]", xmin, xmax, ymin, ymax)
+	}
+
+	for _, test := range []struct {
+		name string
+		pts  plotter.XYs
+		n    int
+	}{
+		{name: "no buckets", pts: plotter.XYs{{X: 0, Y: 0}}, n: 0},
+		{name: "no points", pts: nil, n: 1},
+		{name: "NaN", pts: plotter.XYs{{X: 0, Y: math.NaN()}}, n: 1},
+	} {
+		if _, err := plotter.NewEnvelope(test.pts, test.n); err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
+
+// envelopeBenchPoints is the number of points of
+// the series drawn by the envelope benchmarks.
+const envelopeBenchPoints = 1000000
+
+func envelopeBenchSeries() plotter.XYs {
+	pts := make(plotter.XYs, envelopeBenchPoints)
+	for i := range pts {
+		x := float64(i) / envelopeBenchPoints
+		pts[i] = plotter.XY{X: x, Y: math.Sin(40*math.Pi*x) + math.Sin(1e4*x)}
+	}
+	return pts
+}
+
+func benchmarkEnvelopeDraw(b *testing.B, newPlotter func(plotter.XYs) (plot.Plotter, error)) {
+	pts := envelopeBenchSeries()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pl, err := newPlotter(pts)
+		if err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+		p, err := plot.New()
+		if err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+		p.Add(pl)
+		p.Draw(draw.New(vgimg.New(400, 200)))
+	}
+}
+
+func BenchmarkEnvelope(b *testing.B) {
+	benchmarkEnvelopeDraw(b, func(pts plotter.XYs) (plot.Plotter, error) {
+		return plotter.NewEnvelope(pts, 400)
+	})
+}
+
+func BenchmarkEnvelopeLine(b *testing.B) {
+	benchmarkEnvelopeDraw(b, func(pts plotter.XYs) (plot.Plotter, error) {
+		return plotter.NewLine(pts)
+	})
+}
@@ -5,6 +5,7 @@
 package plotter
 
 import (
+	"errors"
 	"math"
 
 	"gonum.org/v1/plot"
@@ -62,6 +63,34 @@ func NewYErrorBars(yerrs interface {
 	}, nil
 }
 
+// NewAsymmetricYErrorBars returns a new YErrorBars plotter, or an error
+// on failure, where the error bar of the ith point extends from
+// Y-|low.Value(i)| to Y+|high.Value(i)|.
+// The low and high errors must have the same length as xys.
+func NewAsymmetricYErrorBars(xys XYer, low, high Valuer) (*YErrorBars, error) {
+	errs, err := lowHighErrors(xys, low, high)
+	if err != nil {
+		return nil, err
+	}
+	return NewYErrorBars(struct {
+		XYer
+		YErrors
+	}{xys, YErrors(errs)})
+}
+
+// lowHighErrors returns the Errors made of the given low and high errors.
+func lowHighErrors(xys XYer, low, high Valuer) (Errors, error) {
+	if low.Len() != xys.Len() || high.Len() != xys.Len() {
+		return nil, errors.New("plotter: number of errors does not match number of points")
+	}
+	errs := make(Errors, xys.Len())
+	for i := range errs {
+		errs[i].Low = low.Value(i)
+		errs[i].High = high.Value(i)
+	}
+	return errs, nil
+}
+
 // Plot implements the Plotter interface, drawing labels.
 func (e *YErrorBars) Plot(c draw.Canvas, p *plot.Plot) {
 	trX, trY := p.Transforms(&c)
@@ -117,8 +146,8 @@ func (e *YErrorBars) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
 		x := plt.X.Norm(e.XYs[i].X)
 		y := e.XYs[i].Y
 		bs = append(bs,
-			plot.GlyphBox{X: x, Y: plt.Y.Norm(y - err.Low), Rectangle: rect},
-			plot.GlyphBox{X: x, Y: plt.Y.Norm(y + err.High), Rectangle: rect})
+			plot.GlyphBox{X: x, Y: plt.Y.Norm(y - math.Abs(err.Low)), Rectangle: rect},
+			plot.GlyphBox{X: x, Y: plt.Y.Norm(y + math.Abs(err.High)), Rectangle: rect})
 	}
 	return bs
 }
@@ -170,6 +199,21 @@ func NewXErrorBars(xerrs interface {
 	}, nil
 }
 
+// NewAsymmetricXErrorBars returns a new XErrorBars plotter, or an error
+// on failure, where the error bar of the ith point extends from
+// X-|low.Value(i)| to X+|high.Value(i)|.
+// The low and high errors must have the same length as xys.
+func NewAsymmetricXErrorBars(xys XYer, low, high Valuer) (*XErrorBars, error) {
+	errs, err := lowHighErrors(xys, low, high)
+	if err != nil {
+		return nil, err
+	}
+	return NewXErrorBars(struct {
+		XYer
+		XErrors
+	}{xys, XErrors(errs)})
+}
+
 // Plot implements the Plotter interface, drawing labels.
 func (e *XErrorBars) Plot(c draw.Canvas, p *plot.Plot) {
 	trX, trY := p.Transforms(&c)
@@ -225,8 +269,8 @@ func (e *XErrorBars) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
 		x := e.XYs[i].X
 		y := plt.Y.Norm(e.XYs[i].Y)
 		bs = append(bs,
-			plot.GlyphBox{X: plt.X.Norm(x - err.Low), Y: y, Rectangle: rect},
-			plot.GlyphBox{X: plt.X.Norm(x + err.High), Y: y, Rectangle: rect})
+			plot.GlyphBox{X: plt.X.Norm(x - math.Abs(err.Low)), Y: y, Rectangle: rect},
+			plot.GlyphBox{X: plt.X.Norm(x + math.Abs(err.High)), Y: y, Rectangle: rect})
 	}
 	return bs
 }
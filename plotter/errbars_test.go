@@ -5,11 +5,115 @@
 package plotter_test
 
 import (
+	"math"
 	"testing"
 
+	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 func TestErrors(t *testing.T) {
 	cmpimg.CheckPlot(ExampleErrors, t, "errorBars.png")
 }
+
+func TestAsymmetricErrorBars(t *testing.T) {
+	xys := plotter.XYs{{X: 1, Y: 1}, {X: 2, Y: 3}}
+	low := plotter.Values{0.2, 0.5}
+	high := plotter.Values{1.5, 0.5}
+
+	yerrs, err := plotter.NewAsymmetricYErrorBars(xys, low, high)
+	if err != nil {
+		t.Fatalf("could not create y error bars: %+v", err)
+	}
+	xmin, xmax, ymin, ymax := yerrs.DataRange()
+	if xmin != 1 || xmax != 2 || ymin != 0.8 || ymax != 3.5 {
+		t.Errorf("unexpected y error bars range: got (%v, %v, %v, %v)", xmin, xmax, ymin, ymax)
+	}
+
+	xerrs, err := plotter.NewAsymmetricXErrorBars(xys, low, high)
+	if err != nil {
+		t.Fatalf("could not create x error bars: %+v", err)
+	}
+	xmin, xmax, ymin, ymax = xerrs.DataRange()
+	if xmin != 0.8 || xmax != 2.5 || ymin != 1 || ymax != 3 {
+		t.Errorf("unexpected x error bars range: got (%v, %v, %v, %v)", xmin, xmax, ymin, ymax)
+	}
+
+	for _, p := range []plot.Plotter{yerrs, xerrs} {
+		plt, err := plot.New()
+		if err != nil {
+			t.Fatalf("could not create plot: %+v", err)
+		}
+		plt.Add(p)
+		c := new(recorder.Canvas)
+		p.Plot(draw.NewCanvas(c, 10*vg.Centimeter, 10*vg.Centimeter), plt)
+
+		// Each point has a bar and two caps.
+		var strokes int
+		for _, a := range c.Actions {
+			if _, ok := a.(*recorder.Stroke); ok {
+				strokes++
+			}
+		}
+		if want := 3 * len(xys); strokes != want {
+			t.Errorf("%T: got %d error bar strokes, want %d", p, strokes, want)
+		}
+	}
+
+	_, err = plotter.NewAsymmetricYErrorBars(xys, low, plotter.Values{1})
+	if err == nil {
+		t.Errorf("expected an error for mismatched error lengths")
+	}
+}
+
+func TestErrorEllipses(t *testing.T) {
+	cmpimg.CheckPlot(ExampleErrorEllipses, t, "errorEllipses.png")
+}
+
+func TestCovarianceAxes(t *testing.T) {
+	for _, test := range []struct {
+		cov                 plotter.Covariance
+		major, minor, angle float64
+	}{
+		{cov: plotter.Covariance{VarX: 4, VarY: 1}, major: 2, minor: 1, angle: 0},
+		{cov: plotter.Covariance{VarX: 1, VarY: 4}, major: 2, minor: 1, angle: math.Pi / 2},
+		// Eigenvalues 4 and 2, with the major axis along y=x.
+		{cov: plotter.Covariance{VarX: 3, VarY: 3, CovXY: 1}, major: 2, minor: math.Sqrt2, angle: math.Pi / 4},
+		{cov: plotter.CorrelatedErrors(1, 1, -1), major: math.Sqrt2, minor: 0, angle: -math.Pi / 4},
+	} {
+		major, minor, angle := test.cov.Axes()
+		if math.Abs(major-test.major) > 1e-12 || math.Abs(minor-test.minor) > 1e-12 || math.Abs(angle-test.angle) > 1e-12 {
+			t.Errorf("unexpected axes of %+v: got:(%v, %v, %v) want:(%v, %v, %v)",
+				test.cov, major, minor, angle, test.major, test.minor, test.angle)
+		}
+	}
+}
+
+func TestErrorEllipsesDataRange(t *testing.T) {
+	xys := plotter.XYs{{X: 0, Y: 0}, {X: 10, Y: 5}}
+	e, err := plotter.NewErrorEllipses(xys, []plotter.Covariance{
+		{VarX: 4, VarY: 1, CovXY: 1},
+		plotter.CorrelatedErrors(1, 3, 0.5),
+	})
+	if err != nil {
+		t.Fatalf("could not create error ellipses: %+v", err)
+	}
+	e.Sigma = 2
+	xmin, xmax, ymin, ymax := e.DataRange()
+	if xmin != -4 || xmax != 12 || ymin != -2 || ymax != 11 {
+		t.Errorf("unexpected error ellipses range: got (%v, %v, %v, %v)", xmin, xmax, ymin, ymax)
+	}
+
+	_, err = plotter.NewErrorEllipses(xys, []plotter.Covariance{{VarX: 1, VarY: 1, CovXY: 2}, {}})
+	if err == nil {
+		t.Error("expected error for covariance that is not positive semi-definite")
+	}
+	_, err = plotter.NewErrorEllipses(xys, []plotter.Covariance{{}})
+	if err == nil {
+		t.Error("expected error for mismatched number of covariances")
+	}
+}
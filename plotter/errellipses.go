@@ -0,0 +1,152 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Covariance is the covariance matrix
+//
+//	| VarX  CovXY |
+//	| CovXY VarY  |
+//
+// of the X and Y errors of a point.
+type Covariance struct {
+	VarX, VarY, CovXY float64
+}
+
+// CorrelatedErrors returns the covariance of X and Y
+// errors with the standard deviations sigmaX and sigmaY
+// and the correlation coefficient rho.
+func CorrelatedErrors(sigmaX, sigmaY, rho float64) Covariance {
+	return Covariance{
+		VarX:  sigmaX * sigmaX,
+		VarY:  sigmaY * sigmaY,
+		CovXY: rho * sigmaX * sigmaY,
+	}
+}
+
+// Axes returns the semi-major and semi-minor axes of the
+// one standard deviation ellipse of the covariance, the
+// square roots of its eigenvalues, and the angle of the
+// major axis from the X axis, in radians.
+func (c Covariance) Axes() (major, minor, angle float64) {
+	mean := (c.VarX + c.VarY) / 2
+	d := math.Hypot((c.VarX-c.VarY)/2, c.CovXY)
+	major = math.Sqrt(mean + d)
+	minor = math.Sqrt(math.Max(0, mean-d))
+	angle = math.Atan2(2*c.CovXY, c.VarX-c.VarY) / 2
+	return major, minor, angle
+}
+
+// ErrorEllipses implements the plot.Plotter and
+// plot.DataRanger interfaces, drawing confidence
+// ellipses of correlated X and Y errors around
+// points.
+type ErrorEllipses struct {
+	// XYs is a copy of the centers of the ellipses.
+	XYs
+
+	// Covariances is a copy of the covariances
+	// of the errors of each point.
+	Covariances []Covariance
+
+	// Sigma is the number of standard deviations
+	// spanned by the semi-axes of the ellipses.
+	Sigma float64
+
+	// Color is the fill color of the ellipses.
+	// If Color is nil the ellipses are not filled.
+	Color color.Color
+
+	// LineStyle is the style of the outline of the
+	// ellipses. Use zero width to disable outlines.
+	draw.LineStyle
+}
+
+// NewErrorEllipses returns one standard deviation error
+// ellipses around the points, with the given covariances
+// of the errors of each point. The ellipses are outlined
+// with the default line style. An error is returned if
+// there is not a covariance for each point, or if a
+// covariance matrix is not positive semi-definite.
+func NewErrorEllipses(xys XYer, covs []Covariance) (*ErrorEllipses, error) {
+	data, err := CopyXYs(xys)
+	if err != nil {
+		return nil, err
+	}
+	if len(covs) != len(data) {
+		return nil, errors.New("plotter: number of covariances does not match the number of points")
+	}
+	for _, c := range covs {
+		if err := CheckFloats(c.VarX, c.VarY, c.CovXY); err != nil {
+			return nil, err
+		}
+		if c.VarX < 0 || c.VarY < 0 || c.CovXY*c.CovXY > c.VarX*c.VarY {
+			return nil, errors.New("plotter: covariance is not positive semi-definite")
+		}
+	}
+	return &ErrorEllipses{
+		XYs:         data,
+		Covariances: append([]Covariance(nil), covs...),
+		Sigma:       1,
+		LineStyle:   DefaultLineStyle,
+	}, nil
+}
+
+// ellipseSegments is the number of line segments
+// approximating an ellipse.
+const ellipseSegments = 64
+
+// Plot draws the ErrorEllipses, implementing the
+// plot.Plotter interface. The ellipses are computed
+// in data coordinates, so that they are correctly
+// distorted by non-linear axis scales.
+func (e *ErrorEllipses) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	pts := make([]vg.Point, ellipseSegments+1)
+	for i, p := range e.XYs {
+		major, minor, angle := e.Covariances[i].Axes()
+		major *= e.Sigma
+		minor *= e.Sigma
+		sin, cos := math.Sincos(angle)
+		for j := range pts {
+			ts, tc := math.Sincos(2 * math.Pi * float64(j) / ellipseSegments)
+			x := p.X + major*tc*cos - minor*ts*sin
+			y := p.Y + major*tc*sin + minor*ts*cos
+			pts[j] = vg.Point{X: trX(x), Y: trY(y)}
+		}
+		if e.Color != nil {
+			c.FillPolygon(e.Color, c.ClipPolygonXY(pts))
+		}
+		if e.LineStyle.Width != 0 {
+			c.StrokeLines(e.LineStyle, c.ClipLinesXY(pts)...)
+		}
+	}
+}
+
+// DataRange returns the minimum and maximum X and Y
+// values of the extents of the ellipses, implementing
+// the plot.DataRanger interface.
+func (e *ErrorEllipses) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, ymin = math.Inf(1), math.Inf(1)
+	xmax, ymax = math.Inf(-1), math.Inf(-1)
+	for i, p := range e.XYs {
+		dx := e.Sigma * math.Sqrt(e.Covariances[i].VarX)
+		dy := e.Sigma * math.Sqrt(e.Covariances[i].VarY)
+		xmin = math.Min(xmin, p.X-dx)
+		xmax = math.Max(xmax, p.X+dx)
+		ymin = math.Min(ymin, p.Y-dy)
+		ymax = math.Max(ymax, p.Y+dy)
+	}
+	return xmin, xmax, ymin, ymax
+}
@@ -0,0 +1,44 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+)
+
+// ExampleCallout draws a function with callouts
+// pointing at its maximum and minimum.
+func ExampleCallout() {
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Callouts"
+	p.X.Min, p.X.Max = 0, 2*math.Pi
+	p.Y.Min, p.Y.Max = -1.5, 1.5
+
+	sin := plotter.NewFunction(math.Sin)
+	p.Add(sin)
+
+	max, err := plotter.NewCallout("maximum", plotter.XY{X: 3, Y: 1.2}, plotter.XY{X: math.Pi / 2, Y: 1})
+	if err != nil {
+		log.Panic(err)
+	}
+	min, err := plotter.NewCallout("minimum", plotter.XY{X: 3.2, Y: -1.2}, plotter.XY{X: 3 * math.Pi / 2, Y: -1})
+	if err != nil {
+		log.Panic(err)
+	}
+	min.Arrow.Filled = true
+	p.Add(max, min)
+
+	err = p.Save(300, 200, "testdata/callout.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
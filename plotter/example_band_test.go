@@ -0,0 +1,55 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+)
+
+// An example of a forecast drawn as a line inside
+// a band widening with the distance from the data.
+func ExampleBand() {
+	const n = 41
+	center := make(plotter.XYs, n)
+	lower := make(plotter.XYs, n)
+	upper := make(plotter.XYs, n)
+	for i := range center {
+		x := float64(i) / 4
+		y := math.Sin(x) + x/5
+		w := 0.1 + 0.05*x
+		center[i] = plotter.XY{X: x, Y: y}
+		lower[i] = plotter.XY{X: x, Y: y - w}
+		upper[i] = plotter.XY{X: x, Y: y + w}
+	}
+
+	l, b, err := plotter.NewLineBand(center, lower, upper)
+	if err != nil {
+		log.Panic(err)
+	}
+	l.Color = color.RGBA{B: 255, A: 255}
+	b.Color = color.NRGBA{B: 255, A: 0x40}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Forecast"
+	p.X.Label.Text = "X"
+	p.Y.Label.Text = "Y"
+	p.Add(b, l)
+	p.Legend.Add("forecast", b, l)
+	p.Legend.Top = true
+	p.Legend.Left = true
+
+	err = p.Save(300, 200, "testdata/band.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// An example of a scatter plot encoding two more values
+// of each point by the size and the color of its marker,
+// with a legend of both scales drawn beside it.
+func ExampleBubbleLegend() {
+	rnd := rand.New(rand.NewSource(1))
+	pts := make(plotter.XYs, 30)
+	sizes := make([]float64, len(pts))
+	temps := make([]float64, len(pts))
+	for i := range pts {
+		pts[i].X = 10 * rnd.Float64()
+		pts[i].Y = 10 * rnd.Float64()
+		sizes[i] = 1000 * rnd.Float64()
+		temps[i] = 20 + 10*rnd.Float64()
+	}
+
+	// The size scaling and palette functions are
+	// shared by the scatter and the legend.
+	radius := plotter.AreaRadius(0, 1000, vg.Points(2), vg.Points(10))
+	colors := moreland.SmoothBlueRed()
+	colors.SetMin(20)
+	colors.SetMax(30)
+
+	s, err := plotter.NewScatter(pts)
+	if err != nil {
+		log.Panic(err)
+	}
+	s.GlyphStyleFunc = func(i int) draw.GlyphStyle {
+		c, err := colors.At(temps[i])
+		if err != nil {
+			log.Panic(err)
+		}
+		return draw.GlyphStyle{Color: c, Radius: radius(sizes[i]), Shape: draw.CircleGlyph{}}
+	}
+
+	chart, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	chart.Title.Text = "Bubbles"
+	chart.Add(s)
+
+	l, err := plotter.NewBubbleLegend(radius, 0, 1000, 4, colors)
+	if err != nil {
+		log.Panic(err)
+	}
+	legend, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	legend.Title.Text = " "
+	legend.HideAxes()
+	legend.Add(l)
+
+	fig := plot.NewFigure(1, 2)
+	fig.Plots[0][0] = chart
+	fig.Plots[0][1] = legend
+	fig.Tiles.ColWidths = []float64{3, 1}
+
+	err = fig.Save(300, 220, "testdata/bubbleLegend.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -82,3 +82,43 @@ func ExampleScatter_bubbles() {
 		log.Panic(err)
 	}
 }
+
+// ExampleBubbles draws a bubble chart, with the area of the
+// bubbles proportional to a third value of the points, and a
+// legend showing the scale of the bubbles.
+func ExampleBubbles() {
+	rnd := rand.New(rand.NewSource(1))
+	data := make(plotter.XYZs, 15)
+	for i := range data {
+		data[i].X = rnd.Float64() * 10
+		data[i].Y = data[i].X + 4*rnd.Float64()
+		data[i].Z = 100 * rnd.Float64()
+	}
+
+	b, err := plotter.NewBubbles(data, vg.Points(2), vg.Points(12))
+	if err != nil {
+		log.Panic(err)
+	}
+	b.Color = color.NRGBA{R: 196, B: 128, A: 0x80}
+	b.LineStyle.Color = color.NRGBA{R: 98, B: 64, A: 255}
+	b.LineStyle.Width = vg.Points(0.5)
+	b.Radius = plotter.AreaRadius(0, 100, vg.Points(2), vg.Points(12))
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Bubbles"
+	p.X.Label.Text = "X"
+	p.Y.Label.Text = "Y"
+	p.Add(b)
+	b.AddToLegend(&p.Legend, 10, 50, 100)
+	p.Legend.Top = true
+	p.Legend.Left = true
+	p.Legend.Padding = vg.Points(8)
+
+	err = p.Save(250, 250, "testdata/bubbleChart.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
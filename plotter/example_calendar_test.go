@@ -0,0 +1,70 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+	"math"
+	"time"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+)
+
+// ExampleCalendarHeatmap draws the daily activity
+// of the first half of a year, busier on weekdays.
+func ExampleCalendarHeatmap() {
+	rnd := rand.New(rand.NewSource(1))
+	start := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	var vals []plotter.DateValue
+	for d := start; d.Before(start.AddDate(0, 6, 0)); d = d.AddDate(0, 0, 1) {
+		n := rnd.Float64() * 10
+		if wd := d.Weekday(); wd == time.Saturday || wd == time.Sunday {
+			n /= 4
+		}
+		vals = append(vals, plotter.DateValue{Date: d, Value: math.Floor(n)})
+	}
+
+	dark, err := moreland.NewLuminance([]color.Color{
+		color.RGBA{R: 33, G: 110, B: 57, A: 255},
+		color.RGBA{R: 235, G: 237, B: 240, A: 255},
+	})
+	if err != nil {
+		log.Panic(err)
+	}
+	dark.SetMax(1)
+	cm := palette.Reverse(dark)
+	h, err := plotter.NewCalendarHeatmap(vals, cm.Palette(10))
+	if err != nil {
+		log.Panic(err)
+	}
+	h.Separator.Color = color.White
+	h.Separator.Width = 2
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Daily activity"
+	p.Add(h)
+	p.HideX()
+	p.Y.Tick.Marker = plot.ConstantTicks{
+		{Value: 5, Label: "Mon"},
+		{Value: 3, Label: "Wed"},
+		{Value: 1, Label: "Fri"},
+	}
+	p.Y.Tick.Length = 0
+	p.Y.LineStyle.Width = 0
+
+	err = p.Save(400, 150, "testdata/calendarHeatmap.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
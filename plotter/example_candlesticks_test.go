@@ -0,0 +1,85 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// randomWalkOHLC returns n daily open-high-low-close
+// records of a random walk price.
+func randomWalkOHLC(n int) []plotter.OHLC {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]plotter.OHLC, n)
+	price := 100.0
+	for i := range data {
+		open := price
+		close := open + 4*rnd.NormFloat64()
+		high := open + 2*rnd.Float64()
+		if close > high {
+			high = close + rnd.Float64()
+		}
+		low := open - 2*rnd.Float64()
+		if close < low {
+			low = close - rnd.Float64()
+		}
+		data[i] = plotter.OHLC{Time: float64(i), Open: open, High: high, Low: low, Close: close}
+		price = close
+	}
+	return data
+}
+
+// ExampleCandlesticks draws a candlestick chart
+// of a random walk price.
+func ExampleCandlesticks() {
+	cs, err := plotter.NewCandlesticks(randomWalkOHLC(30))
+	if err != nil {
+		log.Panic(err)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Candlesticks"
+	p.X.Label.Text = "Day"
+	p.Y.Label.Text = "Price"
+	p.Add(cs)
+
+	err = p.Save(10*vg.Centimeter, 6*vg.Centimeter, "testdata/candlesticks.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+// ExampleCandlesticks_bars draws an OHLC bar chart
+// of a random walk price.
+func ExampleCandlesticks_bars() {
+	cs, err := plotter.NewCandlesticks(randomWalkOHLC(30))
+	if err != nil {
+		log.Panic(err)
+	}
+	cs.Bars = true
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "OHLC bars"
+	p.X.Label.Text = "Day"
+	p.Y.Label.Text = "Price"
+	p.Add(cs)
+
+	err = p.Save(10*vg.Centimeter, 6*vg.Centimeter, "testdata/ohlcBars.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
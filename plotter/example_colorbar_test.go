@@ -7,10 +7,15 @@ package plotter_test
 import (
 	"image/color"
 	"log"
+	"os"
 
+	"gonum.org/v1/gonum/mat"
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/palette/moreland"
 	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
 )
 
 func ExampleColorBar_horizontal() {
@@ -74,3 +79,61 @@ func ExampleColorBar_vertical() {
 		log.Panic(err)
 	}
 }
+
+// This example shows how to draw a ColorBar next to a HeatMap
+// using the same colors.
+func ExampleColorBar_heatMap() {
+	m := offsetUnitGrid{
+		Data: mat.NewDense(3, 4, []float64{
+			1, 2, 3, 4,
+			5, 6, 7, 8,
+			9, 10, 11, 12,
+		})}
+
+	cm := moreland.SmoothBlueRed()
+	cm.SetMin(1)
+	cm.SetMax(12)
+	h := plotter.NewHeatMap(m, cm.Palette(12))
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Heat map"
+	p.Add(h)
+
+	bar, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	bar.Add(&plotter.ColorBar{ColorMap: cm, Vertical: true})
+	bar.HideX()
+	bar.Y.Padding = 0
+
+	img := vgimg.New(300, 200)
+	dc := draw.New(img)
+
+	// Draw the heat map on the left and the color
+	// bar on the right, along the heat map data area.
+	const barWidth = 40
+	left := draw.Crop(dc, 0, -barWidth-vg.Millimeter, 0, 0)
+	p.Draw(left)
+	da := p.DataCanvas(left)
+	bar.Draw(draw.Canvas{
+		Canvas: dc,
+		Rectangle: vg.Rectangle{
+			Min: vg.Point{X: dc.Max.X - barWidth, Y: da.Min.Y},
+			Max: vg.Point{X: dc.Max.X, Y: da.Max.Y},
+		},
+	})
+
+	w, err := os.Create("testdata/colorBarHeatMap.png")
+	if err != nil {
+		log.Panic(err)
+	}
+	defer w.Close()
+	png := vgimg.PngCanvas{Canvas: img}
+	if _, err = png.WriteTo(w); err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+	"math"
+	"os"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+// An example of a spiral track colored by the time
+// along the track.
+func ExampleColoredLine() {
+	const n = 200
+	xyzs := make(plotter.XYZs, n)
+	for i := range xyzs {
+		t := float64(i) / (n - 1)
+		a := 6 * math.Pi * t
+		xyzs[i] = plotter.XYZ{X: t * math.Cos(a), Y: t * math.Sin(a), Z: t}
+	}
+
+	cm := moreland.SmoothBlueRed()
+	l, err := plotter.NewColoredLine(xyzs, cm)
+	if err != nil {
+		log.Panic(err)
+	}
+	min, max := l.ValueRange()
+	cm.SetMin(min)
+	cm.SetMax(max)
+	l.Width = vg.Points(2)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Colored line"
+	p.Add(l)
+
+	img := vgimg.New(250, 250)
+	dc := draw.New(img)
+
+	p.Draw(dc)
+	w, err := os.Create("testdata/coloredLine.png")
+	if err != nil {
+		log.Panic(err)
+	}
+	defer w.Close()
+	png := vgimg.PngCanvas{Canvas: img}
+	if _, err = png.WriteTo(w); err != nil {
+		log.Panic(err)
+	}
+}
@@ -5,6 +5,7 @@
 package plotter_test
 
 import (
+	"image/color"
 	"log"
 	"math"
 
@@ -59,6 +60,50 @@ func ExampleContour() {
 	}
 }
 
+// ExampleContour_smoothing draws contours of a coarse grid
+// with and without smoothing.
+func ExampleContour_smoothing() {
+	const n = 8
+	data := make([]float64, n*n)
+	for i := range data {
+		r := float64(i/n) - 3.5
+		c := float64(i%n) - 3.5
+		data[i] = math.Hypot(r, 1.5*c) + math.Sin(r)
+	}
+
+	var (
+		grid   = unitGrid{mat.NewDense(n, n, data)}
+		levels = []float64{1, 2, 3, 4}
+		pal    = palette.Rainbow(len(levels), palette.Blue, palette.Red, 1, 1, 1)
+	)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Fatalf("could not create plot: %+v", err)
+	}
+
+	p.Title.Text = "Smoothed contour"
+	p.X.Padding = 0
+	p.Y.Padding = 0
+	p.X.Max = n - 1
+	p.Y.Max = n - 1
+
+	raw := plotter.NewContour(grid, levels, pal)
+	raw.LineStyles[0].Color = color.Gray{Y: 192}
+	raw.Palette = nil
+	p.Add(raw)
+
+	smooth := plotter.NewContour(grid, levels, pal)
+	smooth.Smoothing = 1
+	smooth.LineStyles[0].Width = vg.Points(1.5)
+	p.Add(smooth)
+
+	err = p.Save(10*vg.Centimeter, 10*vg.Centimeter, "testdata/contourSmoothing.png")
+	if err != nil {
+		log.Fatalf("could not save plot: %+v", err)
+	}
+}
+
 type unitGrid struct{ mat.Matrix }
 
 func (g unitGrid) Dims() (c, r int)   { r, c = g.Matrix.Dims(); return c, r }
@@ -0,0 +1,50 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleDendrogram draws the dendrogram of
+// a hierarchical clustering of six fruits.
+func ExampleDendrogram() {
+	fruits := []string{"apple", "pear", "lemon", "lime", "cherry", "plum"}
+	d, err := plotter.NewDendrogram([]plotter.Merge{
+		{A: 2, B: 3, Height: 0.8},
+		{A: 0, B: 1, Height: 1.1},
+		{A: 4, B: 5, Height: 1.5},
+		{A: 7, B: 8, Height: 2.6},
+		{A: 6, B: 9, Height: 4.2},
+	})
+	if err != nil {
+		log.Panic(err)
+	}
+	d.Width = vg.Points(1.5)
+
+	labels := make([]string, len(fruits))
+	for i, leaf := range d.Leaves() {
+		labels[i] = fruits[leaf]
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Fruit clusters"
+	p.Y.Label.Text = "Distance"
+	p.Add(d)
+	p.NominalX(labels...)
+	p.X.Min, p.X.Max = -0.5, float64(len(labels))-0.5
+
+	err = p.Save(250, 200, "testdata/dendrogram.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
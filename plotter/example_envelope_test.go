@@ -0,0 +1,52 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+)
+
+// An example of the min/max envelope of a long,
+// noisy series reduced to one bucket per point of
+// the width of the plot.
+func ExampleEnvelope() {
+	rnd := rand.New(rand.NewSource(1))
+	pts := make(plotter.XYs, 100000)
+	for i := range pts {
+		x := float64(i) / float64(len(pts))
+		pts[i].X = x
+		pts[i].Y = math.Sin(4*math.Pi*x) + 0.2*rnd.NormFloat64()
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Envelope"
+
+	e, err := plotter.NewEnvelope(pts, 200)
+	if err != nil {
+		log.Panic(err)
+	}
+	e.Color = color.NRGBA{B: 255, A: 0x60}
+	e.MeanStyle.Color = color.NRGBA{B: 128, A: 255}
+
+	p.Add(e)
+	p.Legend.Add("samples", e)
+	p.Legend.Top = true
+	p.Y.Max = 2
+
+	err = p.Save(200, 200, "testdata/envelope.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -5,12 +5,14 @@
 package plotter_test
 
 import (
+	"image/color"
 	"log"
 
 	"golang.org/x/exp/rand"
 
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
 )
 
@@ -78,3 +80,46 @@ func ExampleErrors() {
 		log.Panic(err)
 	}
 }
+
+// ExampleErrorEllipses draws points with correlated
+// X and Y errors as one and two standard deviation
+// error ellipses.
+func ExampleErrorEllipses() {
+	rnd := rand.New(rand.NewSource(1))
+
+	n := 8
+	pts := make(plotter.XYs, n)
+	covs := make([]plotter.Covariance, n)
+	for i := range pts {
+		pts[i].X = float64(i) + 0.5*rnd.Float64()
+		pts[i].Y = float64(i) + 2*rnd.Float64()
+		covs[i] = plotter.CorrelatedErrors(0.2+0.2*rnd.Float64(), 0.2+0.4*rnd.Float64(), 2*rnd.Float64()-1)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	scatter, err := plotter.NewScatter(pts)
+	if err != nil {
+		log.Panic(err)
+	}
+	scatter.Shape = draw.CrossGlyph{}
+	inner, err := plotter.NewErrorEllipses(pts, covs)
+	if err != nil {
+		log.Panic(err)
+	}
+	inner.Color = color.NRGBA{B: 255, A: 0x40}
+	outer, err := plotter.NewErrorEllipses(pts, covs)
+	if err != nil {
+		log.Panic(err)
+	}
+	outer.Sigma = 2
+	outer.Dashes = []vg.Length{vg.Points(2), vg.Points(2)}
+	p.Add(outer, inner, scatter)
+
+	err = p.Save(200, 200, "testdata/errorEllipses.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+	"time"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// An example of a project timeline, with an overlapping
+// task and a milestone.
+func ExampleGantt() {
+	date := func(month time.Month, day int) float64 {
+		return float64(time.Date(2020, month, day, 0, 0, 0, 0, time.UTC).Unix())
+	}
+	tasks := []plotter.GanttTask{
+		{Label: "Design", Start: date(time.March, 2), End: date(time.March, 20)},
+		{Label: "Build", Start: date(time.March, 16), End: date(time.April, 24)},
+		{Label: "Build", Start: date(time.April, 6), End: date(time.April, 17),
+			Color: color.RGBA{R: 0xd0, G: 0x60, B: 0x40, A: 0xff}},
+		{Label: "Test", Start: date(time.April, 13), End: date(time.May, 8)},
+		{Label: "Release", Start: date(time.May, 11), End: date(time.May, 11),
+			Color: color.RGBA{R: 0x40, G: 0x80, B: 0xd0, A: 0xff}},
+	}
+	g, err := plotter.NewGantt(tasks, vg.Points(14))
+	if err != nil {
+		log.Panic(err)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Project timeline"
+	p.X.Tick.Marker = plot.TimeTicks{Format: "Jan 2"}
+	p.Y.Scale = plot.InvertedScale{Normalizer: plot.LinearScale{}}
+	p.Add(plotter.NewGrid(), g)
+	p.NominalY(g.Rows...)
+
+	err = p.Save(10*vg.Centimeter, 6*vg.Centimeter, "testdata/gantt.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+)
+
+// An example of a grouped bar chart of three
+// series of values over four categories.
+func ExampleGroupedBarChart() {
+	g, err := plotter.NewGroupedBarChart(
+		[]string{"2018", "2019", "2020"},
+		[]plotter.Valuer{
+			plotter.Values{12, 18, 9, 14},
+			plotter.Values{15, 16, 11, 10},
+			plotter.Values{17, 12, 14, 8},
+		},
+		[]string{"North", "East", "South", "West"},
+	)
+	if err != nil {
+		log.Panic(err)
+	}
+	g.BarGap = 0.1
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Sales by region"
+	p.Y.Label.Text = "Sales"
+	p.Add(g)
+	p.NominalX(g.Categories...)
+	g.AddToLegend(&p.Legend)
+	p.Legend.Top = true
+
+	err = p.Save(300, 250, "testdata/groupedBarChart.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+)
+
+// ExampleHexBin draws the distribution of two clusters
+// of normally distributed points with a hexagonal binning.
+func ExampleHexBin() {
+	rnd := rand.New(rand.NewSource(1))
+	xys := make(plotter.XYs, 5000)
+	for i := range xys {
+		cx, cy := 0.0, 0.0
+		if i%3 == 0 {
+			cx, cy = 3, 2
+		}
+		xys[i].X = cx + rnd.NormFloat64()
+		xys[i].Y = cy + rnd.NormFloat64()
+	}
+
+	h, err := plotter.NewHexBin(xys, 0.25)
+	if err != nil {
+		log.Panic(err)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Hexagonal binning"
+	p.Add(h)
+
+	err = p.Save(250, 200, "testdata/hexBin.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
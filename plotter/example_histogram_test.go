@@ -102,3 +102,36 @@ func ExampleHistogram_logScaleY() {
 		log.Fatal(err)
 	}
 }
+
+// ExampleNewHistogramEdges draws a histogram of counts
+// in bins of different widths, and the corresponding
+// density.
+func ExampleNewHistogramEdges() {
+	edges := []float64{0, 1, 2, 4, 8, 16}
+	counts := plotter.Values{3, 6, 8, 6, 4}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Variable width histogram"
+
+	h, err := plotter.NewHistogramEdges(edges, counts)
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Add(h)
+
+	d, err := plotter.NewHistogramEdges(edges, counts)
+	if err != nil {
+		log.Panic(err)
+	}
+	d.Normalize(27)
+	d.FillColor = color.NRGBA{R: 255, A: 128}
+	p.Add(d)
+
+	err = p.Save(200, 200, "testdata/histogram_edges.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+)
+
+// ExampleKDE2D draws the density of two clusters of
+// normally distributed points as filled bands.
+func ExampleKDE2D() {
+	rnd := rand.New(rand.NewSource(1))
+	xys := make(plotter.XYs, 1000)
+	for i := range xys {
+		cx, cy := 0.0, 0.0
+		if i%3 == 0 {
+			cx, cy = 3, 2
+		}
+		xys[i].X = cx + rnd.NormFloat64()
+		xys[i].Y = cy + rnd.NormFloat64()
+	}
+
+	k, err := plotter.NewKDE2D(xys, 0, 0, moreland.Kindlmann().Palette(8))
+	if err != nil {
+		log.Panic(err)
+	}
+	// Fill eight bands up to the peak of the density.
+	peak := k.Peak().Z
+	for i := 1; i <= 8; i++ {
+		k.Levels = append(k.Levels, peak*float64(i)/9)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Kernel density estimate"
+	p.Add(k)
+
+	err = p.Save(250, 200, "testdata/kde2d.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// ExampleLollipop draws a lollipop chart
+// of monthly changes.
+func ExampleLollipop() {
+	values := plotter.Values{3, 7, -2, 5, 4, -1}
+
+	l, err := plotter.NewLollipop(values)
+	if err != nil {
+		log.Panic(err)
+	}
+	l.Shape = draw.CircleGlyph{}
+	l.Radius = vg.Points(4)
+	l.Color = color.RGBA{R: 196, B: 128, A: 255}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Monthly change"
+	p.Add(l, plotter.NewGrid())
+	p.NominalX("Jan", "Feb", "Mar", "Apr", "May", "Jun")
+
+	err = p.Save(250, 200, "testdata/lollipop.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
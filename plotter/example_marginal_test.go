@@ -0,0 +1,40 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// An example of a scatter plot of correlated
+// values with histograms of the values along
+// its margins.
+func ExampleMarginalHistograms() {
+	rnd := rand.New(rand.NewSource(1))
+	pts := make(plotter.XYs, 500)
+	for i := range pts {
+		x := rnd.NormFloat64()
+		pts[i].X = x
+		pts[i].Y = 0.6*x + 0.8*rnd.NormFloat64()
+	}
+
+	m, err := plotter.NewMarginalHistograms(pts, 20)
+	if err != nil {
+		log.Panic(err)
+	}
+	m.Center.X.Label.Text = "X"
+	m.Center.Y.Label.Text = "Y"
+	m.Scatter.GlyphStyle.Radius = vg.Points(1.5)
+
+	err = m.Save(300, 300, "testdata/marginalHistograms.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
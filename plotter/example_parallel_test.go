@@ -0,0 +1,60 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleParallelCoords draws the measurements
+// of a few flowers of two species across their
+// four dimensions.
+func ExampleParallelCoords() {
+	records := [][]float64{
+		{5.1, 3.5, 1.4, 0.2},
+		{4.9, 3.0, 1.4, 0.2},
+		{4.7, 3.2, 1.3, 0.2},
+		{5.0, 3.6, 1.4, 0.3},
+		{7.0, 3.2, 4.7, 1.4},
+		{6.4, 3.2, 4.5, 1.5},
+		{6.9, 3.1, 4.9, 1.5},
+		{5.5, 2.3, 4.0, 1.3},
+	}
+	pc, err := plotter.NewParallelCoords(records, []plotter.Dimension{
+		{Min: 4, Max: 8},
+		{Min: 2, Max: 4},
+		{Min: 0, Max: 6},
+		{Min: 0, Max: 2},
+	})
+	if err != nil {
+		log.Panic(err)
+	}
+	pc.Classes = []int{0, 0, 0, 0, 1, 1, 1, 1}
+	pc.Colors = []color.Color{
+		color.RGBA{R: 196, A: 255},
+		color.RGBA{B: 196, A: 255},
+	}
+	pc.Width = vg.Points(1)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Flower measurements"
+	p.Add(pc)
+	p.NominalX("sepal length", "sepal width", "petal length", "petal width")
+	p.X.Min, p.X.Max = -0.5, 3.5
+	p.HideY()
+
+	err = p.Save(300, 200, "testdata/parallelCoords.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
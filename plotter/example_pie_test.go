@@ -0,0 +1,51 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExamplePie draws a donut chart of the shares
+// of a budget, with percentage labels and a
+// legend of the wedges.
+func ExamplePie() {
+	shares := plotter.Values{35, 25, 20, 12, 8}
+	labels := []string{"rent", "food", "travel", "savings", "other"}
+	pie, err := plotter.NewPie(shares, labels)
+	if err != nil {
+		log.Panic(err)
+	}
+	pie.InnerRadius = 0.5
+	pie.PercentFormat = "%.0f%%"
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Budget"
+	p.Add(pie)
+	p.HideAxes()
+
+	// Leave room for the legend on the right
+	// of the chart, keeping the axes at the
+	// same scale.
+	p.X.Min, p.X.Max = -1, 2
+	p.Y.Min, p.Y.Max = -1, 1
+	for i, thumb := range pie.Thumbnailers() {
+		p.Legend.Add(pie.Labels[i], thumb)
+	}
+	p.Legend.Top = true
+	p.Legend.XOffs = vg.Points(-5)
+
+	err = p.Save(300, 220, "testdata/pie.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// An example of a radar chart, comparing two series
+// of scores over six categories.
+func ExamplePolarGrid_radar() {
+	categories := []string{"Speed", "Power", "Range", "Comfort", "Safety", "Price"}
+	series := []struct {
+		name   string
+		scores []float64
+		color  color.Color
+	}{
+		{name: "A", scores: []float64{8, 6, 9, 5, 7, 4}, color: color.RGBA{R: 196, A: 255}},
+		{name: "B", scores: []float64{5, 9, 4, 8, 6, 7}, color: color.RGBA{B: 196, A: 255}},
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Radar chart"
+	p.HideAxes()
+
+	grid, err := plotter.NewPolarGrid(10)
+	if err != nil {
+		log.Panic(err)
+	}
+	grid.Angles = make([]float64, len(categories))
+	for i := range categories {
+		grid.Angles[i] = math.Pi/2 - 2*math.Pi*float64(i)/float64(len(categories))
+	}
+	grid.AngleLabels = categories
+	grid.RadiusAngle = math.Pi/2 - math.Pi/float64(len(categories))
+	p.Add(grid)
+
+	for _, s := range series {
+		// Close the polygon by repeating the first point.
+		pts := make(plotter.XYs, len(s.scores)+1)
+		for i := range pts {
+			j := i % len(s.scores)
+			pts[i] = plotter.XY{X: grid.Angles[j], Y: s.scores[j]}
+		}
+		l, err := plotter.NewPolarLine(pts)
+		if err != nil {
+			log.Panic(err)
+		}
+		l.Color = s.color
+		l.Width = vg.Points(1.5)
+		p.Add(l)
+		p.Legend.Add(s.name, l)
+	}
+	p.Legend.Top = true
+
+	err = p.Save(300, 300, "testdata/radar.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/stat/distuv"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// An example of a Q-Q plot checking a sample
+// against the normal distribution.
+func ExampleQQ() {
+	rnd := rand.New(rand.NewSource(1))
+	// The sample is skewed to the right, so
+	// its largest quantiles lie above the line.
+	sample := make(plotter.Values, 100)
+	for i := range sample {
+		v := rnd.NormFloat64()
+		if v > 0 {
+			v *= 1.5
+		}
+		sample[i] = 10 + 2*v
+	}
+
+	qq, err := plotter.NewQQ(sample, distuv.UnitNormal)
+	if err != nil {
+		log.Panic(err)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Normal Q-Q plot"
+	p.X.Label.Text = "Theoretical quantiles"
+	p.Y.Label.Text = "Sample quantiles"
+	p.Add(plotter.NewGrid(), qq)
+
+	err = p.Save(10*vg.Centimeter, 10*vg.Centimeter, "testdata/qq.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+	"os"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+// An example of a rotational vector field drawn as
+// arrows colored by the magnitude of the vectors.
+func ExampleQuiver() {
+	q := plotter.NewQuiver(field{
+		r: 11, c: 11,
+		fn: func(x, y float64) plotter.XY {
+			return plotter.XY{
+				X: -y,
+				Y: x,
+			}
+		},
+	})
+
+	cm := moreland.SmoothBlueRed()
+	cm.SetMin(0)
+	cm.SetMax(8)
+	q.ColorMap = cm
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Rotational field"
+
+	p.X.Tick.Marker = integerTicks{}
+	p.Y.Tick.Marker = integerTicks{}
+
+	p.Add(q)
+
+	img := vgimg.New(250, 250)
+	dc := draw.New(img)
+
+	p.Draw(dc)
+	w, err := os.Create("testdata/quiver.png")
+	if err != nil {
+		log.Panic(err)
+	}
+	defer w.Close()
+	png := vgimg.PngCanvas{Canvas: img}
+	if _, err = png.WriteTo(w); err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+)
+
+// An example of a radar chart, comparing three
+// series of scores over six categories.
+func ExampleRadar() {
+	r, err := plotter.NewRadar(
+		[]string{"Speed", "Power", "Range", "Comfort", "Safety", "Price"},
+		[]string{"A", "B", "C"},
+		plotter.Values{8, 6, 9, 5, 7, 4},
+		plotter.Values{5, 9, 4, 8, 6, 7},
+		plotter.Values{6, 5, 6, 6, 9, 8},
+	)
+	if err != nil {
+		log.Panic(err)
+	}
+	r.Max = 10
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Radar chart"
+	p.HideAxes()
+	p.Add(r)
+	for i, th := range r.Thumbnailers() {
+		p.Legend.Add(r.Names[i], th)
+	}
+	p.Legend.Top = true
+
+	err = p.Save(300, 300, "testdata/radarChart.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// An example of a scatter plot with reference
+// lines at a threshold and at the mean of the
+// values.
+func ExampleRefLine() {
+	rnd := rand.New(rand.NewSource(1))
+	pts := make(plotter.XYs, 50)
+	var mean float64
+	for i := range pts {
+		pts[i].X = float64(i)
+		pts[i].Y = 10 + 2*rnd.NormFloat64()
+		mean += pts[i].Y
+	}
+	mean /= float64(len(pts))
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Reference lines"
+	s, err := plotter.NewScatter(pts)
+	if err != nil {
+		log.Panic(err)
+	}
+
+	threshold, err := plotter.NewRefLine(14, "threshold")
+	if err != nil {
+		log.Panic(err)
+	}
+	threshold.Horizontal = true
+	threshold.Color = color.RGBA{R: 255, A: 255}
+	threshold.Dashes = []vg.Length{vg.Points(4), vg.Points(2)}
+
+	avg, err := plotter.NewRefLine(mean, "mean")
+	if err != nil {
+		log.Panic(err)
+	}
+	avg.Horizontal = true
+	avg.Placement = plotter.RefLabelStart
+	avg.Color = color.RGBA{B: 255, A: 255}
+
+	start, err := plotter.NewRefLine(25, "change")
+	if err != nil {
+		log.Panic(err)
+	}
+	start.Placement = plotter.RefLabelAbove
+	start.Color = color.Gray{Y: 128}
+
+	p.Add(s, threshold, avg, start)
+
+	err = p.Save(200, 200, "testdata/refline.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
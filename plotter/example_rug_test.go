@@ -0,0 +1,51 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// An example of a histogram with a rug
+// plot of the values beneath it.
+func ExampleRug() {
+	rnd := rand.New(rand.NewSource(1))
+	vals := make(plotter.Values, 100)
+	for i := range vals {
+		vals[i] = rnd.NormFloat64()
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Histogram and rug"
+	h, err := plotter.NewHist(vals, 10)
+	if err != nil {
+		log.Panic(err)
+	}
+	h.FillColor = color.Gray{Y: 200}
+
+	r, err := plotter.NewRug(vals)
+	if err != nil {
+		log.Panic(err)
+	}
+	r.Length = vg.Points(8)
+	r.Color = color.RGBA{R: 255, A: 255}
+	r.Width = vg.Points(0.5)
+	p.Add(h, r)
+
+	err = p.Save(200, 200, "testdata/rug.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleSmooth draws a LOESS trend line
+// through a noisy scatter.
+func ExampleSmooth() {
+	rnd := rand.New(rand.NewSource(1))
+	pts := make(plotter.XYs, 100)
+	for i := range pts {
+		x := 10 * rnd.Float64()
+		pts[i] = plotter.XY{X: x, Y: math.Sin(x) + x/5 + 0.4*rnd.NormFloat64()}
+	}
+
+	s, err := plotter.NewScatter(pts)
+	if err != nil {
+		log.Panic(err)
+	}
+	s.Color = color.Gray{Y: 128}
+	s.Radius = vg.Points(2)
+
+	trend, err := plotter.NewSmooth(pts, 0.3)
+	if err != nil {
+		log.Panic(err)
+	}
+	trend.Color = color.RGBA{R: 196, A: 255}
+	trend.Width = vg.Points(2)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "LOESS trend"
+	p.Add(s, trend)
+	p.Legend.Add("data", s)
+	p.Legend.Add("LOESS, span 0.3", trend)
+
+	err = p.Save(250, 200, "testdata/smooth.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
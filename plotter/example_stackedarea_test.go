@@ -0,0 +1,51 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleStackedArea draws three series stacked on top of each other.
+func ExampleStackedArea() {
+	const n = 20
+	series := make([]plotter.XYer, 3)
+	for i := range series {
+		xys := make(plotter.XYs, n)
+		for j := range xys {
+			x := float64(j)
+			xys[j].X = x
+			xys[j].Y = 1 + math.Sin(x/3+float64(i))
+		}
+		series[i] = xys
+	}
+
+	area, err := plotter.NewStackedArea(series...)
+	if err != nil {
+		log.Panic(err)
+	}
+	area.LineStyle.Width = vg.Points(1)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Stacked area"
+	p.Add(area)
+	for i, thumb := range area.Thumbnailers() {
+		p.Legend.Add([]string{"a", "b", "c"}[i], thumb)
+	}
+	p.Legend.Top = true
+
+	err = p.Save(200, 200, "testdata/stackedArea.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
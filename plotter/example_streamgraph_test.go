@@ -0,0 +1,53 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+)
+
+// ExampleStreamgraph draws five series peaking at different
+// times around a minimized-wiggle baseline, ordered inside-out.
+func ExampleStreamgraph() {
+	const n = 40
+	series := make([]plotter.XYer, 5)
+	for i := range series {
+		xys := make(plotter.XYs, n)
+		peak := float64(5 + 7*i)
+		for j := range xys {
+			x := float64(j)
+			xys[j].X = x
+			xys[j].Y = 0.2 + 3*math.Exp(-(x-peak)*(x-peak)/30)
+		}
+		series[i] = xys
+	}
+
+	s, err := plotter.NewStreamgraph(series...)
+	if err != nil {
+		log.Panic(err)
+	}
+	s.Baseline = plotter.WiggleBaseline
+	s.InsideOut()
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Streamgraph"
+	p.Add(s)
+	for i, thumb := range s.Thumbnailers() {
+		p.Legend.Add([]string{"a", "b", "c", "d", "e"}[i], thumb)
+	}
+	p.Legend.Top = true
+
+	err = p.Save(300, 200, "testdata/streamgraph.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+func ExampleViolin() {
+	rnd := rand.New(rand.NewSource(1))
+
+	// Create the sample data.
+	const n = 100
+	uniform := make(plotter.Values, n)
+	normal := make(plotter.Values, n)
+	expon := make(plotter.Values, n)
+	for i := 0; i < n; i++ {
+		uniform[i] = rnd.Float64()
+		normal[i] = rnd.NormFloat64()
+		expon[i] = rnd.ExpFloat64()
+	}
+
+	// Make violins for our data.
+	var violins []*plotter.Violin
+	for i, vs := range []plotter.Values{uniform, normal, expon} {
+		v, err := plotter.NewViolin(vg.Points(40), float64(i), vs)
+		if err != nil {
+			log.Panic(err)
+		}
+		v.FillColor = color.NRGBA{R: 100, G: 100, B: 255, A: 128}
+		violins = append(violins, v)
+	}
+	// Use a narrower bandwidth for the exponential distribution.
+	violins[2].KDE.Bandwidth = 0.1
+
+	p1, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p1.Title.Text = "Vertical Violin Plot"
+	p1.Y.Label.Text = "plotter.Values"
+	for _, v := range violins {
+		p1.Add(v)
+	}
+	p1.NominalX("Uniform\nDistribution", "Normal\nDistribution",
+		"Exponential\nDistribution")
+
+	err = p1.Save(200, 200, "testdata/verticalViolin.png")
+	if err != nil {
+		log.Panic(err)
+	}
+
+	// Now, make the same plot but horizontal.
+	p2, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p2.Title.Text = "Horizontal Violin Plot"
+	p2.X.Label.Text = "plotter.Values"
+	for _, v := range violins {
+		v.Horizontal = true
+		p2.Add(v)
+	}
+	p2.NominalY("Uniform\nDistribution", "Normal\nDistribution",
+		"Exponential\nDistribution")
+
+	err = p2.Save(200, 200, "testdata/horizontalViolin.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleWaterfall draws a waterfall chart of
+// the changes of a balance over a year.
+func ExampleWaterfall() {
+	steps := []plotter.WaterfallStep{
+		{Label: "Start", Value: 10},
+		{Label: "Q1", Value: 4},
+		{Label: "Q2", Value: -6},
+		{Label: "Half", Total: true},
+		{Label: "Q3", Value: -11},
+		{Label: "Q4", Value: 5},
+		{Label: "End", Total: true},
+	}
+	w, err := plotter.NewWaterfall(steps, vg.Points(18))
+	if err != nil {
+		log.Panic(err)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Balance"
+	p.Add(w, plotter.NewGrid())
+	p.NominalX(w.Labels()...)
+
+	err = p.Save(250, 200, "testdata/waterfall.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -175,22 +175,28 @@ func drawVector(c vg.Canvas, v XY) {
 // DataRange implements the DataRange method
 // of the plot.DataRanger interface.
 func (f *Field) DataRange() (xmin, xmax, ymin, ymax float64) {
-	c, r := f.FieldXY.Dims()
+	return fieldDataRange(f.FieldXY)
+}
+
+// fieldDataRange returns the range of the cells of the grid of f,
+// extending half the distance to the neighbouring grid lines.
+func fieldDataRange(f FieldXY) (xmin, xmax, ymin, ymax float64) {
+	c, r := f.Dims()
 	switch c {
 	case 1: // Make a unit length when there is no neighbour.
-		xmax = f.FieldXY.X(0) + 0.5
-		xmin = f.FieldXY.X(0) - 0.5
+		xmax = f.X(0) + 0.5
+		xmin = f.X(0) - 0.5
 	default:
-		xmax = f.FieldXY.X(c-1) + (f.FieldXY.X(c-1)-f.FieldXY.X(c-2))/2
-		xmin = f.FieldXY.X(0) - (f.FieldXY.X(1)-f.FieldXY.X(0))/2
+		xmax = f.X(c-1) + (f.X(c-1)-f.X(c-2))/2
+		xmin = f.X(0) - (f.X(1)-f.X(0))/2
 	}
 	switch r {
 	case 1: // Make a unit length when there is no neighbour.
-		ymax = f.FieldXY.Y(0) + 0.5
-		ymin = f.FieldXY.Y(0) - 0.5
+		ymax = f.Y(0) + 0.5
+		ymin = f.Y(0) - 0.5
 	default:
-		ymax = f.FieldXY.Y(r-1) + (f.FieldXY.Y(r-1)-f.FieldXY.Y(r-2))/2
-		ymin = f.FieldXY.Y(0) - (f.FieldXY.Y(1)-f.FieldXY.Y(0))/2
+		ymax = f.Y(r-1) + (f.Y(r-1)-f.Y(r-2))/2
+		ymin = f.Y(0) - (f.Y(1)-f.Y(0))/2
 	}
 	return xmin, xmax, ymin, ymax
 }
@@ -5,6 +5,8 @@
 package plotter
 
 import (
+	"math"
+
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
@@ -21,15 +23,30 @@ type Function struct {
 
 	Samples int
 
+	// Tolerance, if positive, enables adaptive
+	// sampling. The intervals between samples are
+	// recursively halved while the line drawn through
+	// the middle of an interval deviates from the
+	// straight segment joining its ends by more than
+	// Tolerance.
+	Tolerance vg.Length
+
+	// MaxDepth is the maximum number of times an
+	// interval between two samples is halved when
+	// sampling adaptively.
+	MaxDepth int
+
 	draw.LineStyle
 }
 
 // NewFunction returns a Function that plots F using
-// the default line style with 50 samples.
+// the default line style with 50 samples. Adaptive
+// sampling is disabled, with a maximum depth of 10.
 func NewFunction(f func(float64) float64) *Function {
 	return &Function{
 		F:         f,
 		Samples:   50,
+		MaxDepth:  10,
 		LineStyle: DefaultLineStyle,
 	}
 }
@@ -37,6 +54,9 @@ func NewFunction(f func(float64) float64) *Function {
 // Plot implements the Plotter interface, drawing a line
 // that connects each point in the Line.
 func (f *Function) Plot(c draw.Canvas, p *plot.Plot) {
+	if f.Samples < 1 {
+		return
+	}
 	trX, trY := p.Transforms(&c)
 
 	min, max := f.XMin, f.XMax
@@ -44,16 +64,51 @@ func (f *Function) Plot(c draw.Canvas, p *plot.Plot) {
 		min = p.X.Min
 		max = p.X.Max
 	}
+	at := func(x float64) vg.Point {
+		return vg.Point{X: trX(x), Y: trY(f.F(x))}
+	}
 	d := (max - min) / float64(f.Samples-1)
-	line := make([]vg.Point, f.Samples)
-	for i := range line {
-		x := min + float64(i)*d
-		line[i].X = trX(x)
-		line[i].Y = trY(f.F(x))
+	line := make([]vg.Point, 1, f.Samples)
+	line[0] = at(min)
+	for i := 1; i < f.Samples; i++ {
+		x0, x1 := min+float64(i-1)*d, min+float64(i)*d
+		p1 := at(x1)
+		if f.Tolerance > 0 {
+			line = f.subdivide(line, at, x0, x1, line[len(line)-1], p1, 0)
+			continue
+		}
+		line = append(line, p1)
 	}
 	c.StrokeLines(f.LineStyle, c.ClipLinesXY(line)...)
 }
 
+// subdivide appends to line the points sampled
+// adaptively between x0 and x1, excluding the point
+// p0 at x0 and ending with the point p1 at x1.
+func (f *Function) subdivide(line []vg.Point, at func(float64) vg.Point, x0, x1 float64, p0, p1 vg.Point, depth int) []vg.Point {
+	if depth < f.MaxDepth {
+		xm := (x0 + x1) / 2
+		pm := at(xm)
+		if segmentDistance(pm, p0, p1) > f.Tolerance {
+			line = f.subdivide(line, at, x0, xm, p0, pm, depth+1)
+			return f.subdivide(line, at, xm, x1, pm, p1, depth+1)
+		}
+	}
+	return append(line, p1)
+}
+
+// segmentDistance returns the distance between
+// the point p and the segment from a to b.
+func segmentDistance(p, a, b vg.Point) vg.Length {
+	ab, ap := b.Sub(a), p.Sub(a)
+	l2 := ab.Dot(ab)
+	if l2 > 0 {
+		t := math.Max(0, math.Min(1, float64(ap.Dot(ab)/l2)))
+		ap = ap.Sub(ab.Scale(vg.Length(t)))
+	}
+	return vg.Length(math.Hypot(float64(ap.X), float64(ap.Y)))
+}
+
 // Thumbnail draws a line in the given style down the
 // center of a DrawArea as a thumbnail representation
 // of the LineStyle of the function.
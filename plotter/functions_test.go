@@ -5,11 +5,106 @@
 package plotter_test
 
 import (
+	"math"
 	"testing"
 
+	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 func TestFunction(t *testing.T) {
 	cmpimg.CheckPlot(ExampleFunction, t, "functions.png")
 }
+
+func TestFunctionNoSamples(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.X.Min, p.X.Max = 0, 1
+
+	// A Function without samples draws nothing.
+	for _, f := range []*plotter.Function{
+		{F: math.Sin},
+		{F: math.Sin, Samples: -1, Tolerance: 1},
+	} {
+		var rec recorder.Canvas
+		f.Plot(draw.NewCanvas(&rec, 100, 100), p)
+		if len(rec.Actions) != 0 {
+			t.Errorf("unexpected actions for %d samples: %v", f.Samples, rec.Actions)
+		}
+	}
+}
+
+func TestFunctionAdaptive(t *testing.T) {
+	const (
+		xmin = 0.05
+		xmax = 1.0
+		size = 1000
+	)
+	// sample returns the X values, in data coordinates,
+	// of the points of the line drawn for f.
+	sample := func(f *plotter.Function) []float64 {
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		p.X.Min, p.X.Max = xmin, xmax
+		p.Y.Min, p.Y.Max = -1, 1
+
+		var rec recorder.Canvas
+		f.Plot(draw.NewCanvas(&rec, size, size), p)
+		var xs []float64
+		for _, a := range rec.Actions {
+			s, ok := a.(*recorder.Stroke)
+			if !ok {
+				continue
+			}
+			for _, comp := range s.Path {
+				xs = append(xs, xmin+float64(comp.Pos.X)/size*(xmax-xmin))
+			}
+		}
+		return xs
+	}
+	count := func(xs []float64, min, max float64) int {
+		var n int
+		for _, x := range xs {
+			if min <= x && x <= max {
+				n++
+			}
+		}
+		return n
+	}
+
+	f := plotter.NewFunction(func(x float64) float64 { return math.Sin(1 / x) })
+	f.XMin, f.XMax = xmin, xmax
+	f.Samples = 20
+
+	xs := sample(f)
+	if len(xs) != f.Samples {
+		t.Errorf("unexpected number of fixed samples: got:%d want:%d", len(xs), f.Samples)
+	}
+
+	f.Tolerance = vg.Points(0.5)
+	xs = sample(f)
+	if len(xs) <= f.Samples {
+		t.Errorf("adaptive sampling did not add samples: got:%d", len(xs))
+	}
+	// Compare the number of samples per unit of x in the
+	// oscillating region near zero with the nearly flat
+	// region near one.
+	sharp := float64(count(xs, 0.05, 0.2)) / 0.15
+	flat := float64(count(xs, 0.6, 1)) / 0.4
+	if sharp < 5*flat {
+		t.Errorf("unexpected sample density: got sharp:%.1f flat:%.1f", sharp, flat)
+	}
+	for i := 1; i < len(xs); i++ {
+		if xs[i] <= xs[i-1] {
+			t.Fatalf("samples not increasing at %d: %v <= %v", i, xs[i], xs[i-1])
+		}
+	}
+}
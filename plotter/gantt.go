@@ -0,0 +1,257 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// GanttTask is a task of a Gantt chart.
+type GanttTask struct {
+	// Label is the label of the row of the task.
+	// Tasks with the same label share a row.
+	Label string
+
+	// Start and End are the times of the start and
+	// the end of the task, in the units of the X axis,
+	// such as the Unix times used by plot.TimeTicks.
+	// A task starting and ending at the same time is
+	// a milestone.
+	Start, End float64
+
+	// Color is the fill color of the task. The
+	// Color of the Gantt chart is used if Color
+	// is nil.
+	Color color.Color
+}
+
+// Gantt implements the Plotter interface, drawing a Gantt
+// chart: each task is a horizontal bar spanning its time
+// along the X axis, on the row of its label, and each
+// milestone is a diamond at its time. The rows are at the
+// positions 0 to n-1 along the Y axis for n rows, so that
+// they can be labelled by calling the NominalY method of
+// the plot with the Rows. A plot.InvertedScale on the Y
+// axis lists the first row at the top.
+//
+// The overlapping tasks of a row are drawn in lanes
+// sharing the thickness of the bars of the row.
+type Gantt struct {
+	// Tasks is a copy of the tasks of the chart.
+	Tasks []GanttTask
+
+	// Rows are the labels of the rows,
+	// in order of first appearance in
+	// the Tasks.
+	Rows []string
+
+	// Width is the thickness of the bars of a row,
+	// and the width of the milestones.
+	Width vg.Length
+
+	// Color is the fill color of the
+	// tasks without a color.
+	Color color.Color
+
+	// LineStyle is the style of the outline of
+	// the bars and the milestones.
+	// Use zero width to disable outlines.
+	draw.LineStyle
+
+	// row, lane and lanes are the row and the
+	// lane of each task and the number of lanes
+	// of each row.
+	row, lane []int
+	lanes     []int
+}
+
+// NewGantt returns a Gantt chart of the tasks, with bars
+// of the given thickness filled in gray and outlined in the
+// default line style.
+//
+// An error is returned if the width is not positive, if
+// there are no tasks, if a time is infinite or NaN, or if
+// a task ends before it starts.
+func NewGantt(tasks []GanttTask, width vg.Length) (*Gantt, error) {
+	if width <= 0 {
+		return nil, errors.New("plotter: width parameter was not positive")
+	}
+	if len(tasks) == 0 {
+		return nil, ErrNoData
+	}
+	g := &Gantt{
+		Tasks:     append([]GanttTask(nil), tasks...),
+		Width:     width,
+		Color:     color.Gray{Y: 0x80},
+		LineStyle: DefaultLineStyle,
+		row:       make([]int, len(tasks)),
+		lane:      make([]int, len(tasks)),
+	}
+
+	rows := make(map[string]int)
+	for i, t := range g.Tasks {
+		if err := CheckFloats(t.Start, t.End); err != nil {
+			return nil, err
+		}
+		if t.End < t.Start {
+			return nil, errors.New("plotter: Gantt task ends before it starts")
+		}
+		r, ok := rows[t.Label]
+		if !ok {
+			r = len(g.Rows)
+			rows[t.Label] = r
+			g.Rows = append(g.Rows, t.Label)
+		}
+		g.row[i] = r
+	}
+
+	// The tasks of each row are put in the first lane
+	// free at their start, in order of start time.
+	// Milestones are drawn across the lanes.
+	order := make([]int, len(g.Tasks))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return g.Tasks[order[a]].Start < g.Tasks[order[b]].Start
+	})
+	ends := make([][]float64, len(g.Rows))
+	for _, i := range order {
+		t := g.Tasks[i]
+		if t.Start == t.End {
+			continue
+		}
+		r := g.row[i]
+		l := 0
+		for l < len(ends[r]) && ends[r][l] > t.Start {
+			l++
+		}
+		if l == len(ends[r]) {
+			ends[r] = append(ends[r], 0)
+		}
+		ends[r][l] = t.End
+		g.lane[i] = l
+	}
+	g.lanes = make([]int, len(g.Rows))
+	for r, e := range ends {
+		g.lanes[r] = len(e)
+	}
+	return g, nil
+}
+
+// color returns the fill color of the ith task.
+func (g *Gantt) color(i int) color.Color {
+	if c := g.Tasks[i].Color; c != nil {
+		return c
+	}
+	return g.Color
+}
+
+// Plot draws the Gantt chart, implementing the plot.Plotter
+// interface.
+func (g *Gantt) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	for i, t := range g.Tasks {
+		y := trY(float64(g.row[i]))
+		if t.Start == t.End {
+			// Milestones are drawn like glyphs,
+			// unclipped if their center is on
+			// the canvas.
+			x, r := trX(t.Start), g.Width/2
+			if !c.Contains(vg.Point{X: x, Y: y}) {
+				continue
+			}
+			pts := []vg.Point{
+				{X: x, Y: y - r},
+				{X: x + r, Y: y},
+				{X: x, Y: y + r},
+				{X: x - r, Y: y},
+			}
+			g.draw(&c, i, pts, [][]vg.Point{append(pts, pts[0])})
+			continue
+		}
+		if !c.ContainsY(y) {
+			continue
+		}
+		h := g.Width / vg.Length(g.lanes[g.row[i]])
+		bottom := y - g.Width/2 + h*vg.Length(g.lane[i])
+		xmin, xmax := trX(t.Start), trX(t.End)
+		pts := []vg.Point{
+			{X: xmin, Y: bottom},
+			{X: xmin, Y: bottom + h},
+			{X: xmax, Y: bottom + h},
+			{X: xmax, Y: bottom},
+		}
+		g.draw(&c, i, c.ClipPolygonX(pts), c.ClipLinesX(append(pts, pts[0])))
+	}
+}
+
+// draw fills the polygon of the ith task
+// and strokes its outline.
+func (g *Gantt) draw(c *draw.Canvas, i int, poly []vg.Point, outline [][]vg.Point) {
+	if col := g.color(i); col != nil {
+		c.FillPolygon(col, poly)
+	}
+	if g.LineStyle.Width != 0 {
+		c.StrokeLines(g.LineStyle, outline...)
+	}
+}
+
+// DataRange returns the span of the times of the tasks
+// and the range of the rows, implementing the
+// plot.DataRanger interface.
+func (g *Gantt) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, xmax = math.Inf(1), math.Inf(-1)
+	for _, t := range g.Tasks {
+		xmin = math.Min(xmin, t.Start)
+		xmax = math.Max(xmax, t.End)
+	}
+	return xmin, xmax, 0, float64(len(g.Rows) - 1)
+}
+
+// GlyphBoxes returns a GlyphBox covering the thickness of
+// the bar of each task, and the width of each milestone,
+// implementing the plot.GlyphBoxer interface.
+func (g *Gantt) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	bs := make([]plot.GlyphBox, len(g.Tasks))
+	r := g.Width / 2
+	for i, t := range g.Tasks {
+		bs[i].X = plt.X.Norm(t.Start)
+		bs[i].Y = plt.Y.Norm(float64(g.row[i]))
+		bs[i].Rectangle = vg.Rectangle{
+			Min: vg.Point{Y: -r},
+			Max: vg.Point{Y: r},
+		}
+		if t.Start == t.End {
+			bs[i].Rectangle.Min.X = -r
+			bs[i].Rectangle.Max.X = r
+		}
+	}
+	return bs
+}
+
+// Thumbnail fills the thumbnail with the Color of the chart
+// and outlines it, implementing the plot.Thumbnailer interface.
+func (g *Gantt) Thumbnail(c *draw.Canvas) {
+	pts := []vg.Point{
+		{X: c.Min.X, Y: c.Min.Y},
+		{X: c.Min.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Min.Y},
+	}
+	if g.Color != nil {
+		c.FillPolygon(g.Color, c.ClipPolygonY(pts))
+	}
+	if g.LineStyle.Width != 0 {
+		c.StrokeLines(g.LineStyle, c.ClipLinesY(append(pts, pts[0]))...)
+	}
+}
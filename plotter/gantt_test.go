@@ -0,0 +1,144 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestGantt(t *testing.T) {
+	cmpimg.CheckPlot(ExampleGantt, t, "gantt.png")
+}
+
+func TestGanttGeometry(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		tasks []plotter.GanttTask
+
+		wantRows []string
+		wantXMax float64
+		want     []vg.Rectangle
+	}{
+		{
+			name: "tasks and milestone",
+			tasks: []plotter.GanttTask{
+				{Label: "A", Start: 0, End: 4},
+				{Label: "B", Start: 2, End: 10},
+				{Label: "B", Start: 6, End: 6},
+			},
+			wantRows: []string{"A", "B"},
+			wantXMax: 10,
+			// Rows are at 2cm and 4cm, and one
+			// unit of time is one centimeter.
+			want: []vg.Rectangle{
+				{Min: vg.Point{X: 0, Y: 1.5 * vg.Centimeter}, Max: vg.Point{X: 4 * vg.Centimeter, Y: 2.5 * vg.Centimeter}},
+				{Min: vg.Point{X: 2 * vg.Centimeter, Y: 3.5 * vg.Centimeter}, Max: vg.Point{X: 10 * vg.Centimeter, Y: 4.5 * vg.Centimeter}},
+				{Min: vg.Point{X: 5.5 * vg.Centimeter, Y: 3.5 * vg.Centimeter}, Max: vg.Point{X: 6.5 * vg.Centimeter, Y: 4.5 * vg.Centimeter}},
+			},
+		},
+		{
+			name: "overlapping tasks",
+			tasks: []plotter.GanttTask{
+				{Label: "A", Start: 0, End: 4},
+				{Label: "A", Start: 3, End: 5},
+				{Label: "A", Start: 4, End: 8},
+			},
+			wantRows: []string{"A"},
+			wantXMax: 8,
+			// The third task starts at the end
+			// of the first, in the first lane.
+			want: []vg.Rectangle{
+				{Min: vg.Point{X: 0, Y: 1.5 * vg.Centimeter}, Max: vg.Point{X: 4 * vg.Centimeter, Y: 2 * vg.Centimeter}},
+				{Min: vg.Point{X: 3 * vg.Centimeter, Y: 2 * vg.Centimeter}, Max: vg.Point{X: 5 * vg.Centimeter, Y: 2.5 * vg.Centimeter}},
+				{Min: vg.Point{X: 4 * vg.Centimeter, Y: 1.5 * vg.Centimeter}, Max: vg.Point{X: 8 * vg.Centimeter, Y: 2 * vg.Centimeter}},
+			},
+		},
+	} {
+		g, err := plotter.NewGantt(test.tasks, vg.Centimeter)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", test.name, err)
+		}
+		g.LineStyle.Width = 0
+		if !reflect.DeepEqual(g.Rows, test.wantRows) {
+			t.Errorf("unexpected rows for %s: got:%q want:%q", test.name, g.Rows, test.wantRows)
+		}
+
+		xmin, xmax, ymin, ymax := g.DataRange()
+		if xmin != 0 || xmax != test.wantXMax || ymin != 0 || ymax != float64(len(test.wantRows)-1) {
+			t.Errorf("unexpected data range for %s: got:[%g, %g]×[%g, %g]", test.name, xmin, xmax, ymin, ymax)
+		}
+
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		p.X.Min, p.X.Max, p.Y.Min, p.Y.Max = 0, 10, -1, 2
+
+		var rec recorder.Canvas
+		g.Plot(draw.NewCanvas(&rec, 10*vg.Centimeter, 6*vg.Centimeter), p)
+
+		var got []vg.Rectangle
+		for _, a := range rec.Actions {
+			if f, ok := a.(*recorder.Fill); ok {
+				got = append(got, pathBounds(f.Path))
+			}
+		}
+		if len(got) != len(test.want) {
+			t.Fatalf("unexpected number of shapes for %s: got:%d want:%d", test.name, len(got), len(test.want))
+		}
+		for i := range got {
+			if !closeRect(got[i], test.want[i]) {
+				t.Errorf("unexpected shape %d for %s: got:%v want:%v", i, test.name, got[i], test.want[i])
+			}
+		}
+	}
+}
+
+func TestGanttErrors(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		tasks []plotter.GanttTask
+		width vg.Length
+	}{
+		{name: "no tasks", width: 1},
+		{name: "zero width", tasks: []plotter.GanttTask{{Start: 0, End: 1}}},
+		{name: "reversed", tasks: []plotter.GanttTask{{Start: 1, End: 0}}, width: 1},
+		{name: "NaN", tasks: []plotter.GanttTask{{Start: math.NaN(), End: 0}}, width: 1},
+	} {
+		if _, err := plotter.NewGantt(test.tasks, test.width); err == nil {
+			t.Errorf("expected error for %s", test.name)
+		}
+	}
+}
+
+// pathBounds returns the bounding box of the points of p.
+func pathBounds(p vg.Path) vg.Rectangle {
+	r := vg.Rectangle{Min: p[0].Pos, Max: p[0].Pos}
+	for _, c := range p {
+		if c.Type == vg.CloseComp {
+			continue
+		}
+		r.Min.X = vg.Length(math.Min(float64(r.Min.X), float64(c.Pos.X)))
+		r.Min.Y = vg.Length(math.Min(float64(r.Min.Y), float64(c.Pos.Y)))
+		r.Max.X = vg.Length(math.Max(float64(r.Max.X), float64(c.Pos.X)))
+		r.Max.Y = vg.Length(math.Max(float64(r.Max.Y), float64(c.Pos.Y)))
+	}
+	return r
+}
+
+func closeRect(a, b vg.Rectangle) bool {
+	const tol = 1e-9
+	return math.Abs(float64(a.Min.X-b.Min.X)) < tol && math.Abs(float64(a.Min.Y-b.Min.Y)) < tol &&
+		math.Abs(float64(a.Max.X-b.Max.X)) < tol && math.Abs(float64(a.Max.Y-b.Max.Y)) < tol
+}
@@ -6,6 +6,8 @@ package plotter
 
 import (
 	"image/color"
+	"math"
+	"sort"
 
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/vg"
@@ -18,20 +20,44 @@ var (
 		Color: color.Gray{128},
 		Width: vg.Points(0.25),
 	}
+
+	// DefaultMinorGridLineStyle is the default style for
+	// minor grid lines.
+	DefaultMinorGridLineStyle = draw.LineStyle{
+		Color:  color.Gray{192},
+		Width:  vg.Points(0.25),
+		Dashes: []vg.Length{vg.Points(1), vg.Points(1)},
+	}
 )
 
 // Grid implements the plot.Plotter interface, drawing
-// a set of grid lines at the major tick marks.
+// a set of grid lines at the major tick marks, and
+// optionally at the minor tick marks.
 type Grid struct {
 	// Vertical is the style of the vertical lines.
 	Vertical draw.LineStyle
 
 	// Horizontal is the style of the horizontal lines.
 	Horizontal draw.LineStyle
+
+	// MinorVertical and MinorHorizontal are the styles
+	// of the vertical and horizontal lines at the minor
+	// tick marks. Minor lines are not drawn if the color
+	// of their style is nil.
+	MinorVertical, MinorHorizontal draw.LineStyle
+
+	// VerticalBands and HorizontalBands are the colors
+	// used in turn to fill the vertical and horizontal
+	// bands between consecutive major tick marks of the X
+	// and Y axes, such as a nil color and a light gray
+	// for zebra shading. Bands with a nil color are not
+	// filled. The bands are drawn behind the grid lines.
+	VerticalBands, HorizontalBands []color.Color
 }
 
 // NewGrid returns a new grid with both vertical and
 // horizontal lines using the default grid line style.
+// Minor grid lines are not drawn.
 func NewGrid() *Grid {
 	return &Grid{
 		Vertical:   DefaultGridLineStyle,
@@ -50,32 +76,94 @@ func (g *Grid) Plot(c draw.Canvas, plt *plot.Plot) {
 		xmax = c.Max.X
 	)
 
-	if g.Vertical.Color == nil {
-		goto horiz
-	}
-	for _, tk := range plt.X.Tick.Marker.Ticks(plt.X.Min, plt.X.Max) {
-		if tk.IsMinor() {
-			continue
+	xticks := plt.X.Ticks()
+	yticks := plt.Y.Ticks()
+
+	// vertical draws vertical grid lines at the minor
+	// or major ticks.
+	vertical := func(sty draw.LineStyle, minor bool) {
+		if sty.Color == nil {
+			return
 		}
-		x := trX(tk.Value)
-		if x > xmax || x < xmin {
-			continue
+		for _, tk := range xticks {
+			if tk.IsMinor() != minor || !inAxisRange(&plt.X, tk.Value) {
+				continue
+			}
+			x := trX(tk.Value)
+			if x > xmax || x < xmin {
+				continue
+			}
+			c.StrokeLine2(sty, x, ymin, x, ymax)
 		}
-		c.StrokeLine2(g.Vertical, x, ymin, x, ymax)
 	}
 
-horiz:
-	if g.Horizontal.Color == nil {
-		return
+	// horizontal draws horizontal grid lines at the minor
+	// or major ticks.
+	horizontal := func(sty draw.LineStyle, minor bool) {
+		if sty.Color == nil {
+			return
+		}
+		for _, tk := range yticks {
+			if tk.IsMinor() != minor || !inAxisRange(&plt.Y, tk.Value) {
+				continue
+			}
+			y := trY(tk.Value)
+			if y > ymax || y < ymin {
+				continue
+			}
+			c.StrokeLine2(sty, xmin, y, xmax, y)
+		}
 	}
-	for _, tk := range plt.Y.Tick.Marker.Ticks(plt.Y.Min, plt.Y.Max) {
-		if tk.IsMinor() {
-			continue
+
+	// bands fills the regions between consecutive major
+	// ticks, within min and max, with the colors in turn.
+	// The values of the ticks are clamped to the range of
+	// the axis before they are transformed.
+	bands := func(clrs []color.Color, ticks []plot.Tick, axis *plot.Axis, tr func(float64) vg.Length, min, max vg.Length, fill func(clr color.Color, lo, hi vg.Length)) {
+		if len(clrs) == 0 {
+			return
+		}
+		var pos []vg.Length
+		for _, tk := range ticks {
+			if !tk.IsMinor() {
+				pos = append(pos, tr(math.Max(axis.Min, math.Min(axis.Max, tk.Value))))
+			}
 		}
-		y := trY(tk.Value)
-		if y > ymax || y < ymin {
-			continue
+		sort.Slice(pos, func(i, j int) bool { return pos[i] < pos[j] })
+		for i := 1; i < len(pos); i++ {
+			clr := clrs[(i-1)%len(clrs)]
+			lo, hi := pos[i-1], pos[i]
+			if lo < min {
+				lo = min
+			}
+			if hi > max {
+				hi = max
+			}
+			if clr == nil || lo >= hi {
+				continue
+			}
+			fill(clr, lo, hi)
 		}
-		c.StrokeLine2(g.Horizontal, xmin, y, xmax, y)
 	}
+	bands(g.VerticalBands, xticks, &plt.X, trX, xmin, xmax, func(clr color.Color, lo, hi vg.Length) {
+		c.FillPolygon(clr, []vg.Point{{X: lo, Y: ymin}, {X: lo, Y: ymax}, {X: hi, Y: ymax}, {X: hi, Y: ymin}})
+	})
+	bands(g.HorizontalBands, yticks, &plt.Y, trY, ymin, ymax, func(clr color.Color, lo, hi vg.Length) {
+		c.FillPolygon(clr, []vg.Point{{X: xmin, Y: lo}, {X: xmin, Y: hi}, {X: xmax, Y: hi}, {X: xmax, Y: lo}})
+	})
+
+	// Minor lines are drawn first so that
+	// they lie under the major lines.
+	vertical(g.MinorVertical, true)
+	horizontal(g.MinorHorizontal, true)
+	vertical(g.Vertical, false)
+	horizontal(g.Horizontal, false)
+}
+
+// inAxisRange returns whether v is within the range of the
+// axis. Tick marks outside the range are not transformed, so
+// that the ticks of a linear Ticker are not transformed by
+// an axis with a log scale.
+func inAxisRange(a *plot.Axis, v float64) bool {
+	return a.Min <= v && v <= a.Max
 }
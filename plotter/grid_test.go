@@ -0,0 +1,249 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"math"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+// gridLines returns the positions of the vertical and horizontal
+// grid lines drawn by g with a line width of w.
+func gridLines(t *testing.T, plt *plot.Plot, g *plotter.Grid, w vg.Length) (xs, ys []vg.Length) {
+	c := new(recorder.Canvas)
+	dc := draw.NewCanvas(c, 10*vg.Centimeter, 10*vg.Centimeter)
+	g.Plot(dc, plt)
+
+	var width vg.Length
+	for _, a := range c.Actions {
+		switch a := a.(type) {
+		case *recorder.SetLineWidth:
+			width = a.Width
+		case *recorder.Stroke:
+			if width != w {
+				continue
+			}
+			p0, p1 := a.Path[0].Pos, a.Path[1].Pos
+			switch {
+			case p0.X == p1.X:
+				xs = append(xs, p0.X)
+			case p0.Y == p1.Y:
+				ys = append(ys, p0.Y)
+			default:
+				t.Errorf("unexpected oblique grid line: %+v", a.Path)
+			}
+		}
+	}
+	sort.Slice(xs, func(i, j int) bool { return xs[i] < xs[j] })
+	sort.Slice(ys, func(i, j int) bool { return ys[i] < ys[j] })
+	return xs, ys
+}
+
+func TestGridMinorLines(t *testing.T) {
+	plt, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	plt.X.Min, plt.X.Max = 0, 10
+	plt.Y.Min, plt.Y.Max = 1, 1000
+	plt.Y.Scale = plot.LogScale{}
+	plt.Y.Tick.Marker = plot.LogTicks{}
+
+	const (
+		majorWidth = 1
+		minorWidth = 0.5
+	)
+	g := plotter.NewGrid()
+	g.Vertical.Width = majorWidth
+	g.Horizontal.Width = majorWidth
+	g.MinorVertical = plotter.DefaultMinorGridLineStyle
+	g.MinorVertical.Width = minorWidth
+	g.MinorHorizontal = plotter.DefaultMinorGridLineStyle
+	g.MinorHorizontal.Width = minorWidth
+
+	majorX, majorY := gridLines(t, plt, g, majorWidth)
+	minorX, minorY := gridLines(t, plt, g, minorWidth)
+
+	// The linear axis has four minor lines between major lines.
+	if len(majorX) < 2 {
+		t.Fatalf("too few major vertical grid lines: %v", majorX)
+	}
+	for i := 1; i < len(majorX); i++ {
+		var n int
+		for _, x := range minorX {
+			if majorX[i-1] < x && x < majorX[i] {
+				n++
+			}
+		}
+		if n != 4 {
+			t.Errorf("unexpected number of minor lines between %v and %v: got:%d want:4",
+				majorX[i-1], majorX[i], n)
+		}
+	}
+
+	// The log axis has major lines at the decades and
+	// minor lines at 2, 3, ..., 9 within each decade.
+	c := draw.NewCanvas(new(recorder.Canvas), 10*vg.Centimeter, 10*vg.Centimeter)
+	_, trY := plt.Transforms(&c)
+	var wantMajor, wantMinor []vg.Length
+	for dec := 1.0; dec <= 1000; dec *= 10 {
+		wantMajor = append(wantMajor, trY(dec))
+		if dec == 1000 {
+			break
+		}
+		for i := 2; i < 10; i++ {
+			wantMinor = append(wantMinor, trY(float64(i)*dec))
+		}
+	}
+	checkLines(t, "major horizontal", majorY, wantMajor)
+	checkLines(t, "minor horizontal", minorY, wantMinor)
+
+	// Minor lines are not drawn by default.
+	_, ys := gridLines(t, plt, plotter.NewGrid(), plotter.DefaultGridLineStyle.Width)
+	checkLines(t, "default horizontal", ys, wantMajor)
+}
+
+func TestGridBreaks(t *testing.T) {
+	plt, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	plt.X.Min, plt.X.Max = 0, 100
+	plt.Y.Min, plt.Y.Max = 0, 1
+	plt.X.Breaks = []plot.Break{{Min: 10, Max: 90}}
+
+	// The vertical lines are drawn at the ticks of the
+	// intervals on both sides of the break.
+	c := draw.NewCanvas(new(recorder.Canvas), 10*vg.Centimeter, 10*vg.Centimeter)
+	trX, _ := plt.Transforms(&c)
+	var want []vg.Length
+	var values []float64
+	for _, tk := range plt.X.Ticks() {
+		if !tk.IsMinor() {
+			want = append(want, trX(tk.Value))
+			values = append(values, tk.Value)
+		}
+	}
+	for _, v := range []float64{5, 95} {
+		if i := sort.SearchFloat64s(values, v); i == len(values) || values[i] != v {
+			t.Errorf("no major tick at %v: %v", v, values)
+		}
+	}
+	xs, _ := gridLines(t, plt, plotter.NewGrid(), plotter.DefaultGridLineStyle.Width)
+	checkLines(t, "broken vertical", xs, want)
+}
+
+func checkLines(t *testing.T, name string, got, want []vg.Length) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("unexpected number of %s grid lines: got:%d want:%d", name, len(got), len(want))
+		return
+	}
+	for i := range got {
+		if math.Abs(float64(got[i]-want[i])) > 1e-9 {
+			t.Errorf("unexpected position of %s grid line %d: got:%v want:%v", name, i, got[i], want[i])
+		}
+	}
+}
+
+func TestGridBands(t *testing.T) {
+	plt, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	plt.X.Min, plt.X.Max = 0, 10
+	plt.Y.Min, plt.Y.Max = -0.5, 4.5
+	var ticks plot.ConstantTicks
+	for i := 0; i < 5; i++ {
+		ticks = append(ticks, plot.Tick{Value: float64(i), Label: "tick"})
+	}
+	plt.Y.Tick.Marker = ticks
+
+	shade := color.Gray{Y: 230}
+	g := plotter.NewGrid()
+	g.HorizontalBands = []color.Color{shade, nil}
+
+	c := new(recorder.Canvas)
+	dc := draw.NewCanvas(c, 10*vg.Centimeter, 10*vg.Centimeter)
+	g.Plot(dc, plt)
+	_, trY := plt.Transforms(&dc)
+
+	var (
+		clr    color.Color
+		bands  [][2]vg.Length
+		stroke bool
+	)
+	for _, a := range c.Actions {
+		switch a := a.(type) {
+		case *recorder.SetColor:
+			clr = a.Color
+		case *recorder.Stroke:
+			stroke = true
+		case *recorder.Fill:
+			if stroke {
+				t.Error("band filled over the grid lines")
+			}
+			if clr != shade {
+				t.Errorf("unexpected band color: got:%v want:%v", clr, shade)
+			}
+			lo, hi := vg.Length(math.Inf(1)), vg.Length(math.Inf(-1))
+			for _, p := range a.Path {
+				if p.Type == vg.CloseComp {
+					continue
+				}
+				if p.Pos.X != dc.Min.X && p.Pos.X != dc.Max.X {
+					t.Errorf("band does not span the data area: x=%v", p.Pos.X)
+				}
+				lo = vg.Length(math.Min(float64(lo), float64(p.Pos.Y)))
+				hi = vg.Length(math.Max(float64(hi), float64(p.Pos.Y)))
+			}
+			bands = append(bands, [2]vg.Length{lo, hi})
+		}
+	}
+
+	// Five ticks bound four bands, every other one shaded.
+	want := [][2]vg.Length{{trY(0), trY(1)}, {trY(2), trY(3)}}
+	if len(bands) != len(want) {
+		t.Fatalf("unexpected number of bands: got:%d want:%d", len(bands), len(want))
+	}
+	for i, b := range bands {
+		for j := range b {
+			if math.Abs(float64(b[j]-want[i][j])) > 1e-9 {
+				t.Errorf("unexpected position of band %d: got:%v want:%v", i, b, want[i])
+				break
+			}
+		}
+	}
+
+	// Bands are cut at the border of the data area.
+	plt.Y.Min, plt.Y.Max = 0.5, 4.5
+	c = new(recorder.Canvas)
+	dc = draw.NewCanvas(c, 10*vg.Centimeter, 10*vg.Centimeter)
+	g.Plot(dc, plt)
+	var n int
+	for _, a := range c.Actions {
+		f, ok := a.(*recorder.Fill)
+		if !ok {
+			continue
+		}
+		n++
+		for _, p := range f.Path {
+			if p.Type != vg.CloseComp && !dc.ContainsY(p.Pos.Y) {
+				t.Errorf("band extends outside the data area: y=%v", p.Pos.Y)
+			}
+		}
+	}
+	if n != 2 {
+		t.Errorf("unexpected number of clipped bands: got:%d want:2", n)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Group implements the Plotter interface, drawing a Plotter,
+// such as a series of a plot, in a group of shapes identified
+// by an ID and class names on canvases supporting groups, see
+// vg.Grouper, so that exported SVG documents can be restyled
+// by external CSS rules selecting the series.
+//
+// A Group is a DataRanger, a GlyphBoxer and a Thumbnailer,
+// forwarding to the Plotter if it implements the interfaces.
+type Group struct {
+	plot.Plotter
+
+	// ID is the identifier of the group drawn by Plot.
+	// The ID is not given to the group of the Thumbnail,
+	// so that an ID is not used twice in a document.
+	ID string
+
+	// Class is the space-separated class names of the
+	// groups drawn by Plot and Thumbnail.
+	Class string
+}
+
+// Plot draws the Plotter in a group, implementing the
+// plot.Plotter interface.
+func (g *Group) Plot(c draw.Canvas, plt *plot.Plot) {
+	c.Push()
+	c.Group(g.ID, g.Class)
+	g.Plotter.Plot(c, plt)
+	c.Pop()
+}
+
+// DataRange returns the data range of the Plotter, or an
+// empty range if the Plotter is not a plot.DataRanger,
+// implementing the plot.DataRanger interface.
+func (g *Group) DataRange() (xmin, xmax, ymin, ymax float64) {
+	if d, ok := g.Plotter.(plot.DataRanger); ok {
+		return d.DataRange()
+	}
+	return math.Inf(1), math.Inf(-1), math.Inf(1), math.Inf(-1)
+}
+
+// GlyphBoxes returns the glyph boxes of the Plotter, if it
+// is a plot.GlyphBoxer, implementing the plot.GlyphBoxer
+// interface.
+func (g *Group) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	if b, ok := g.Plotter.(plot.GlyphBoxer); ok {
+		return b.GlyphBoxes(plt)
+	}
+	return nil
+}
+
+// Thumbnail draws the thumbnail of the Plotter in a group
+// with the Class, if the Plotter is a plot.Thumbnailer,
+// implementing the plot.Thumbnailer interface.
+func (g *Group) Thumbnail(c *draw.Canvas) {
+	th, ok := g.Plotter.(plot.Thumbnailer)
+	if !ok {
+		return
+	}
+	c.Push()
+	c.Group("", g.Class)
+	th.Thumbnail(c)
+	c.Pop()
+}
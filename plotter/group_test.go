@@ -0,0 +1,56 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestGroup(t *testing.T) {
+	s, err := plotter.NewScatter(plotter.XYs{{X: 1, Y: 2}, {X: 3, Y: 5}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	g := &plotter.Group{Plotter: s, ID: "points", Class: "series"}
+
+	xmin, xmax, ymin, ymax := g.DataRange()
+	if xmin != 1 || xmax != 3 || ymin != 2 || ymax != 5 {
+		t.Errorf("unexpected data range: got:[%v,%v]x[%v,%v] want:[1,3]x[2,5]", xmin, xmax, ymin, ymax)
+	}
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Add(g)
+	if got, want := len(g.GlyphBoxes(p)), len(s.GlyphBoxes(p)); got != want {
+		t.Errorf("unexpected number of glyph boxes: got:%d want:%d", got, want)
+	}
+
+	// The group is drawn as the plotter on canvases
+	// that do not support groups.
+	var got, want recorder.Canvas
+	g.Plot(draw.NewCanvas(&got, 100, 100), p)
+	s.Plot(draw.NewCanvas(&want, 100, 100), p)
+	if len(got.Actions) != len(want.Actions)+2 {
+		t.Errorf("unexpected number of actions: got:%d want:%d", len(got.Actions), len(want.Actions)+2)
+	}
+
+	// A plotter that is not a DataRanger does
+	// not change the range of the axes.
+	g = &plotter.Group{Plotter: plotter.NewGrid()}
+	xmin, xmax, ymin, ymax = g.DataRange()
+	if !math.IsInf(xmin, 1) || !math.IsInf(xmax, -1) || !math.IsInf(ymin, 1) || !math.IsInf(ymax, -1) {
+		t.Errorf("unexpected data range: got:[%v,%v]x[%v,%v] want empty", xmin, xmax, ymin, ymax)
+	}
+	if boxes := g.GlyphBoxes(p); boxes != nil {
+		t.Errorf("unexpected glyph boxes: %v", boxes)
+	}
+}
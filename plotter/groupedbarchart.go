@@ -0,0 +1,138 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// GroupedBarChart implements the plot.Plotter interface,
+// drawing several series of values as groups of side by
+// side bars, one group for each category. The ith category
+// is at the X location i, so that the categories can be
+// named with plot.NominalX.
+type GroupedBarChart struct {
+	// Names are the names of the series.
+	Names []string
+
+	// Categories are the names of the categories.
+	Categories []string
+
+	// Bars are the bar charts of the series. Their Width,
+	// Offset and orientation are set by the GroupedBarChart
+	// when it is drawn, so that each group fills the space
+	// of its category, apart from the gaps.
+	Bars []*BarChart
+
+	// GroupGap is the space between two groups of bars,
+	// as a fraction of the distance between categories.
+	GroupGap float64
+
+	// BarGap is the space between two bars of a group,
+	// as a fraction of the width of the bars.
+	BarGap float64
+
+	// Horizontal dictates whether the bars should be in
+	// the vertical (default) or horizontal direction.
+	Horizontal bool
+}
+
+// NewGroupedBarChart returns a grouped bar chart of the given
+// series of values, with a value for each category in each
+// series. The series are filled with distinct colors.
+//
+// An error is returned if there are no series, if the number
+// of names does not match the number of series, or if a
+// series does not have a value for each category.
+func NewGroupedBarChart(names []string, series []Valuer, categories []string) (*GroupedBarChart, error) {
+	if len(series) == 0 || len(categories) == 0 {
+		return nil, ErrNoData
+	}
+	if len(names) != len(series) {
+		return nil, errors.New("plotter: number of names does not match the number of series")
+	}
+
+	n := len(series)
+	if n < 2 {
+		// Rainbow needs two colors to span its hues.
+		n = 2
+	}
+	colors := palette.Rainbow(n, palette.Blue, palette.Red, 0.6, 0.9, 1).Colors()
+
+	bars := make([]*BarChart, len(series))
+	for i, vs := range series {
+		if vs.Len() != len(categories) {
+			return nil, errors.New("plotter: number of values does not match the number of categories")
+		}
+		// The width is set when the chart is drawn.
+		b, err := NewBarChart(vs, 1)
+		if err != nil {
+			return nil, err
+		}
+		b.Color = colors[i]
+		bars[i] = b
+	}
+
+	return &GroupedBarChart{
+		Names:      append([]string(nil), names...),
+		Categories: append([]string(nil), categories...),
+		Bars:       bars,
+		GroupGap:   0.2,
+	}, nil
+}
+
+// Plot implements the plot.Plotter interface.
+func (g *GroupedBarChart) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	trCat := trX
+	if g.Horizontal {
+		trCat = trY
+	}
+	slot := vg.Length(math.Abs(float64(trCat(1) - trCat(0))))
+
+	group := slot * vg.Length(1-g.GroupGap)
+	n := vg.Length(len(g.Bars))
+	width := group / (n + (n-1)*vg.Length(g.BarGap))
+	for i, b := range g.Bars {
+		b.Width = width
+		b.Offset = -group/2 + width/2 + vg.Length(i)*width*vg.Length(1+g.BarGap)
+		b.Horizontal = g.Horizontal
+		b.Plot(c, plt)
+	}
+}
+
+// DataRange implements the plot.DataRanger interface. The
+// range of the categories extends half the distance between
+// categories on both sides, so that the groups of bars fit.
+func (g *GroupedBarChart) DataRange() (xmin, xmax, ymin, ymax float64) {
+	catMin, catMax := -0.5, float64(len(g.Categories))-0.5
+	valMin, valMax := math.Inf(1), math.Inf(-1)
+	for _, b := range g.Bars {
+		for _, v := range b.Values {
+			valMin = math.Min(valMin, v)
+			valMax = math.Max(valMax, v)
+		}
+	}
+	valMin = math.Min(valMin, 0)
+	valMax = math.Max(valMax, 0)
+	if g.Horizontal {
+		return valMin, valMax, catMin, catMax
+	}
+	return catMin, catMax, valMin, valMax
+}
+
+// AddToLegend adds an entry to the legend for each series,
+// with the bar chart of the series as its thumbnail.
+func (g *GroupedBarChart) AddToLegend(l *plot.Legend) {
+	for i, b := range g.Bars {
+		l.Add(g.Names[i], b)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestGroupedBarChart(t *testing.T) {
+	cmpimg.CheckPlot(ExampleGroupedBarChart, t, "groupedBarChart.png")
+}
+
+func TestGroupedBarChartPositions(t *testing.T) {
+	g, err := plotter.NewGroupedBarChart(
+		[]string{"a", "b", "c"},
+		[]plotter.Valuer{
+			plotter.Values{1, 2, 3, 4},
+			plotter.Values{2, 3, 4, 1},
+			plotter.Values{3, 4, 1, 2},
+		},
+		[]string{"w", "x", "y", "z"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	g.GroupGap = 0.25
+	g.BarGap = 0.5
+
+	xmin, xmax, ymin, ymax := g.DataRange()
+	if xmin != -0.5 || xmax != 3.5 || ymin != 0 || ymax != 4 {
+		t.Errorf("unexpected data range: got:[%g, %g]×[%g, %g] want:[-0.5, 3.5]×[0, 4]", xmin, xmax, ymin, ymax)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.X.Min, p.X.Max, p.Y.Min, p.Y.Max = xmin, xmax, ymin, ymax
+
+	// Each category is 100 wide on the canvas, so groups are 75
+	// wide, holding three bars and two half bar gaps.
+	var rec recorder.Canvas
+	g.Plot(draw.NewCanvas(&rec, 400, 100), p)
+
+	const width = 75.0 / 4
+	for i, b := range g.Bars {
+		if b.Width != width {
+			t.Errorf("unexpected width of series %d: got:%v want:%v", i, b.Width, width)
+		}
+	}
+
+	var fills []*recorder.Fill
+	for _, a := range rec.Actions {
+		if a, ok := a.(*recorder.Fill); ok {
+			fills = append(fills, a)
+		}
+	}
+	if len(fills) != 12 {
+		t.Fatalf("unexpected number of bars: got:%d want:12", len(fills))
+	}
+	for i, f := range fills {
+		series, cat := i/4, i%4
+		want := vg.Length(100*cat) + 12.5 + vg.Length(series)*1.5*width
+		if got := f.Path[0].Pos.X; math.Abs(float64(got-want)) > 1e-9 {
+			t.Errorf("unexpected left side of bar %d of series %d: got:%v want:%v", cat, series, got, want)
+		}
+	}
+}
+
+func TestGroupedBarChartErrors(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		names      []string
+		series     []plotter.Valuer
+		categories []string
+	}{
+		{
+			name:       "no series",
+			categories: []string{"x"},
+		},
+		{
+			name:       "names mismatch",
+			names:      []string{"a"},
+			series:     []plotter.Valuer{plotter.Values{1}, plotter.Values{2}},
+			categories: []string{"x"},
+		},
+		{
+			name:       "unequal lengths",
+			names:      []string{"a", "b"},
+			series:     []plotter.Valuer{plotter.Values{1, 2}, plotter.Values{2}},
+			categories: []string{"x", "y"},
+		},
+	} {
+		_, err := plotter.NewGroupedBarChart(test.names, test.series, test.categories)
+		if err == nil {
+			t.Errorf("expected error for %s", test.name)
+		}
+	}
+}
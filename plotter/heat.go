@@ -57,6 +57,16 @@ type HeatMap struct {
 	// Min and Max define the dynamic range of the
 	// heat map.
 	Min, Max float64
+
+	// Scale is the normalizer used to map values in
+	// the dynamic range to colors of the palette.
+	// If Scale is nil, values are mapped linearly.
+	//
+	// When Scale is a plot.LogScale, possibly inverted,
+	// non-positive values are drawn with the Underflow
+	// color, and a non-positive Min is replaced by the
+	// smallest positive value of the grid.
+	Scale plot.Normalizer
 }
 
 // NewHeatMap creates as new heat map plotter for the given data,
@@ -105,8 +115,15 @@ func (h *HeatMap) Plot(c draw.Canvas, plt *plot.Plot) {
 	if len(pal) == 0 {
 		panic("heatmap: empty palette")
 	}
-	// ps scales the palette uniformly across the data range.
-	ps := float64(len(pal)-1) / (h.Max - h.Min)
+	norm := h.Scale
+	if norm == nil {
+		norm = plot.LinearScale{}
+	}
+	min := h.Min
+	isLog := isLogScale(norm)
+	if isLog && min <= 0 {
+		min = h.minPositive()
+	}
 
 	trX, trY := plt.Transforms(&c)
 
@@ -168,10 +185,19 @@ func (h *HeatMap) Plot(c draw.Canvas, plt *plot.Plot) {
 				col = h.Underflow
 			case v > h.Max:
 				col = h.Overflow
-			case math.IsNaN(v), math.IsInf(ps, 0):
+			case math.IsNaN(v):
 				col = h.NaN
+			case isLog && v <= 0:
+				col = h.Underflow
 			default:
-				col = pal[int((v-h.Min)*ps+0.5)] // Apply palette scaling.
+				// Apply palette scaling.
+				n := norm.Normalize(min, h.Max, v)
+				if math.IsNaN(n) || math.IsInf(n, 0) {
+					col = h.NaN
+					break
+				}
+				n = math.Max(0, math.Min(1, n))
+				col = pal[int(n*float64(len(pal)-1)+0.5)]
 			}
 			if col != nil {
 				c.SetColor(col)
@@ -181,6 +207,35 @@ func (h *HeatMap) Plot(c draw.Canvas, plt *plot.Plot) {
 	}
 }
 
+// minPositive returns the smallest positive value of the grid,
+// or +Inf if there is none.
+func (h *HeatMap) minPositive() float64 {
+	min := math.Inf(1)
+	c, r := h.GridXYZ.Dims()
+	for i := 0; i < c; i++ {
+		for j := 0; j < r; j++ {
+			if v := h.GridXYZ.Z(i, j); v > 0 {
+				min = math.Min(min, v)
+			}
+		}
+	}
+	return min
+}
+
+// isLogScale returns whether n is a plot.LogScale,
+// possibly inverted.
+func isLogScale(n plot.Normalizer) bool {
+	switch n := n.(type) {
+	case plot.LogScale, *plot.LogScale:
+		return true
+	case plot.InvertedScale:
+		return isLogScale(n.Normalizer)
+	case *plot.InvertedScale:
+		return isLogScale(n.Normalizer)
+	}
+	return false
+}
+
 // DataRange implements the DataRange method
 // of the plot.DataRanger interface.
 func (h *HeatMap) DataRange() (xmin, xmax, ymin, ymax float64) {
@@ -6,6 +6,7 @@ package plotter_test
 
 import (
 	"fmt"
+	"image/color"
 	"math"
 	"testing"
 
@@ -15,6 +16,7 @@ import (
 	"gonum.org/v1/plot/palette"
 	"gonum.org/v1/plot/plotter"
 	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 	"gonum.org/v1/plot/vg/vgimg"
 )
 
@@ -90,3 +92,63 @@ func TestHeatMapDims(t *testing.T) {
 		}()
 	}
 }
+
+func TestHeatMapLogScale(t *testing.T) {
+	pal := palette.Heat(3, 1)
+	colors := pal.Colors()
+	m := offsetUnitGrid{Data: mat.NewDense(1, 5, []float64{1, 10, 100, 0, -1})}
+
+	for _, test := range []struct {
+		scale plot.Normalizer
+		want  []color.Color
+	}{
+		{
+			scale: nil,
+			want:  []color.Color{colors[0], colors[0], colors[2], colors[0], colors[0]},
+		},
+		{
+			scale: plot.LogScale{},
+			want:  []color.Color{colors[0], colors[1], colors[2], color.Black, color.Black},
+		},
+		{
+			scale: &plot.LogScale{},
+			want:  []color.Color{colors[0], colors[1], colors[2], color.Black, color.Black},
+		},
+		{
+			scale: plot.InvertedScale{Normalizer: plot.LogScale{}},
+			want:  []color.Color{colors[2], colors[1], colors[0], color.Black, color.Black},
+		},
+		{
+			scale: &plot.InvertedScale{Normalizer: &plot.LogScale{}},
+			want:  []color.Color{colors[2], colors[1], colors[0], color.Black, color.Black},
+		},
+	} {
+		h := plotter.NewHeatMap(m, pal)
+		h.Scale = test.scale
+		h.Underflow = color.Black
+
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		p.Add(h)
+
+		c := new(recorder.Canvas)
+		h.Plot(draw.NewCanvas(c, 100, 100), p)
+
+		var got []color.Color
+		for _, a := range c.Actions {
+			if a, ok := a.(*recorder.SetColor); ok {
+				got = append(got, a.Color)
+			}
+		}
+		if len(got) != len(test.want) {
+			t.Fatalf("scale=%T: got %d cells, want %d", test.scale, len(got), len(test.want))
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("scale=%T: unexpected color for cell %d: got %v, want %v", test.scale, i, got[i], test.want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// HexCell is a cell of a hexagonal binning.
+type HexCell struct {
+	// X and Y are the coordinates of the
+	// center of the cell.
+	X, Y float64
+
+	// Count is the number of points in the cell.
+	Count int
+
+	// Weight is the sum of the weights of the
+	// points in the cell. The weight of each
+	// point is one for a HexBin created by
+	// NewHexBin.
+	Weight float64
+}
+
+// HexBin implements the Plotter interface, drawing a
+// two-dimensional histogram of points binned into a grid
+// of hexagons. Each hexagon is colored according to the
+// weight of its cell.
+type HexBin struct {
+	// Cells are the non-empty cells of the grid.
+	Cells []HexCell
+
+	// Radius is the distance from the center of the
+	// hexagons to their vertices, in data coordinates.
+	// The hexagons have a vertex at their top and at
+	// their bottom. Since the X and Y axes are usually
+	// not drawn at the same scale, the hexagons are
+	// only regular if the data are scaled accordingly.
+	Radius float64
+
+	// ColorMap is used to map the weights of the
+	// cells to colors. Its range is set to the range
+	// of the weights by the HexBin constructors, so
+	// that it can also be used to draw a ColorBar.
+	ColorMap palette.ColorMap
+
+	// LineStyle is the style of the outline of the
+	// hexagons. Use zero width to disable outlines.
+	draw.LineStyle
+}
+
+// NewHexBin returns a HexBin binning the given points into
+// hexagons of the given radius. The weight of each cell is
+// the number of points it holds.
+func NewHexBin(xys XYer, radius float64) (*HexBin, error) {
+	data, err := CopyXYs(xys)
+	if err != nil {
+		return nil, err
+	}
+	xyzs := make(XYZs, len(data))
+	for i, p := range data {
+		xyzs[i] = XYZ{X: p.X, Y: p.Y, Z: 1}
+	}
+	return newHexBin(xyzs, radius)
+}
+
+// NewWeightedHexBin returns a HexBin binning the X and Y
+// values of the given points into hexagons of the given
+// radius. The weight of each cell is the sum of the Z values
+// of the points it holds.
+func NewWeightedHexBin(xyzs XYZer, radius float64) (*HexBin, error) {
+	data, err := CopyXYZs(xyzs)
+	if err != nil {
+		return nil, err
+	}
+	return newHexBin(data, radius)
+}
+
+func newHexBin(data XYZs, radius float64) (*HexBin, error) {
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	if !(radius > 0) || math.IsInf(radius, 1) {
+		return nil, errors.New("plotter: invalid hexagon radius")
+	}
+
+	idx := make(map[hexIndex]int)
+	var cells []HexCell
+	for _, p := range data {
+		k := hexIndexOf(p.X, p.Y, radius)
+		i, ok := idx[k]
+		if !ok {
+			x, y := k.center(radius)
+			i = len(cells)
+			idx[k] = i
+			cells = append(cells, HexCell{X: x, Y: y})
+		}
+		cells[i].Count++
+		cells[i].Weight += p.Z
+	}
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].Y != cells[j].Y {
+			return cells[i].Y < cells[j].Y
+		}
+		return cells[i].X < cells[j].X
+	})
+
+	cm := moreland.Kindlmann()
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, c := range cells {
+		min = math.Min(min, c.Weight)
+		max = math.Max(max, c.Weight)
+	}
+	if min == max {
+		// Make a unit range when all the weights are equal.
+		min -= 0.5
+		max += 0.5
+	}
+	cm.SetMax(max)
+	cm.SetMin(min)
+
+	sty := DefaultLineStyle
+	sty.Width = 0
+
+	return &HexBin{
+		Cells:     cells,
+		Radius:    radius,
+		ColorMap:  cm,
+		LineStyle: sty,
+	}, nil
+}
+
+// hexIndex is the axial coordinates of a hexagon in a grid of
+// hexagons with a vertex at their top. The center of the hexagon
+// at the origin of the grid is at the origin of the data.
+type hexIndex struct {
+	q, r int
+}
+
+// hexIndexOf returns the index of the hexagon of the given
+// radius containing the point (x, y).
+func hexIndexOf(x, y, radius float64) hexIndex {
+	q := (math.Sqrt(3)/3*x - y/3) / radius
+	r := 2 * y / 3 / radius
+
+	// Round the cube coordinates (q, r, -q-r) to the
+	// nearest hexagon, fixing the coordinate with the
+	// largest rounding error so that they sum to zero.
+	s := -q - r
+	rq, rr, rs := math.Round(q), math.Round(r), math.Round(s)
+	dq, dr, ds := math.Abs(rq-q), math.Abs(rr-r), math.Abs(rs-s)
+	switch {
+	case dq > dr && dq > ds:
+		rq = -rr - rs
+	case dr > ds:
+		rr = -rq - rs
+	}
+	return hexIndex{q: int(rq), r: int(rr)}
+}
+
+// center returns the center of the hexagon of the given radius.
+func (h hexIndex) center(radius float64) (x, y float64) {
+	x = radius * math.Sqrt(3) * (float64(h.q) + float64(h.r)/2)
+	y = radius * 1.5 * float64(h.r)
+	return x, y
+}
+
+// hexagon returns the vertices of the hexagon of the given
+// radius centered at (x, y), counter-clockwise from the top.
+func hexagon(x, y, radius float64) [6][2]float64 {
+	var v [6][2]float64
+	for i := range v {
+		a := math.Pi/2 + float64(i)*math.Pi/3
+		v[i] = [2]float64{x + radius*math.Cos(a), y + radius*math.Sin(a)}
+	}
+	return v
+}
+
+// MaxCell returns the cell with the largest weight.
+func (h *HexBin) MaxCell() HexCell {
+	max := h.Cells[0]
+	for _, c := range h.Cells[1:] {
+		if c.Weight > max.Weight {
+			max = c
+		}
+	}
+	return max
+}
+
+// Plot draws the HexBin, implementing the plot.Plotter interface.
+func (h *HexBin) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+
+	pts := make([]vg.Point, 6, 7)
+	for _, cell := range h.Cells {
+		for i, v := range hexagon(cell.X, cell.Y, h.Radius) {
+			pts[i] = vg.Point{X: trX(v[0]), Y: trY(v[1])}
+		}
+		w := math.Max(h.ColorMap.Min(), math.Min(h.ColorMap.Max(), cell.Weight))
+		col, err := h.ColorMap.At(w)
+		if err != nil {
+			panic(err)
+		}
+		c.FillPolygon(col, c.ClipPolygonXY(pts[:6]))
+		if h.LineStyle.Width != 0 {
+			c.StrokeLines(h.LineStyle, c.ClipLinesXY(append(pts[:6], pts[0]))...)
+		}
+	}
+}
+
+// DataRange returns the minimum and maximum x and y values
+// covered by the hexagons, implementing the plot.DataRanger
+// interface.
+func (h *HexBin) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, xmax = math.Inf(1), math.Inf(-1)
+	ymin, ymax = math.Inf(1), math.Inf(-1)
+	dx := h.Radius * math.Sqrt(3) / 2
+	for _, c := range h.Cells {
+		xmin = math.Min(xmin, c.X-dx)
+		xmax = math.Max(xmax, c.X+dx)
+		ymin = math.Min(ymin, c.Y-h.Radius)
+		ymax = math.Max(ymax, c.Y+h.Radius)
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// GlyphBoxes returns a GlyphBox for the center of each cell,
+// covering the width of the outline of the hexagons,
+// implementing the plot.GlyphBoxer interface.
+func (h *HexBin) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	r := h.LineStyle.Width / 2
+	bs := make([]plot.GlyphBox, len(h.Cells))
+	for i, c := range h.Cells {
+		bs[i].X = plt.X.Norm(c.X)
+		bs[i].Y = plt.Y.Norm(c.Y)
+		bs[i].Rectangle = vg.Rectangle{
+			Min: vg.Point{X: -r, Y: -r},
+			Max: vg.Point{X: +r, Y: +r},
+		}
+	}
+	return bs
+}
@@ -0,0 +1,95 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestHexBin(t *testing.T) {
+	cmpimg.CheckPlot(ExampleHexBin, t, "hexBin.png")
+}
+
+func TestHexBinMaxCell(t *testing.T) {
+	// A cluster of points around (2, 3) and a few isolated points.
+	xys := plotter.XYs{
+		{X: 2, Y: 3}, {X: 2.1, Y: 3}, {X: 1.9, Y: 3.1}, {X: 2, Y: 2.9}, {X: 2.05, Y: 3.05},
+		{X: -4, Y: 0}, {X: 5, Y: -3}, {X: 5.1, Y: -3},
+	}
+	const radius = 0.5
+	h, err := plotter.NewHexBin(xys, radius)
+	if err != nil {
+		t.Fatalf("could not create hexbin: %v", err)
+	}
+
+	if got, want := len(h.Cells), 3; got != want {
+		t.Errorf("unexpected number of cells: got:%d want:%d", got, want)
+	}
+	var total int
+	for _, c := range h.Cells {
+		total += c.Count
+		if c.Weight != float64(c.Count) {
+			t.Errorf("unexpected weight of cell at (%v, %v): got:%v want:%d", c.X, c.Y, c.Weight, c.Count)
+		}
+	}
+	if total != len(xys) {
+		t.Errorf("unexpected total count: got:%d want:%d", total, len(xys))
+	}
+
+	max := h.MaxCell()
+	if max.Count != 5 {
+		t.Errorf("unexpected count of max cell: got:%d want:5", max.Count)
+	}
+	if d := math.Hypot(max.X-2, max.Y-3); d > radius {
+		t.Errorf("max cell center (%v, %v) too far from cluster: distance %v", max.X, max.Y, d)
+	}
+	if h.ColorMap.Min() != 1 || h.ColorMap.Max() != 5 {
+		t.Errorf("unexpected color map range: got:[%v, %v] want:[1, 5]", h.ColorMap.Min(), h.ColorMap.Max())
+	}
+}
+
+func TestWeightedHexBin(t *testing.T) {
+	xyzs := plotter.XYZs{{X: 0, Y: 0, Z: 1}, {X: 0.1, Y: 0, Z: 2}, {X: 3, Y: 3, Z: 10}}
+	h, err := plotter.NewWeightedHexBin(xyzs, 0.5)
+	if err != nil {
+		t.Fatalf("could not create hexbin: %v", err)
+	}
+	max := h.MaxCell()
+	if max.Count != 1 || max.Weight != 10 {
+		t.Errorf("unexpected max cell: got count:%d weight:%v want count:1 weight:10", max.Count, max.Weight)
+	}
+	for _, c := range h.Cells {
+		if c.Count == 2 && c.Weight != 3 {
+			t.Errorf("unexpected weight of cell with two points: got:%v want:3", c.Weight)
+		}
+	}
+}
+
+func TestHexBinCells(t *testing.T) {
+	// Every point must be binned in the hexagon closest to it.
+	const radius = 1.0
+	var xys plotter.XYs
+	for x := -3.0; x <= 3; x += 0.37 {
+		for y := -3.0; y <= 3; y += 0.29 {
+			xys = append(xys, plotter.XY{X: x, Y: y})
+		}
+	}
+	for _, p := range xys {
+		h, err := plotter.NewHexBin(plotter.XYs{p}, radius)
+		if err != nil {
+			t.Fatalf("could not create hexbin: %v", err)
+		}
+		c := h.Cells[0]
+		// The distance from the center of a hexagon to any
+		// of its points is at most the radius.
+		if d := math.Hypot(p.X-c.X, p.Y-c.Y); d > radius+1e-9 {
+			t.Errorf("point (%v, %v) binned in cell (%v, %v) at distance %v", p.X, p.Y, c.X, c.Y, d)
+		}
+	}
+}
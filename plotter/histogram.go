@@ -22,6 +22,8 @@ type Histogram struct {
 	Bins []HistogramBin
 
 	// Width is the width of each bin.
+	// Width is zero if the bins do not all
+	// have the same width.
 	Width float64
 
 	// FillColor is the color used to fill each
@@ -39,7 +41,15 @@ type Histogram struct {
 	// The lowest Y value for the DataRange will be corrected to leave an
 	// arbitrary amount of height for the smallest bin entry so it is visible
 	// on the final plot.
+	// For a horizontal histogram, LogY applies
+	// to the X axis, along which the bars extend.
 	LogY bool
+
+	// Horizontal dictates whether the bars extend
+	// to the right of the Y axis along the X axis,
+	// with the bins along the Y axis, instead of
+	// extending up from the X axis (default).
+	Horizontal bool
 }
 
 // NewHistogram returns a new histogram
@@ -71,6 +81,51 @@ func NewHist(vs Valuer, n int) (*Histogram, error) {
 	return NewHistogram(unitYs{vs}, n)
 }
 
+// NewHistogramEdges returns a new histogram with
+// bins defined by the given edges, holding the
+// given counts. The ith bin spans the range from
+// edges[i] to edges[i+1] and has weight counts[i],
+// so bins may have different widths.
+//
+// An error is returned if the edges are not
+// strictly increasing or if there is not exactly
+// one more edge than counts.
+func NewHistogramEdges(edges []float64, counts Valuer) (*Histogram, error) {
+	cs, err := CopyValues(counts)
+	if err != nil {
+		return nil, err
+	}
+	if len(cs) == 0 {
+		return nil, ErrNoData
+	}
+	if len(edges) != len(cs)+1 {
+		return nil, errors.New("plotter: number of histogram edges does not match number of counts")
+	}
+
+	bins := make([]HistogramBin, len(cs))
+	for i, c := range cs {
+		if !(edges[i] < edges[i+1]) {
+			return nil, errors.New("plotter: histogram edges not strictly increasing")
+		}
+		bins[i] = HistogramBin{Min: edges[i], Max: edges[i+1], Weight: c}
+	}
+
+	width := bins[0].Max - bins[0].Min
+	for _, b := range bins[1:] {
+		if b.Max-b.Min != width {
+			width = 0
+			break
+		}
+	}
+
+	return &Histogram{
+		Bins:      bins,
+		Width:     width,
+		FillColor: color.Gray{128},
+		LineStyle: DefaultLineStyle,
+	}, nil
+}
+
 type unitYs struct {
 	Valuer
 }
@@ -92,6 +147,13 @@ func (h *Histogram) Plot(c draw.Canvas, p *plot.Plot) {
 		}
 		xmin := trX(bin.Min)
 		xmax := trX(bin.Max)
+		if h.Horizontal {
+			xmin, xmax = c.Min.X, c.Min.X
+			if bin.Weight != 0 {
+				xmax = trX(bin.Weight)
+			}
+			ymin, ymax = trY(bin.Min), trY(bin.Max)
+		}
 		pts := []vg.Point{
 			{X: xmin, Y: ymin},
 			{X: xmax, Y: ymin},
@@ -139,18 +201,27 @@ func (h *Histogram) DataRange() (xmin, xmax, ymin, ymax float64) {
 	default:
 		ymin = 0
 	}
+	if h.Horizontal {
+		return ymin, ymax, xmin, xmax
+	}
 	return
 }
 
 // Normalize normalizes the histogram so that the
 // total area beneath it sums to a given value.
+// The weight of each bin is divided by its width,
+// so that bins of different widths hold densities.
 func (h *Histogram) Normalize(sum float64) {
 	mass := 0.0
 	for _, b := range h.Bins {
 		mass += b.Weight
 	}
-	for i := range h.Bins {
-		h.Bins[i].Weight *= sum / (h.Width * mass)
+	for i, b := range h.Bins {
+		w := h.Width
+		if w == 0 {
+			w = b.Max - b.Min
+		}
+		h.Bins[i].Weight *= sum / (w * mass)
 	}
 }
 
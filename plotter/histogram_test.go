@@ -5,6 +5,7 @@
 package plotter_test
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -53,3 +54,86 @@ func TestSingletonHistogram(t *testing.T) {
 func TestHistogramLogScale(t *testing.T) {
 	cmpimg.CheckPlot(ExampleHistogram_logScaleY, t, "histogram_logy.png")
 }
+
+func TestHistogramEdges(t *testing.T) {
+	cmpimg.CheckPlot(ExampleNewHistogramEdges, t, "histogram_edges.png")
+}
+
+func TestHistogramEdgesCounts(t *testing.T) {
+	edges := []float64{-1, 0, 0.5, 2, 5}
+	counts := plotter.Values{2, 4, 6, 3}
+
+	h, err := plotter.NewHistogramEdges(edges, counts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if h.Width != 0 {
+		t.Errorf("unexpected width for unequal bins: got:%v want:0", h.Width)
+	}
+	for i, b := range h.Bins {
+		if b.Min != edges[i] || b.Max != edges[i+1] || b.Weight != counts[i] {
+			t.Errorf("unexpected bin %d: got:%+v want:{Min:%v Max:%v Weight:%v}",
+				i, b, edges[i], edges[i+1], counts[i])
+		}
+	}
+
+	xmin, xmax, ymin, ymax := h.DataRange()
+	if xmin != -1 || xmax != 5 || ymin != 0 || ymax != 6 {
+		t.Errorf("unexpected data range: got:[%v, %v]x[%v, %v] want:[-1, 5]x[0, 6]",
+			xmin, xmax, ymin, ymax)
+	}
+}
+
+func TestHistogramEdgesDensity(t *testing.T) {
+	edges := []float64{-1, 0, 0.5, 2, 5}
+	counts := plotter.Values{2, 4, 6, 3}
+
+	h, err := plotter.NewHistogramEdges(edges, counts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	h.Normalize(1)
+
+	const total = 15
+	var area float64
+	for i, b := range h.Bins {
+		w := edges[i+1] - edges[i]
+		want := counts[i] / (total * w)
+		if math.Abs(b.Weight-want) > 1e-12 {
+			t.Errorf("unexpected density for bin %d: got:%v want:%v", i, b.Weight, want)
+		}
+		area += b.Weight * w
+	}
+	if math.Abs(area-1) > 1e-12 {
+		t.Errorf("unexpected total area: got:%v want:1", area)
+	}
+}
+
+func TestHistogramHorizontal(t *testing.T) {
+	h, err := plotter.NewHistogramEdges([]float64{1, 2, 4}, plotter.Values{3, 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	h.Horizontal = true
+	xmin, xmax, ymin, ymax := h.DataRange()
+	if xmin != 0 || xmax != 5 || ymin != 1 || ymax != 4 {
+		t.Errorf("unexpected data range: got:[%v,%v]x[%v,%v] want:[0,5]x[1,4]", xmin, xmax, ymin, ymax)
+	}
+}
+
+func TestHistogramEdgesErrors(t *testing.T) {
+	for _, test := range []struct {
+		edges  []float64
+		counts plotter.Values
+	}{
+		{edges: []float64{0, 1}, counts: nil},
+		{edges: []float64{0, 1}, counts: plotter.Values{1, 2}},
+		{edges: []float64{0, 2, 1}, counts: plotter.Values{1, 2}},
+		{edges: []float64{0, 1, 1}, counts: plotter.Values{1, 2}},
+	} {
+		_, err := plotter.NewHistogramEdges(test.edges, test.counts)
+		if err == nil {
+			t.Errorf("expected error for edges=%v counts=%v", test.edges, test.counts)
+		}
+	}
+}
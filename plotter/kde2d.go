@@ -0,0 +1,296 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// kde2DGridSize is the number of columns and rows
+// of the grid of the density estimate of a KDE2D.
+const kde2DGridSize = 100
+
+// KDE2D implements the Plotter interface, drawing a
+// two-dimensional Gaussian kernel density estimate of a
+// set of points, either as a heat map of the density or
+// as filled bands between levels of the density.
+type KDE2D struct {
+	// Density is the heat map of the density estimated
+	// on a grid covering the range of the points. Its
+	// Palette, Min and Max, which are the range of the
+	// density, can be used for a matching ColorBar.
+	Density *HeatMap
+
+	// BandwidthX and BandwidthY are the standard
+	// deviations of the Gaussian kernel along the
+	// X and Y axes.
+	BandwidthX, BandwidthY float64
+
+	// Levels, if not empty, are the increasing levels
+	// of the density bounding the filled bands drawn
+	// instead of the heat map. The ith band covers the
+	// densities from Levels[i] to Levels[i+1], and the
+	// last band the densities above the last level.
+	// The bands are colored with colors spread evenly
+	// over the Palette of Density, and the densities
+	// below the first level are not filled.
+	Levels []float64
+
+	// xmin, xmax, ymin and ymax are
+	// the range of the grid.
+	xmin, xmax, ymin, ymax float64
+}
+
+// NewKDE2D returns a KDE2D estimating the density of the points
+// with the given bandwidths, drawn as a heat map colored through
+// the palette. A bandwidth of zero is set by Scott's rule to the
+// standard deviation of the coordinates of the points scaled by
+// n^(-1/6) for n points.
+//
+// The grid of the estimate covers the range of the points. It is
+// widened by a bandwidth along an axis over which the points have
+// no extent.
+//
+// An error is returned if there are no points, if a coordinate is
+// infinite or NaN, or if a bandwidth is negative or cannot be set
+// by Scott's rule because all the coordinates are equal.
+func NewKDE2D(xys XYer, bandwidthX, bandwidthY float64, p palette.Palette) (*KDE2D, error) {
+	data, err := CopyXYs(xys)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	if err := CheckFloats(bandwidthX, bandwidthY); err != nil {
+		return nil, err
+	}
+	if bandwidthX < 0 || bandwidthY < 0 {
+		return nil, errors.New("plotter: negative bandwidth")
+	}
+	if bandwidthX == 0 {
+		bandwidthX = scottBandwidth(XValues{data})
+	}
+	if bandwidthY == 0 {
+		bandwidthY = scottBandwidth(YValues{data})
+	}
+	if bandwidthX == 0 || bandwidthY == 0 {
+		return nil, errors.New("plotter: cannot estimate bandwidth of constant data")
+	}
+
+	k := &KDE2D{
+		BandwidthX: bandwidthX,
+		BandwidthY: bandwidthY,
+	}
+	k.xmin, k.xmax, k.ymin, k.ymax = XYRange(data)
+	if k.xmin == k.xmax {
+		k.xmin -= bandwidthX
+		k.xmax += bandwidthX
+	}
+	if k.ymin == k.ymax {
+		k.ymin -= bandwidthY
+		k.ymax += bandwidthY
+	}
+
+	g := kdeGrid{
+		xmin: k.xmin, xmax: k.xmax,
+		ymin: k.ymin, ymax: k.ymax,
+		z: make([]float64, kde2DGridSize*kde2DGridSize),
+	}
+	norm := 1 / (float64(len(data)) * 2 * math.Pi * bandwidthX * bandwidthY)
+	for c := 0; c < kde2DGridSize; c++ {
+		x := g.X(c)
+		for r := 0; r < kde2DGridSize; r++ {
+			y := g.Y(r)
+			var sum float64
+			for _, p := range data {
+				dx := (x - p.X) / bandwidthX
+				dy := (y - p.Y) / bandwidthY
+				sum += math.Exp(-(dx*dx + dy*dy) / 2)
+			}
+			g.z[c*kde2DGridSize+r] = sum * norm
+		}
+	}
+	k.Density = NewHeatMap(g, p)
+	return k, nil
+}
+
+// scottBandwidth returns the bandwidth given by Scott's
+// rule for the values in two dimensions.
+func scottBandwidth(vs Valuer) float64 {
+	if vs.Len() < 2 {
+		return 0
+	}
+	n := float64(vs.Len())
+	var mean float64
+	for i := 0; i < vs.Len(); i++ {
+		mean += vs.Value(i)
+	}
+	mean /= n
+	var ss float64
+	for i := 0; i < vs.Len(); i++ {
+		d := vs.Value(i) - mean
+		ss += d * d
+	}
+	return math.Sqrt(ss/(n-1)) * math.Pow(n, -1.0/6)
+}
+
+// Peak returns the point of the grid of the estimate with
+// the largest density, and its density.
+func (k *KDE2D) Peak() XYZ {
+	g := k.Density.GridXYZ
+	cols, rows := g.Dims()
+	max := XYZ{Z: math.Inf(-1)}
+	for c := 0; c < cols; c++ {
+		for r := 0; r < rows; r++ {
+			if z := g.Z(c, r); z > max.Z {
+				max = XYZ{X: g.X(c), Y: g.Y(r), Z: z}
+			}
+		}
+	}
+	return max
+}
+
+// Plot draws the KDE2D, implementing the plot.Plotter interface.
+func (k *KDE2D) Plot(c draw.Canvas, plt *plot.Plot) {
+	if len(k.Levels) == 0 {
+		k.Density.Plot(c, plt)
+		return
+	}
+
+	pal := k.Density.Palette.Colors()
+	if len(pal) == 0 {
+		panic("kde2d: empty palette")
+	}
+	trX, trY := plt.Transforms(&c)
+	g := k.Density.GridXYZ
+	cols, rows := g.Dims()
+	for i, lo := range k.Levels {
+		hi := math.Inf(1)
+		if i+1 < len(k.Levels) {
+			hi = k.Levels[i+1]
+		}
+
+		// Join the parts of the band in the cells of the
+		// grid into a single path, so that they are filled
+		// without seams along the edges of the cells.
+		var pa vg.Path
+		pts := make([]vg.Point, 0, 8)
+		for ci := 0; ci+1 < cols; ci++ {
+			for ri := 0; ri+1 < rows; ri++ {
+				cell := []kdeVertex{
+					{x: g.X(ci), y: g.Y(ri), z: g.Z(ci, ri)},
+					{x: g.X(ci + 1), y: g.Y(ri), z: g.Z(ci+1, ri)},
+					{x: g.X(ci + 1), y: g.Y(ri + 1), z: g.Z(ci+1, ri+1)},
+					{x: g.X(ci), y: g.Y(ri + 1), z: g.Z(ci, ri+1)},
+				}
+				poly := clipLevel(clipLevel(cell, lo, true), hi, false)
+				if len(poly) < 3 {
+					continue
+				}
+				pts = pts[:0]
+				for _, v := range poly {
+					pts = append(pts, vg.Point{X: trX(v.x), Y: trY(v.y)})
+				}
+				pts = c.ClipPolygonXY(pts)
+				if len(pts) < 3 {
+					continue
+				}
+				pa.Move(pts[0])
+				for _, pt := range pts[1:] {
+					pa.Line(pt)
+				}
+				pa.Close()
+			}
+		}
+		if len(pa) == 0 {
+			continue
+		}
+		j := 0
+		if n := len(k.Levels); n > 1 {
+			j = i * (len(pal) - 1) / (n - 1)
+		}
+		c.SetColor(pal[j])
+		c.Fill(pa)
+	}
+}
+
+// kdeVertex is a vertex of a polygon with
+// the density at the vertex.
+type kdeVertex struct {
+	x, y, z float64
+}
+
+// clipLevel returns the part of the polygon where the density,
+// interpolated linearly along its edges, is at least the level
+// if above is true, or at most the level otherwise.
+func clipLevel(poly []kdeVertex, level float64, above bool) []kdeVertex {
+	if math.IsInf(level, 0) {
+		if above == (level < 0) {
+			return poly
+		}
+		return nil
+	}
+	in := func(v kdeVertex) bool {
+		if above {
+			return v.z >= level
+		}
+		return v.z <= level
+	}
+	var out []kdeVertex
+	for i, cur := range poly {
+		prev := poly[(i+len(poly)-1)%len(poly)]
+		if in(cur) != in(prev) {
+			t := (level - prev.z) / (cur.z - prev.z)
+			out = append(out, kdeVertex{
+				x: prev.x + t*(cur.x-prev.x),
+				y: prev.y + t*(cur.y-prev.y),
+				z: level,
+			})
+		}
+		if in(cur) {
+			out = append(out, cur)
+		}
+	}
+	return out
+}
+
+// DataRange returns the minimum and maximum x and y values of
+// the grid of the estimate, which is the range of the points,
+// implementing the plot.DataRanger interface.
+func (k *KDE2D) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return k.xmin, k.xmax, k.ymin, k.ymax
+}
+
+// kdeGrid implements the GridXYZ interface for the grid
+// of a density estimate. The cells of the grid cover
+// its range.
+type kdeGrid struct {
+	xmin, xmax, ymin, ymax float64
+
+	// z holds the densities by column.
+	z []float64
+}
+
+func (g kdeGrid) Dims() (c, r int)   { return kde2DGridSize, kde2DGridSize }
+func (g kdeGrid) Z(c, r int) float64 { return g.z[c*kde2DGridSize+r] }
+func (g kdeGrid) X(c int) float64 {
+	if c < 0 || c >= kde2DGridSize {
+		panic("index out of range")
+	}
+	return g.xmin + (float64(c)+0.5)*(g.xmax-g.xmin)/kde2DGridSize
+}
+func (g kdeGrid) Y(r int) float64 {
+	if r < 0 || r >= kde2DGridSize {
+		panic("index out of range")
+	}
+	return g.ymin + (float64(r)+0.5)*(g.ymax-g.ymin)/kde2DGridSize
+}
@@ -0,0 +1,116 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestKDE2D(t *testing.T) {
+	cmpimg.CheckPlot(ExampleKDE2D, t, "kde2d.png")
+}
+
+func TestKDE2DPeak(t *testing.T) {
+	// A large cluster around (3, 2) and a smaller
+	// one around (-2, -1).
+	rnd := rand.New(rand.NewSource(1))
+	xys := make(plotter.XYs, 600)
+	for i := range xys {
+		cx, cy := 3.0, 2.0
+		if i%3 == 0 {
+			cx, cy = -2, -1
+		}
+		xys[i].X = cx + 0.5*rnd.NormFloat64()
+		xys[i].Y = cy + 0.5*rnd.NormFloat64()
+	}
+	k, err := plotter.NewKDE2D(xys, 0.3, 0.3, palette.Heat(10, 1))
+	if err != nil {
+		t.Fatalf("could not create density estimate: %v", err)
+	}
+
+	peak := k.Peak()
+	if d := math.Hypot(peak.X-3, peak.Y-2); d > 0.25 {
+		t.Errorf("peak (%v, %v) too far from the larger cluster: distance %v", peak.X, peak.Y, d)
+	}
+	if peak.Z != k.Density.Max {
+		t.Errorf("unexpected peak density: got:%v want:%v", peak.Z, k.Density.Max)
+	}
+
+	// The density of the smaller cluster is about half
+	// of the density of the larger one.
+	g := k.Density.GridXYZ
+	cols, rows := g.Dims()
+	var local plotter.XYZ
+	for c := 0; c < cols; c++ {
+		for r := 0; r < rows; r++ {
+			if g.X(c) < 0.5 && g.Z(c, r) > local.Z {
+				local = plotter.XYZ{X: g.X(c), Y: g.Y(r), Z: g.Z(c, r)}
+			}
+		}
+	}
+	if d := math.Hypot(local.X+2, local.Y+1); d > 0.25 {
+		t.Errorf("local peak (%v, %v) too far from the smaller cluster: distance %v", local.X, local.Y, d)
+	}
+	if ratio := local.Z / peak.Z; ratio < 0.4 || ratio > 0.6 {
+		t.Errorf("unexpected ratio of the peak densities: got:%v want:about 0.5", ratio)
+	}
+
+	xmin, xmax, ymin, ymax := k.DataRange()
+	wxmin, wxmax, wymin, wymax := plotter.XYRange(xys)
+	if xmin != wxmin || xmax != wxmax || ymin != wymin || ymax != wymax {
+		t.Errorf("unexpected data range: got:[%v, %v]x[%v, %v] want:[%v, %v]x[%v, %v]",
+			xmin, xmax, ymin, ymax, wxmin, wxmax, wymin, wymax)
+	}
+
+	// The density integrates to about one over the grid.
+	var sum float64
+	for c := 0; c < cols; c++ {
+		for r := 0; r < rows; r++ {
+			sum += g.Z(c, r)
+		}
+	}
+	sum *= (xmax - xmin) / float64(cols) * (ymax - ymin) / float64(rows)
+	if math.Abs(sum-1) > 0.05 {
+		t.Errorf("unexpected integral of the density: got:%v want:1", sum)
+	}
+
+	// Each band is filled with a single path.
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	p.Add(k)
+	k.Levels = []float64{peak.Z / 4, peak.Z / 2, 3 * peak.Z / 4}
+	var rec recorder.Canvas
+	k.Plot(draw.NewCanvas(&rec, 100, 100), p)
+	var fills int
+	for _, a := range rec.Actions {
+		if _, ok := a.(*recorder.Fill); ok {
+			fills++
+		}
+	}
+	if fills != len(k.Levels) {
+		t.Errorf("unexpected number of filled bands: got:%d want:%d", fills, len(k.Levels))
+	}
+
+	for _, bw := range [][2]float64{{-1, 1}, {1, math.NaN()}} {
+		if _, err := plotter.NewKDE2D(xys, bw[0], bw[1], palette.Heat(10, 1)); err == nil {
+			t.Errorf("expected error for bandwidth %v", bw)
+		}
+	}
+	if _, err := plotter.NewKDE2D(plotter.XYs{{X: 1, Y: 1}}, 0, 0, palette.Heat(10, 1)); err == nil {
+		t.Error("expected error for bandwidth of a single point")
+	}
+}
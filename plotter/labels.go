@@ -6,6 +6,8 @@ package plotter
 
 import (
 	"errors"
+	"image/color"
+	"math"
 
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/vg"
@@ -36,8 +38,31 @@ type Labels struct {
 	// XOffset and YOffset are added directly to the final
 	// label X and Y location respectively.
 	XOffset, YOffset vg.Length
+
+	// AvoidOverlap specifies whether labels overlapping
+	// previously drawn labels are moved vertically, by
+	// up to two label heights, to a position where they
+	// do not overlap, if there is one. Labels are placed
+	// in order, so the layout is deterministic.
+	AvoidOverlap bool
+
+	// LeaderStyle is the style of the leader lines drawn
+	// from the labels moved by AvoidOverlap to their point.
+	LeaderStyle draw.LineStyle
 }
 
+// labelShifts are the vertical shifts, in units of the label
+// height plus labelGap, tried in order to place a label that
+// overlaps other labels when AvoidOverlap is set.
+var labelShifts = []vg.Length{0, 1, -1, maxLabelShift, -maxLabelShift}
+
+// maxLabelShift is the largest of the labelShifts.
+const maxLabelShift = 2
+
+// labelGap is the vertical gap between labels
+// moved apart by AvoidOverlap.
+const labelGap = vg.Length(1)
+
 // NewLabels returns a new Labels using the DefaultFont and
 // the DefaultFontSize.
 func NewLabels(d XYLabeller) (*Labels, error) {
@@ -69,26 +94,89 @@ func NewLabels(d XYLabeller) (*Labels, error) {
 	}
 
 	return &Labels{
-		XYs:       xys,
-		Labels:    strs,
-		TextStyle: styles,
+		XYs:         xys,
+		Labels:      strs,
+		TextStyle:   styles,
+		LeaderStyle: draw.LineStyle{Color: color.Black, Width: vg.Points(0.5)},
 	}, nil
 }
 
 // Plot implements the Plotter interface, drawing labels.
 func (l *Labels) Plot(c draw.Canvas, p *plot.Plot) {
 	trX, trY := p.Transforms(&c)
+	var placed []vg.Rectangle
 	for i, label := range l.Labels {
-		pt := vg.Point{X: trX(l.XYs[i].X), Y: trY(l.XYs[i].Y)}
-		if !c.Contains(pt) {
+		anchor := vg.Point{X: trX(l.XYs[i].X), Y: trY(l.XYs[i].Y)}
+		if !c.Contains(anchor) {
 			continue
 		}
+		pt := anchor
 		pt.X += l.XOffset
 		pt.Y += l.YOffset
+		if l.AvoidOverlap {
+			var box vg.Rectangle
+			pt, box = l.place(i, pt, placed)
+			placed = append(placed, box)
+			if pt.Y != anchor.Y+l.YOffset {
+				c.StrokeLines(l.LeaderStyle, []vg.Point{anchor, nearest(box, anchor)})
+			}
+		}
 		c.FillText(l.TextStyle[i], pt, label)
 	}
 }
 
+// place returns the position of the ith label drawn at pt, and
+// its box, moved so that it overlaps the least with the boxes of
+// the labels already placed.
+func (l *Labels) place(i int, pt vg.Point, placed []vg.Rectangle) (vg.Point, vg.Rectangle) {
+	r := l.TextStyle[i].Rectangle(l.Labels[i])
+	step := r.Size().Y + labelGap
+	var (
+		best    vg.Point
+		bestBox vg.Rectangle
+		least   = vg.Length(math.Inf(1))
+	)
+	for _, k := range labelShifts {
+		p := vg.Point{X: pt.X, Y: pt.Y + k*step}
+		box := vg.Rectangle{Min: p.Add(r.Min), Max: p.Add(r.Max)}
+		var overlap vg.Length
+		for _, b := range placed {
+			overlap += overlapArea(box, b)
+		}
+		if overlap < least {
+			best, bestBox, least = p, box, overlap
+		}
+		if overlap == 0 {
+			break
+		}
+	}
+	return best, bestBox
+}
+
+// overlapArea returns the area of the intersection of a and b.
+func overlapArea(a, b vg.Rectangle) vg.Length {
+	w := vg.Length(math.Min(float64(a.Max.X), float64(b.Max.X)) - math.Max(float64(a.Min.X), float64(b.Min.X)))
+	h := vg.Length(math.Min(float64(a.Max.Y), float64(b.Max.Y)) - math.Max(float64(a.Min.Y), float64(b.Min.Y)))
+	if w <= 0 || h <= 0 {
+		return 0
+	}
+	return w * h
+}
+
+// nearest returns the point of r nearest to pt.
+func nearest(r vg.Rectangle, pt vg.Point) vg.Point {
+	clamp := func(v, min, max vg.Length) vg.Length {
+		switch {
+		case v < min:
+			return min
+		case v > max:
+			return max
+		}
+		return v
+	}
+	return vg.Point{X: clamp(pt.X, r.Min.X, r.Max.X), Y: clamp(pt.Y, r.Min.Y, r.Max.Y)}
+}
+
 // DataRange returns the minimum and maximum X and Y values
 func (l *Labels) DataRange() (xmin, xmax, ymin, ymax float64) {
 	return XYRange(l)
@@ -97,6 +185,10 @@ func (l *Labels) DataRange() (xmin, xmax, ymin, ymax float64) {
 // GlyphBoxes returns a slice of GlyphBoxes,
 // one for each of the labels, implementing the
 // plot.GlyphBoxer interface.
+//
+// When AvoidOverlap is set, the boxes cover all the
+// positions the labels may be moved to, since the
+// layout of the labels depends on the canvas size.
 func (l *Labels) GlyphBoxes(p *plot.Plot) []plot.GlyphBox {
 	bs := make([]plot.GlyphBox, len(l.Labels))
 	for i, label := range l.Labels {
@@ -104,6 +196,11 @@ func (l *Labels) GlyphBoxes(p *plot.Plot) []plot.GlyphBox {
 		bs[i].Y = p.Y.Norm(l.XYs[i].Y)
 		sty := l.TextStyle[i]
 		bs[i].Rectangle = sty.Rectangle(label)
+		if l.AvoidOverlap {
+			d := maxLabelShift * (bs[i].Rectangle.Size().Y + labelGap)
+			bs[i].Rectangle.Min.Y += l.YOffset - d
+			bs[i].Rectangle.Max.Y += l.YOffset + d
+		}
 	}
 	return bs
 }
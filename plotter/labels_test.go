@@ -7,10 +7,147 @@ package plotter_test
 import (
 	"testing"
 
+	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 func TestLabels(t *testing.T) {
 	cmpimg.CheckPlot(ExampleLabels, t, "labels.png")
 	cmpimg.CheckPlot(ExampleLabels_inCanvasCoordinates, t, "labels_cnv_coords.png")
 }
+
+func TestLabelsAvoidOverlap(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	p.X.Min, p.X.Max = 0, 10
+	p.Y.Min, p.Y.Max = 0, 10
+
+	l, err := plotter.NewLabels(plotter.XYLabels{
+		XYs:    []plotter.XY{{X: 5, Y: 5}, {X: 5.01, Y: 5}, {X: 5, Y: 5.01}},
+		Labels: []string{"first", "second", "third"},
+	})
+	if err != nil {
+		t.Fatalf("could not create labels: %v", err)
+	}
+	l.AvoidOverlap = true
+	p.Add(l)
+	p.HideAxes()
+
+	var c recorder.Canvas
+	dc := draw.NewCanvas(&c, 10*vg.Centimeter, 10*vg.Centimeter)
+	p.Draw(dc)
+	da := p.DataCanvas(dc)
+
+	anchors := make([]vg.Point, len(l.XYs))
+	for i, xy := range l.XYs {
+		anchors[i] = vg.Point{X: da.X(p.X.Norm(xy.X)), Y: da.Y(p.Y.Norm(xy.Y))}
+	}
+
+	var (
+		texts   []*recorder.FillString
+		leaders int
+	)
+	for _, a := range c.Actions {
+		switch a := a.(type) {
+		case *recorder.FillString:
+			texts = append(texts, a)
+		case *recorder.Stroke:
+			for _, pt := range anchors {
+				if len(a.Path) == 2 && a.Path[0].Pos == pt {
+					leaders++
+				}
+			}
+		}
+	}
+	if len(texts) != 3 {
+		t.Fatalf("unexpected number of labels: got:%d want:3", len(texts))
+	}
+
+	// Compute the boxes of the labels from the displacement
+	// of their text relative to the first label, which is
+	// drawn at its point.
+	boxes := make([]vg.Rectangle, len(texts))
+	for i, txt := range texts {
+		pt := anchors[0].Add(txt.Point.Sub(texts[0].Point))
+		r := l.TextStyle[i].Rectangle(txt.String)
+		boxes[i] = vg.Rectangle{Min: pt.Add(r.Min), Max: pt.Add(r.Max)}
+	}
+	for i := range boxes {
+		for j := i + 1; j < len(boxes); j++ {
+			a, b := boxes[i], boxes[j]
+			if a.Min.X < b.Max.X && b.Min.X < a.Max.X && a.Min.Y < b.Max.Y && b.Min.Y < a.Max.Y {
+				t.Errorf("labels %d and %d overlap: %v %v", i, j, a, b)
+			}
+		}
+	}
+	if leaders != 2 {
+		t.Errorf("unexpected number of leader lines: got:%d want:2", leaders)
+	}
+
+	// The glyph boxes must cover the displaced labels.
+	for i, g := range l.GlyphBoxes(p) {
+		pt := vg.Point{X: da.X(g.X), Y: da.Y(g.Y)}
+		r := vg.Rectangle{Min: pt.Add(g.Rectangle.Min), Max: pt.Add(g.Rectangle.Max)}
+		b := boxes[i]
+		const tol = 1e-9
+		if b.Min.X < r.Min.X-tol || b.Max.X > r.Max.X+tol || b.Min.Y < r.Min.Y-tol || b.Max.Y > r.Max.Y+tol {
+			t.Errorf("glyph box %v does not cover label %d at %v", r, i, b)
+		}
+	}
+}
+
+func TestLabelsMultiLine(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	p.X.Min, p.X.Max = 0, 10
+	p.Y.Min, p.Y.Max = 0, 10
+
+	l, err := plotter.NewLabels(plotter.XYLabels{
+		XYs:    []plotter.XY{{X: 5, Y: 5}},
+		Labels: []string{"first line\nsecond"},
+	})
+	if err != nil {
+		t.Fatalf("could not create labels: %v", err)
+	}
+	l.TextStyle[0].LineHeight = 14
+	p.Add(l)
+
+	fnt := l.TextStyle[0].Font
+	boxes := l.GlyphBoxes(p)
+	if len(boxes) != 1 {
+		t.Fatalf("unexpected number of glyph boxes: got:%d want:1", len(boxes))
+	}
+	size := boxes[0].Rectangle.Size()
+	if want := 14 + fnt.Extents().Ascent; size.Y != want {
+		t.Errorf("unexpected glyph box height: got:%v want:%v", size.Y, want)
+	}
+	if want := fnt.Width("first line"); size.X != want {
+		t.Errorf("unexpected glyph box width: got:%v want:%v", size.X, want)
+	}
+
+	var c recorder.Canvas
+	p.Draw(draw.NewCanvas(&c, 10*vg.Centimeter, 10*vg.Centimeter))
+	var lines []*recorder.FillString
+	for _, a := range c.Actions {
+		if a, ok := a.(*recorder.FillString); ok && (a.String == "first line" || a.String == "second") {
+			lines = append(lines, a)
+		}
+	}
+	if len(lines) != 2 {
+		t.Fatalf("unexpected number of lines: got:%d want:2", len(lines))
+	}
+	if lines[0].Point.X != lines[1].Point.X {
+		t.Errorf("lines not left aligned: got x=%v and x=%v", lines[0].Point.X, lines[1].Point.X)
+	}
+	if d := lines[0].Point.Y - lines[1].Point.Y; d != 14 {
+		t.Errorf("unexpected distance between baselines: got:%v want:14", d)
+	}
+}
@@ -6,6 +6,7 @@ package plotter
 
 import (
 	"image/color"
+	"math"
 
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/vg"
@@ -38,6 +39,13 @@ type Line struct {
 	// StepStyle is the kind of the step line.
 	StepStyle StepKind
 
+	// SkipNaN specifies whether the points with a NaN
+	// coordinate are skipped, connecting the line across
+	// them, instead of breaking the line into separate
+	// segments at them, leaving a gap. The default is to
+	// break the line.
+	SkipNaN bool
+
 	// LineStyle is the style of the line connecting the points.
 	// Use zero width to disable lines.
 	draw.LineStyle
@@ -45,14 +53,31 @@ type Line struct {
 	// FillColor is the color to fill the area below the plot.
 	// Use nil to disable the filling. This is the default.
 	FillColor color.Color
+
+	// FillGradient, if not empty, is the vertical gradient
+	// used to fill the area below the plot instead of the
+	// FillColor. The offset 0 of the gradient is at the
+	// bottom of the filled area, and the offset 1 is at
+	// the highest point of the line.
+	FillGradient []vg.GradientStop
+
+	// GlyphStyle is the style of the glyphs drawn at
+	// each point of the line. Use a nil Shape to disable
+	// the glyphs. This is the default.
+	GlyphStyle draw.GlyphStyle
 }
 
 // NewLine returns a Line that uses the default line style and
-// does not draw glyphs.
+// does not draw glyphs. The coordinates of the points may be NaN
+// to mark missing data, but an error is returned if one of them
+// is infinite.
 func NewLine(xys XYer) (*Line, error) {
-	data, err := CopyXYs(xys)
-	if err != nil {
-		return nil, err
+	data := make(XYs, xys.Len())
+	for i := range data {
+		data[i].X, data[i].Y = xys.XY(i)
+		if math.IsInf(data[i].X, 0) || math.IsInf(data[i].Y, 0) {
+			return nil, ErrInfinity
+		}
 	}
 	return &Line{
 		XYs:       data,
@@ -63,90 +88,168 @@ func NewLine(xys XYer) (*Line, error) {
 // Plot draws the Line, implementing the plot.Plotter interface.
 func (pts *Line) Plot(c draw.Canvas, plt *plot.Plot) {
 	trX, trY := plt.Transforms(&c)
-	ps := make([]vg.Point, len(pts.XYs))
-
-	for i, p := range pts.XYs {
-		ps[i].X = trX(p.X)
-		ps[i].Y = trY(p.Y)
+	var (
+		segs [][]vg.Point
+		ps   []vg.Point
+	)
+	for _, p := range pts.XYs {
+		if isNaNXY(p) {
+			if !pts.SkipNaN && len(ps) != 0 {
+				segs = append(segs, ps)
+				ps = nil
+			}
+			continue
+		}
+		ps = append(ps, vg.Point{X: trX(p.X), Y: trY(p.Y)})
+	}
+	if len(ps) != 0 {
+		segs = append(segs, ps)
 	}
 
-	if pts.FillColor != nil && len(ps) > 0 {
-		minY := trY(plt.Y.Min)
-		fillPoly := []vg.Point{{X: ps[0].X, Y: minY}}
-		switch pts.StepStyle {
-		case PreStep:
-			fillPoly = append(fillPoly, ps[1:]...)
-		case PostStep:
-			fillPoly = append(fillPoly, ps[:len(ps)-1]...)
-		default:
-			fillPoly = append(fillPoly, ps...)
+	if pts.FillColor != nil || len(pts.FillGradient) != 0 {
+		for _, ps := range segs {
+			pts.fill(c, trY(plt.Y.Min), ps)
 		}
-		fillPoly = append(fillPoly, vg.Point{X: ps[len(ps)-1].X, Y: minY})
-		fillPoly = c.ClipPolygonXY(fillPoly)
-		if len(fillPoly) > 0 {
-			c.SetColor(pts.FillColor)
-			var pa vg.Path
-			prev := fillPoly[0]
-			pa.Move(prev)
-			for _, pt := range fillPoly[1:] {
-				switch pts.StepStyle {
-				case NoStep:
-					pa.Line(pt)
-				case PreStep:
-					pa.Line(vg.Point{X: prev.X, Y: pt.Y})
-					pa.Line(pt)
-				case MidStep:
-					pa.Line(vg.Point{X: (prev.X + pt.X) / 2, Y: prev.Y})
-					pa.Line(vg.Point{X: (prev.X + pt.X) / 2, Y: pt.Y})
-					pa.Line(pt)
-				case PostStep:
-					pa.Line(vg.Point{X: pt.X, Y: prev.Y})
-					pa.Line(pt)
+	}
+
+	if pts.LineStyle.Width != 0 && len(segs) != 0 {
+		c.SetLineStyle(pts.LineStyle)
+		for _, ps := range segs {
+			for _, l := range c.ClipLinesXY(ps) {
+				if len(l) == 0 {
+					continue
+				}
+				var p vg.Path
+				prev := l[0]
+				p.Move(prev)
+				for _, pt := range l[1:] {
+					switch pts.StepStyle {
+					case PreStep:
+						p.Line(vg.Point{X: prev.X, Y: pt.Y})
+					case MidStep:
+						p.Line(vg.Point{X: (prev.X + pt.X) / 2, Y: prev.Y})
+						p.Line(vg.Point{X: (prev.X + pt.X) / 2, Y: pt.Y})
+					case PostStep:
+						p.Line(vg.Point{X: pt.X, Y: prev.Y})
+					}
+					p.Line(pt)
+					prev = pt
 				}
-				prev = pt
+				c.Stroke(p)
 			}
-			pa.Close()
-			c.Fill(pa)
 		}
 	}
 
-	lines := c.ClipLinesXY(ps)
-	if pts.LineStyle.Width != 0 && len(lines) != 0 {
-		c.SetLineStyle(pts.LineStyle)
-		for _, l := range lines {
-			if len(l) == 0 {
-				continue
+	if pts.GlyphStyle.Shape != nil {
+		for _, ps := range segs {
+			for _, p := range ps {
+				c.DrawGlyph(pts.GlyphStyle, p)
 			}
-			var p vg.Path
-			prev := l[0]
-			p.Move(prev)
-			for _, pt := range l[1:] {
-				switch pts.StepStyle {
-				case PreStep:
-					p.Line(vg.Point{X: prev.X, Y: pt.Y})
-				case MidStep:
-					p.Line(vg.Point{X: (prev.X + pt.X) / 2, Y: prev.Y})
-					p.Line(vg.Point{X: (prev.X + pt.X) / 2, Y: pt.Y})
-				case PostStep:
-					p.Line(vg.Point{X: pt.X, Y: prev.Y})
-				}
-				p.Line(pt)
-				prev = pt
+		}
+	}
+}
+
+// fill fills the area below the segment ps of the line,
+// down to minY.
+func (pts *Line) fill(c draw.Canvas, minY vg.Length, ps []vg.Point) {
+	fillPoly := []vg.Point{{X: ps[0].X, Y: minY}}
+	switch pts.StepStyle {
+	case PreStep:
+		fillPoly = append(fillPoly, ps[1:]...)
+	case PostStep:
+		fillPoly = append(fillPoly, ps[:len(ps)-1]...)
+	default:
+		fillPoly = append(fillPoly, ps...)
+	}
+	fillPoly = append(fillPoly, vg.Point{X: ps[len(ps)-1].X, Y: minY})
+	fillPoly = c.ClipPolygonXY(fillPoly)
+	if len(fillPoly) == 0 {
+		return
+	}
+	var pa vg.Path
+	prev := fillPoly[0]
+	pa.Move(prev)
+	for _, pt := range fillPoly[1:] {
+		switch pts.StepStyle {
+		case NoStep:
+			pa.Line(pt)
+		case PreStep:
+			pa.Line(vg.Point{X: prev.X, Y: pt.Y})
+			pa.Line(pt)
+		case MidStep:
+			pa.Line(vg.Point{X: (prev.X + pt.X) / 2, Y: prev.Y})
+			pa.Line(vg.Point{X: (prev.X + pt.X) / 2, Y: pt.Y})
+			pa.Line(pt)
+		case PostStep:
+			pa.Line(vg.Point{X: pt.X, Y: prev.Y})
+			pa.Line(pt)
+		}
+		prev = pt
+	}
+	pa.Close()
+	if len(pts.FillGradient) != 0 {
+		top := minY
+		for _, pt := range fillPoly {
+			if pt.Y > top {
+				top = pt.Y
 			}
-			c.Stroke(p)
 		}
+		c.FillGradient(pa, vg.LinearGradient{
+			Start: vg.Point{X: fillPoly[0].X, Y: minY},
+			End:   vg.Point{X: fillPoly[0].X, Y: top},
+			Stops: pts.FillGradient,
+		})
+	} else {
+		c.SetColor(pts.FillColor)
+		c.Fill(pa)
 	}
 }
 
-// DataRange returns the minimum and maximum
-// x and y values, implementing the plot.DataRanger interface.
+// isNaNXY returns whether a coordinate of p is NaN.
+func isNaNXY(p XY) bool {
+	return math.IsNaN(p.X) || math.IsNaN(p.Y)
+}
+
+// DataRange returns the minimum and maximum x and y values
+// of the points without a NaN coordinate, implementing the
+// plot.DataRanger interface.
 func (pts *Line) DataRange() (xmin, xmax, ymin, ymax float64) {
-	return XYRange(pts)
+	xmin, xmax = math.Inf(1), math.Inf(-1)
+	ymin, ymax = math.Inf(1), math.Inf(-1)
+	for _, p := range pts.XYs {
+		if isNaNXY(p) {
+			continue
+		}
+		xmin, xmax = math.Min(xmin, p.X), math.Max(xmax, p.X)
+		ymin, ymax = math.Min(ymin, p.Y), math.Max(ymax, p.Y)
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// GlyphBoxes returns a slice of plot.GlyphBoxes for the
+// glyphs drawn at the points of the line, implementing
+// the plot.GlyphBoxer interface.
+func (pts *Line) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	if pts.GlyphStyle.Shape == nil {
+		return nil
+	}
+	bs := make([]plot.GlyphBox, 0, len(pts.XYs))
+	for _, p := range pts.XYs {
+		if isNaNXY(p) {
+			continue
+		}
+		bs = append(bs, plot.GlyphBox{
+			X:         plt.X.Norm(p.X),
+			Y:         plt.Y.Norm(p.Y),
+			Rectangle: pts.GlyphStyle.Rectangle(),
+		})
+	}
+	return bs
 }
 
 // Thumbnail returns the thumbnail for the Line, implementing the plot.Thumbnailer interface.
 func (pts *Line) Thumbnail(c *draw.Canvas) {
-	if pts.FillColor != nil {
+	if pts.FillColor != nil || len(pts.FillGradient) != 0 {
 		var topY vg.Length
 		if pts.LineStyle.Width == 0 {
 			topY = c.Max.Y
@@ -160,13 +263,25 @@ func (pts *Line) Thumbnail(c *draw.Canvas) {
 			{X: c.Max.X, Y: c.Min.Y},
 		}
 		poly := c.ClipPolygonY(points)
-		c.FillPolygon(pts.FillColor, poly)
+		if len(pts.FillGradient) != 0 {
+			c.FillPolygonGradient(vg.LinearGradient{
+				Start: vg.Point{X: c.Min.X, Y: c.Min.Y},
+				End:   vg.Point{X: c.Min.X, Y: topY},
+				Stops: pts.FillGradient,
+			}, poly)
+		} else {
+			c.FillPolygon(pts.FillColor, poly)
+		}
 	}
 
 	if pts.LineStyle.Width != 0 {
 		y := c.Center().Y
 		c.StrokeLine2(pts.LineStyle, c.Min.X, y, c.Max.X, y)
 	}
+
+	if pts.GlyphStyle.Shape != nil {
+		c.DrawGlyph(pts.GlyphStyle, c.Center())
+	}
 }
 
 // NewLinePoints returns both a Line and a
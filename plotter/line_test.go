@@ -0,0 +1,141 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+// glyphPoints is a draw.GlyphDrawer recording
+// where glyphs are drawn.
+type glyphPoints struct {
+	pts *[]vg.Point
+}
+
+func (g glyphPoints) DrawGlyph(c *draw.Canvas, sty draw.GlyphStyle, pt vg.Point) {
+	*g.pts = append(*g.pts, pt)
+}
+
+func TestLineGlyphs(t *testing.T) {
+	l, err := plotter.NewLine(plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 2}, {X: 2, Y: 1}})
+	if err != nil {
+		t.Fatalf("could not create line: %v", err)
+	}
+	var glyphs []vg.Point
+	l.GlyphStyle = draw.GlyphStyle{Shape: glyphPoints{&glyphs}, Radius: 3}
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	p.X.Min, p.X.Max = 0, 2
+	p.Y.Min, p.Y.Max = 0, 2
+
+	var rec recorder.Canvas
+	l.Plot(draw.NewCanvas(&rec, 100, 100), p)
+
+	want := []vg.Point{{X: 0, Y: 0}, {X: 50, Y: 100}, {X: 100, Y: 50}}
+	var line []vg.Point
+	for _, a := range rec.Actions {
+		if s, ok := a.(*recorder.Stroke); ok {
+			for _, c := range s.Path {
+				line = append(line, c.Pos)
+			}
+		}
+	}
+	if !reflect.DeepEqual(line, want) {
+		t.Errorf("unexpected line path: got:%v want:%v", line, want)
+	}
+	if !reflect.DeepEqual(glyphs, want) {
+		t.Errorf("unexpected glyphs: got:%v want:%v", glyphs, want)
+	}
+
+	boxes := l.GlyphBoxes(p)
+	if len(boxes) != len(want) {
+		t.Fatalf("unexpected number of glyph boxes: got:%d want:%d", len(boxes), len(want))
+	}
+	r := vg.Rectangle{Min: vg.Point{X: -3, Y: -3}, Max: vg.Point{X: 3, Y: 3}}
+	for i, b := range boxes {
+		if b.X != float64(want[i].X)/100 || b.Y != float64(want[i].Y)/100 || b.Rectangle != r {
+			t.Errorf("unexpected glyph box %d: got:%+v", i, b)
+		}
+	}
+
+	l.GlyphStyle.Shape = nil
+	if boxes := l.GlyphBoxes(p); boxes != nil {
+		t.Errorf("unexpected glyph boxes without glyphs: %v", boxes)
+	}
+}
+
+func TestLineNaN(t *testing.T) {
+	data := plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 2}, {X: 2, Y: math.NaN()}, {X: 3, Y: 1}, {X: 4, Y: 2}}
+	l, err := plotter.NewLine(data)
+	if err != nil {
+		t.Fatalf("could not create line: %v", err)
+	}
+	xmin, xmax, ymin, ymax := l.DataRange()
+	if xmin != 0 || xmax != 4 || ymin != 0 || ymax != 2 {
+		t.Errorf("unexpected data range: got:[%v, %v]x[%v, %v] want:[0, 4]x[0, 2]", xmin, xmax, ymin, ymax)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	p.X.Min, p.X.Max = 0, 4
+	p.Y.Min, p.Y.Max = 0, 2
+
+	for _, test := range []struct {
+		skip bool
+		want [][]vg.Point
+	}{
+		{
+			skip: false,
+			want: [][]vg.Point{
+				{{X: 0, Y: 0}, {X: 25, Y: 100}},
+				{{X: 75, Y: 50}, {X: 100, Y: 100}},
+			},
+		},
+		{
+			skip: true,
+			want: [][]vg.Point{
+				{{X: 0, Y: 0}, {X: 25, Y: 100}, {X: 75, Y: 50}, {X: 100, Y: 100}},
+			},
+		},
+	} {
+		l.SkipNaN = test.skip
+		var rec recorder.Canvas
+		l.Plot(draw.NewCanvas(&rec, 100, 100), p)
+
+		var got [][]vg.Point
+		for _, a := range rec.Actions {
+			s, ok := a.(*recorder.Stroke)
+			if !ok {
+				continue
+			}
+			var seg []vg.Point
+			for _, c := range s.Path {
+				seg = append(seg, c.Pos)
+			}
+			got = append(got, seg)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected line paths with SkipNaN=%t: got:%v want:%v", test.skip, got, test.want)
+		}
+	}
+
+	_, err = plotter.NewLine(plotter.XYs{{X: 0, Y: math.Inf(1)}})
+	if err != plotter.ErrInfinity {
+		t.Errorf("unexpected error for infinite point: got:%v want:%v", err, plotter.ErrInfinity)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Lollipop implements the plot.Plotter interface, drawing
+// a lollipop chart: a thin stem from the baseline to each
+// value, with a glyph at the value. The ith value is at the
+// category location XMin+i, so that the categories can be
+// named with plot.NominalX.
+type Lollipop struct {
+	Values
+
+	// XMin is the category location of the first value.
+	XMin float64
+
+	// Baseline is the value from which the stems are drawn.
+	Baseline float64
+
+	// Horizontal dictates whether the stems should be in the
+	// vertical (default) or horizontal direction. If Horizontal
+	// is true, the categories are on the Y axis and the values
+	// on the X axis.
+	Horizontal bool
+
+	// StemStyle is the style of the stems.
+	// Use zero width to disable the stems.
+	StemStyle draw.LineStyle
+
+	// GlyphStyle is the style of the glyphs
+	// drawn at the values.
+	draw.GlyphStyle
+}
+
+// NewLollipop returns a new lollipop chart with a stem
+// and a glyph for each value, drawn with the default
+// line and glyph styles.
+func NewLollipop(vs Valuer) (*Lollipop, error) {
+	values, err := CopyValues(vs)
+	if err != nil {
+		return nil, err
+	}
+	return &Lollipop{
+		Values:     values,
+		StemStyle:  DefaultLineStyle,
+		GlyphStyle: DefaultGlyphStyle,
+	}, nil
+}
+
+// Plot implements the plot.Plotter interface.
+func (l *Lollipop) Plot(c draw.Canvas, plt *plot.Plot) {
+	trCat, trVal := plt.Transforms(&c)
+	if l.Horizontal {
+		trCat, trVal = trVal, trCat
+	}
+
+	base := trVal(l.Baseline)
+	for i, v := range l.Values {
+		cat := trCat(l.XMin + float64(i))
+		val := trVal(v)
+		stem := []vg.Point{{X: cat, Y: base}, {X: cat, Y: val}}
+		pt := vg.Point{X: cat, Y: val}
+		if l.Horizontal {
+			stem = []vg.Point{{X: base, Y: cat}, {X: val, Y: cat}}
+			pt = vg.Point{X: val, Y: cat}
+		}
+		if l.StemStyle.Width != 0 {
+			c.StrokeLines(l.StemStyle, c.ClipLinesXY(stem)...)
+		}
+		if c.Contains(pt) {
+			c.DrawGlyph(l.GlyphStyle, pt)
+		}
+	}
+}
+
+// DataRange implements the plot.DataRanger interface.
+// The value range includes the baseline.
+func (l *Lollipop) DataRange() (xmin, xmax, ymin, ymax float64) {
+	catMin := l.XMin
+	catMax := catMin + float64(len(l.Values)-1)
+
+	valMin, valMax := l.Baseline, l.Baseline
+	for _, v := range l.Values {
+		valMin = math.Min(valMin, v)
+		valMax = math.Max(valMax, v)
+	}
+	if !l.Horizontal {
+		return catMin, catMax, valMin, valMax
+	}
+	return valMin, valMax, catMin, catMax
+}
+
+// GlyphBoxes implements the plot.GlyphBoxer
+// interface, so that the glyphs are not clipped.
+func (l *Lollipop) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	boxes := make([]plot.GlyphBox, len(l.Values))
+	for i, v := range l.Values {
+		cat := l.XMin + float64(i)
+		if !l.Horizontal {
+			boxes[i].X = plt.X.Norm(cat)
+			boxes[i].Y = plt.Y.Norm(v)
+		} else {
+			boxes[i].X = plt.X.Norm(v)
+			boxes[i].Y = plt.Y.Norm(cat)
+		}
+		boxes[i].Rectangle = l.GlyphStyle.Rectangle()
+	}
+	return boxes
+}
+
+// Thumbnail implements the plot.Thumbnailer interface.
+func (l *Lollipop) Thumbnail(c *draw.Canvas) {
+	x := c.Center().X
+	if l.StemStyle.Width != 0 {
+		c.StrokeLine2(l.StemStyle, x, c.Min.Y, x, c.Center().Y)
+	}
+	c.DrawGlyph(l.GlyphStyle, c.Center())
+}
@@ -0,0 +1,92 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestLollipop(t *testing.T) {
+	cmpimg.CheckPlot(ExampleLollipop, t, "lollipop.png")
+}
+
+func TestLollipopStems(t *testing.T) {
+	for _, test := range []struct {
+		horizontal bool
+		want       [][2]vg.Point
+	}{
+		{
+			horizontal: false,
+			want: [][2]vg.Point{
+				{{X: 0, Y: 25}, {X: 0, Y: 75}},
+				{{X: 50, Y: 25}, {X: 50, Y: 0}},
+				{{X: 100, Y: 25}, {X: 100, Y: 100}},
+			},
+		},
+		{
+			horizontal: true,
+			want: [][2]vg.Point{
+				{{X: 25, Y: 0}, {X: 75, Y: 0}},
+				{{X: 25, Y: 50}, {X: 0, Y: 50}},
+				{{X: 25, Y: 100}, {X: 100, Y: 100}},
+			},
+		},
+	} {
+		l, err := plotter.NewLollipop(plotter.Values{2, -1, 3})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		l.Horizontal = test.horizontal
+		l.Shape = nil
+
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("could not create plot: %v", err)
+		}
+		// Map categories from 0 to 2 and values
+		// from -1 to 3 onto the 100×100 canvas.
+		cat, val := &p.X, &p.Y
+		if test.horizontal {
+			cat, val = val, cat
+		}
+		cat.Min, cat.Max = 0, 2
+		val.Min, val.Max = -1, 3
+
+		var rec recorder.Canvas
+		l.Plot(draw.NewCanvas(&rec, 100, 100), p)
+
+		var got [][2]vg.Point
+		for _, a := range rec.Actions {
+			s, ok := a.(*recorder.Stroke)
+			if !ok {
+				continue
+			}
+			if len(s.Path) != 2 {
+				t.Fatalf("unexpected stem path: %v", s.Path)
+			}
+			got = append(got, [2]vg.Point{s.Path[0].Pos, s.Path[1].Pos})
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected stems for horizontal=%t:\ngot: %v\nwant:%v", test.horizontal, got, test.want)
+		}
+
+		xmin, xmax, ymin, ymax := l.DataRange()
+		want := [4]float64{0, 2, -1, 3}
+		if test.horizontal {
+			want = [4]float64{-1, 3, 0, 2}
+		}
+		if got := [4]float64{xmin, xmax, ymin, ymax}; got != want {
+			t.Errorf("unexpected data range for horizontal=%t: got:%v want:%v", test.horizontal, got, want)
+		}
+	}
+}
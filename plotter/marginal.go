@@ -0,0 +1,101 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+
+	"gonum.org/v1/plot"
+)
+
+// MarginalHistograms is a scatter plot of points with
+// histograms of the X and Y values of the points along
+// its top and right margins.
+type MarginalHistograms struct {
+	// Figure is the figure of the plots, drawn by its
+	// Draw and Save methods. The plot of the histogram
+	// of the X values is above the scatter plot and
+	// shares its X axis, and the plot of the histogram
+	// of the Y values is on its right and shares its
+	// Y axis.
+	*plot.Figure
+
+	// Center, Top and Right are the scatter plot and
+	// the plots of the histograms of the X and Y values.
+	// The shared axes of the plots of the histograms
+	// are hidden.
+	Center, Top, Right *plot.Plot
+
+	// Scatter is the scatter of the points.
+	Scatter *Scatter
+
+	// X and Y are the histograms of the X and Y values.
+	// The bins of X cover the range of the X axis of the
+	// scatter plot, and the horizontal bins of Y cover
+	// the range of its Y axis.
+	X, Y *Histogram
+}
+
+// NewMarginalHistograms returns MarginalHistograms of the points
+// with histograms of n bins each, taking a quarter of the width
+// and of the height of the figure.
+//
+// An error is returned if n is not positive, if there are no
+// points, or if a coordinate is infinite or NaN.
+func NewMarginalHistograms(xys XYer, n int) (*MarginalHistograms, error) {
+	if n <= 0 {
+		return nil, errors.New("plotter: marginal histograms with non-positive number of bins")
+	}
+	data, err := CopyXYs(xys)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+
+	m := &MarginalHistograms{}
+	m.Scatter, err = NewScatter(data)
+	if err != nil {
+		return nil, err
+	}
+	m.X, err = NewHist(XValues{data}, n)
+	if err != nil {
+		return nil, err
+	}
+	m.Y, err = NewHist(YValues{data}, n)
+	if err != nil {
+		return nil, err
+	}
+	m.Y.Horizontal = true
+
+	m.Center, err = plot.New()
+	if err != nil {
+		return nil, err
+	}
+	m.Center.Add(m.Scatter)
+	m.Top, err = plot.New()
+	if err != nil {
+		return nil, err
+	}
+	m.Top.Add(m.X)
+	m.Top.HideX()
+	m.Right, err = plot.New()
+	if err != nil {
+		return nil, err
+	}
+	m.Right.Add(m.Y)
+	m.Right.HideY()
+
+	m.Figure = plot.NewFigure(2, 2)
+	m.Plots[0][0] = m.Top
+	m.Plots[1][0] = m.Center
+	m.Plots[1][1] = m.Right
+	m.Tiles.ColWidths = []float64{3, 1}
+	m.Tiles.RowHeights = []float64{1, 3}
+	m.ShareX = true
+	m.ShareY = true
+	return m, nil
+}
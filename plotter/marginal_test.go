@@ -0,0 +1,91 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestMarginalHistograms(t *testing.T) {
+	cmpimg.CheckPlot(ExampleMarginalHistograms, t, "marginalHistograms.png")
+}
+
+func closeTo(a, b vg.Length) bool {
+	return math.Abs(float64(a-b)) < 1e-9
+}
+
+func TestMarginalHistogramsAxes(t *testing.T) {
+	const n = 5
+	pts := plotter.XYs{{X: -2, Y: 10}, {X: 0, Y: 30}, {X: 1, Y: 15}, {X: 8, Y: 20}}
+	m, err := plotter.NewMarginalHistograms(pts, n)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The bins of the histograms cover the ranges of the
+	// corresponding axes of the scatter plot.
+	for _, test := range []struct {
+		name     string
+		h        *plotter.Histogram
+		min, max float64
+	}{
+		{name: "x", h: m.X, min: m.Center.X.Min, max: m.Center.X.Max},
+		{name: "y", h: m.Y, min: m.Center.Y.Min, max: m.Center.Y.Max},
+	} {
+		if len(test.h.Bins) != n {
+			t.Errorf("%s: unexpected number of bins: got:%d want:%d", test.name, len(test.h.Bins), n)
+			continue
+		}
+		if min, max := test.h.Bins[0].Min, test.h.Bins[n-1].Max; min != test.min || max != test.max {
+			t.Errorf("%s: unexpected range of bins: got:[%v,%v] want:[%v,%v]", test.name, min, max, test.min, test.max)
+		}
+	}
+	if m.Top.X.Min != m.Center.X.Min || m.Top.X.Max != m.Center.X.Max {
+		t.Errorf("unexpected X range of the top plot: got:[%v,%v] want:[%v,%v]",
+			m.Top.X.Min, m.Top.X.Max, m.Center.X.Min, m.Center.X.Max)
+	}
+	if m.Right.Y.Min != m.Center.Y.Min || m.Right.Y.Max != m.Center.Y.Max {
+		t.Errorf("unexpected Y range of the right plot: got:[%v,%v] want:[%v,%v]",
+			m.Right.Y.Min, m.Right.Y.Max, m.Center.Y.Min, m.Center.Y.Max)
+	}
+
+	// The data areas of the histograms are aligned with the
+	// data area of the scatter plot, and take a quarter of
+	// the width and of the height of the data areas.
+	c := draw.NewCanvas(&recorder.Canvas{}, 400, 400)
+	tiles := plot.Align(m.Plots, m.Tiles, c)
+	top := m.Top.DataCanvas(tiles[0][0])
+	center := m.Center.DataCanvas(tiles[1][0])
+	right := m.Right.DataCanvas(tiles[1][1])
+	if !closeTo(top.Min.X, center.Min.X) || !closeTo(top.Max.X, center.Max.X) {
+		t.Errorf("unexpected X extent of the top histogram: got:[%v,%v] want:[%v,%v]",
+			top.Min.X, top.Max.X, center.Min.X, center.Max.X)
+	}
+	if !closeTo(right.Min.Y, center.Min.Y) || !closeTo(right.Max.Y, center.Max.Y) {
+		t.Errorf("unexpected Y extent of the right histogram: got:[%v,%v] want:[%v,%v]",
+			right.Min.Y, right.Max.Y, center.Min.Y, center.Max.Y)
+	}
+	if got, want := top.Size().Y*3, center.Size().Y; !closeTo(got, want) {
+		t.Errorf("unexpected height of the top histogram: got:%v want:%v", got/3, want/3)
+	}
+	if got, want := right.Size().X*3, center.Size().X; !closeTo(got, want) {
+		t.Errorf("unexpected width of the right histogram: got:%v want:%v", got/3, want/3)
+	}
+
+	if _, err := plotter.NewMarginalHistograms(pts, 0); err == nil {
+		t.Errorf("expected an error for zero bins")
+	}
+	if _, err := plotter.NewMarginalHistograms(plotter.XYs{{X: math.NaN()}}, n); err == nil {
+		t.Errorf("expected an error for NaN")
+	}
+}
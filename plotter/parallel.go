@@ -0,0 +1,226 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Dimension is a dimension of the records of
+// a parallel coordinates plot.
+type Dimension struct {
+	// Min and Max are the values of the dimension
+	// at the bottom and the top of its axis. If
+	// they are equal, the range of the values of
+	// the records is used.
+	Min, Max float64
+}
+
+// ParallelCoords implements the Plotter interface, drawing
+// a parallel coordinates plot: each dimension of the records
+// has a vertical axis, at the positions 0 to n-1 along the X
+// axis for n dimensions, and each record is drawn as a line
+// through its values on the axes.
+//
+// The values are normalized to the range of their dimension,
+// so that the axes span the Y values 0 to 1. The Y axis of the
+// plot is usually hidden, and the dimensions named with
+// p.NominalX.
+type ParallelCoords struct {
+	// Records is a copy of the values of the
+	// records, in the order of the dimensions.
+	Records [][]float64
+
+	// Dimensions are the ranges of the dimensions.
+	Dimensions []Dimension
+
+	// Classes, if not nil, are the classes of the
+	// records, used to select their line colors.
+	Classes []int
+
+	// Colors are the line colors of the classes
+	// of the records, used in turn. If Classes
+	// is nil, the color of the LineStyle is used.
+	Colors []color.Color
+
+	// LineStyle is the style of the lines of the records.
+	draw.LineStyle
+
+	// AxisStyle is the style of the axes
+	// and the tick marks of the dimensions.
+	AxisStyle draw.LineStyle
+
+	// TickLabel is the style of the tick labels,
+	// drawn on the left of the axes.
+	TickLabel draw.TextStyle
+
+	// TickLength is the length of the tick marks.
+	TickLength vg.Length
+
+	// Ticker returns the tick marks of the dimensions.
+	Ticker plot.Ticker
+}
+
+// NewParallelCoords returns a ParallelCoords for records of
+// len(dims) values. Dimensions with equal Min and Max get
+// the range of the values of the records. The lines are
+// drawn in the default line style and the tick labels in
+// the DefaultFont and the DefaultFontSize.
+//
+// An error is returned if there are no records, if a record
+// does not have a value for each dimension, or if a value is
+// infinite or NaN.
+func NewParallelCoords(records [][]float64, dims []Dimension) (*ParallelCoords, error) {
+	if len(records) == 0 || len(dims) == 0 {
+		return nil, ErrNoData
+	}
+	data := make([][]float64, len(records))
+	for i, r := range records {
+		if len(r) != len(dims) {
+			return nil, errors.New("plotter: number of values does not match the number of dimensions")
+		}
+		if err := CheckFloats(r...); err != nil {
+			return nil, err
+		}
+		data[i] = append([]float64(nil), r...)
+	}
+
+	ranges := append([]Dimension(nil), dims...)
+	for j, d := range ranges {
+		if d.Min != d.Max {
+			continue
+		}
+		d.Min, d.Max = math.Inf(1), math.Inf(-1)
+		for _, r := range data {
+			d.Min = math.Min(d.Min, r[j])
+			d.Max = math.Max(d.Max, r[j])
+		}
+		ranges[j] = d
+	}
+
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
+	return &ParallelCoords{
+		Records:    data,
+		Dimensions: ranges,
+		LineStyle:  DefaultLineStyle,
+		AxisStyle:  draw.LineStyle{Color: color.Black, Width: vg.Points(0.5)},
+		TickLabel: draw.TextStyle{
+			Font:    fnt,
+			XAlign:  draw.XRight,
+			YAlign:  draw.YCenter,
+			Handler: plot.DefaultTextHandler,
+		},
+		TickLength: vg.Points(4),
+		Ticker:     plot.DefaultTicks{},
+	}, nil
+}
+
+// Normalize returns the value v of the jth dimension
+// normalized to its range, so that the minimum of the
+// range is 0 and its maximum is 1. Values of dimensions
+// with an empty range are normalized to 0.5.
+func (pc *ParallelCoords) Normalize(j int, v float64) float64 {
+	d := pc.Dimensions[j]
+	if d.Min == d.Max {
+		return 0.5
+	}
+	return (v - d.Min) / (d.Max - d.Min)
+}
+
+// color returns the line color of the ith record.
+func (pc *ParallelCoords) color(i int) color.Color {
+	if pc.Classes == nil || len(pc.Colors) == 0 {
+		return pc.LineStyle.Color
+	}
+	return pc.Colors[pc.Classes[i]%len(pc.Colors)]
+}
+
+// ticks returns the major tick marks of the jth dimension.
+func (pc *ParallelCoords) ticks(j int) []plot.Tick {
+	d := pc.Dimensions[j]
+	if d.Min == d.Max || pc.Ticker == nil {
+		return nil
+	}
+	min, max := math.Min(d.Min, d.Max), math.Max(d.Min, d.Max)
+	var ticks []plot.Tick
+	for _, t := range pc.Ticker.Ticks(min, max) {
+		if !t.IsMinor() && t.Value >= min && t.Value <= max {
+			ticks = append(ticks, t)
+		}
+	}
+	return ticks
+}
+
+// Plot draws the ParallelCoords, implementing the plot.Plotter
+// interface.
+func (pc *ParallelCoords) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+
+	sty := pc.LineStyle
+	line := make([]vg.Point, len(pc.Dimensions))
+	for i, r := range pc.Records {
+		for j, v := range r {
+			line[j] = vg.Point{X: trX(float64(j)), Y: trY(pc.Normalize(j, v))}
+		}
+		sty.Color = pc.color(i)
+		c.StrokeLines(sty, c.ClipLinesXY(line)...)
+	}
+
+	for j := range pc.Dimensions {
+		x := trX(float64(j))
+		if !c.ContainsX(x) {
+			continue
+		}
+		c.StrokeLine2(pc.AxisStyle, x, trY(0), x, trY(1))
+		for _, t := range pc.ticks(j) {
+			y := trY(pc.Normalize(j, t.Value))
+			c.StrokeLine2(pc.AxisStyle, x-pc.TickLength, y, x, y)
+			c.FillText(pc.TickLabel, vg.Point{X: x - 2*pc.TickLength, Y: y}, t.Label)
+		}
+	}
+}
+
+// DataRange returns the minimum and maximum x and y values,
+// implementing the plot.DataRanger interface. The X range
+// covers the positions of the axes of the dimensions and
+// the Y range is from 0 to 1.
+func (pc *ParallelCoords) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return 0, float64(len(pc.Dimensions) - 1), 0, 1
+}
+
+// GlyphBoxes returns a GlyphBox for each of the tick labels
+// of the dimensions, implementing the plot.GlyphBoxer
+// interface.
+func (pc *ParallelCoords) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	var boxes []plot.GlyphBox
+	for j := range pc.Dimensions {
+		for _, t := range pc.ticks(j) {
+			r := pc.TickLabel.Rectangle(t.Label)
+			off := vg.Point{X: -2 * pc.TickLength}
+			boxes = append(boxes, plot.GlyphBox{
+				X:         plt.X.Norm(float64(j)),
+				Y:         plt.Y.Norm(pc.Normalize(j, t.Value)),
+				Rectangle: vg.Rectangle{Min: r.Min.Add(off), Max: r.Max.Add(off)},
+			})
+		}
+	}
+	return boxes
+}
+
+// Thumbnail draws a line in the style of the records,
+// implementing the plot.Thumbnailer interface.
+func (pc *ParallelCoords) Thumbnail(c *draw.Canvas) {
+	y := c.Center().Y
+	c.StrokeLine2(pc.LineStyle, c.Min.X, y, c.Max.X, y)
+}
@@ -0,0 +1,114 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestParallelCoords(t *testing.T) {
+	cmpimg.CheckPlot(ExampleParallelCoords, t, "parallelCoords.png")
+}
+
+func TestParallelCoordsLines(t *testing.T) {
+	records := [][]float64{
+		{0, 10, 5},
+		{4, 30, 5},
+		{2, 20, 7},
+	}
+	pc, err := plotter.NewParallelCoords(records, []plotter.Dimension{
+		{Min: 0, Max: 8},
+		{Min: 40, Max: 0},
+		{}, // From the data: [5, 7].
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	red, blue := color.RGBA{R: 255, A: 255}, color.RGBA{B: 255, A: 255}
+	pc.Classes = []int{0, 1, 0}
+	pc.Colors = []color.Color{red, blue}
+
+	want := [][]float64{
+		{0, 0.75, 0},
+		{0.5, 0.25, 0},
+		{0.25, 0.5, 1},
+	}
+	for i, r := range records {
+		for j, v := range r {
+			if got := pc.Normalize(j, v); math.Abs(got-want[i][j]) > 1e-12 {
+				t.Errorf("unexpected normalized value of record %d dimension %d: got:%v want:%v", i, j, got, want[i][j])
+			}
+		}
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	p.X.Min, p.X.Max = 0, 2
+	p.Y.Min, p.Y.Max = 0, 1
+	var rec recorder.Canvas
+	pc.Plot(draw.NewCanvas(&rec, 200, 100), p)
+
+	var (
+		clr   color.Color
+		lines [][]vg.Point
+		clrs  []color.Color
+		axes  int
+	)
+	for _, a := range rec.Actions {
+		switch a := a.(type) {
+		case *recorder.SetColor:
+			clr = a.Color
+		case *recorder.Stroke:
+			switch len(a.Path) {
+			case 3:
+				var pts []vg.Point
+				for _, c := range a.Path {
+					pts = append(pts, c.Pos)
+				}
+				lines = append(lines, pts)
+				clrs = append(clrs, clr)
+			case 2:
+				p0, p1 := a.Path[0].Pos, a.Path[1].Pos
+				if p0.X == p1.X && p0.Y == 0 && p1.Y == 100 {
+					axes++
+				}
+			}
+		}
+	}
+	if len(lines) != len(records) {
+		t.Fatalf("unexpected number of record lines: got:%d want:%d", len(lines), len(records))
+	}
+	for i, pts := range lines {
+		for j, pt := range pts {
+			w := vg.Point{X: vg.Length(100 * j), Y: vg.Length(100 * want[i][j])}
+			if math.Abs(float64(pt.X-w.X)) > 1e-9 || math.Abs(float64(pt.Y-w.Y)) > 1e-9 {
+				t.Errorf("unexpected vertex %d of record %d: got:%v want:%v", j, i, pt, w)
+			}
+		}
+	}
+	for i, c := range []color.Color{red, blue, red} {
+		if clrs[i] != c {
+			t.Errorf("unexpected color of record %d: got:%v want:%v", i, clrs[i], c)
+		}
+	}
+	if axes != 3 {
+		t.Errorf("unexpected number of dimension axes: got:%d want:3", axes)
+	}
+
+	if _, err := plotter.NewParallelCoords([][]float64{{1, 2}}, []plotter.Dimension{{}}); err == nil {
+		t.Error("expected error for a record with too many values")
+	}
+}
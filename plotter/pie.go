@@ -0,0 +1,238 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Pie implements the Plotter interface, drawing a pie
+// chart centered on the origin: each value is drawn as
+// a wedge whose angle is proportional to the value.
+// With a non-zero InnerRadius, the chart is drawn as a
+// donut chart.
+//
+// The wedges are only drawn as circular sectors if the
+// X and Y axes of the plot have the same scale. The axes
+// of pie charts are usually hidden with plot.HideAxes.
+type Pie struct {
+	// Values is a copy of the values of the wedges.
+	Values
+
+	// Labels are the labels of the wedges, for
+	// the legend entries of their Thumbnailers.
+	Labels []string
+
+	// Colors are the fill colors of the wedges,
+	// used in turn.
+	Colors []color.Color
+
+	// StartAngle is the angle in radians,
+	// counter-clockwise from the positive X
+	// axis, at which the first wedge starts.
+	StartAngle float64
+
+	// Clockwise specifies whether the wedges are
+	// laid out clockwise from the StartAngle,
+	// instead of counter-clockwise.
+	Clockwise bool
+
+	// Radius and InnerRadius are the outer and inner
+	// radii of the wedges. The wedges join at the
+	// center if InnerRadius is zero.
+	Radius, InnerRadius float64
+
+	// LineStyle is the style of the outlines of the
+	// wedges.
+	// Use zero width to disable outlines.
+	draw.LineStyle
+
+	// Percent is the style of the percentage labels
+	// drawn at the middle of the wedges.
+	Percent draw.TextStyle
+
+	// PercentFormat is the fmt format of the percentage
+	// labels, given the percentage of each value in
+	// the total of the values.
+	// Use an empty format to disable labels.
+	PercentFormat string
+
+	// total is the sum of the values.
+	total float64
+}
+
+// NewPie returns a Pie chart of the values with the given
+// labels, starting at the top of the chart and laid out
+// clockwise. The wedges have a radius of one, are filled with
+// colors from a rainbow palette and outlined in white, without
+// percentage labels.
+//
+// An error is returned if a value is negative, infinite or NaN,
+// if all values are zero, or if the number of labels does not
+// match the number of values.
+func NewPie(vs Valuer, labels []string) (*Pie, error) {
+	values, err := CopyValues(vs)
+	if err != nil {
+		return nil, err
+	}
+	if len(labels) != len(values) {
+		return nil, errors.New("plotter: number of labels does not match the number of values")
+	}
+	var total float64
+	for _, v := range values {
+		if v < 0 {
+			return nil, errors.New("plotter: negative pie value")
+		}
+		total += v
+	}
+	if total == 0 {
+		return nil, errors.New("plotter: pie values sum to zero")
+	}
+
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
+	return &Pie{
+		Values:     values,
+		Labels:     append([]string(nil), labels...),
+		Colors:     stackColors(len(values)),
+		StartAngle: math.Pi / 2,
+		Clockwise:  true,
+		Radius:     1,
+		LineStyle:  draw.LineStyle{Color: color.White, Width: vg.Points(1)},
+		Percent: draw.TextStyle{
+			Color:   color.Black,
+			Font:    fnt,
+			XAlign:  draw.XCenter,
+			YAlign:  draw.YCenter,
+			Handler: plot.DefaultTextHandler,
+		},
+		total: total,
+	}, nil
+}
+
+// Angles returns the start and end angles in radians,
+// counter-clockwise from the positive X axis, of the
+// wedge of the ith value. The end angle is less than
+// the start angle if the wedges are laid out clockwise.
+func (p *Pie) Angles(i int) (start, end float64) {
+	var sum float64
+	for _, v := range p.Values[:i] {
+		sum += v
+	}
+	dir := 1.0
+	if p.Clockwise {
+		dir = -1
+	}
+	start = p.StartAngle + dir*2*math.Pi*sum/p.total
+	end = p.StartAngle + dir*2*math.Pi*(sum+p.Values[i])/p.total
+	return start, end
+}
+
+// color returns the fill color of the ith wedge.
+func (p *Pie) color(i int) color.Color {
+	if len(p.Colors) == 0 {
+		return nil
+	}
+	return p.Colors[i%len(p.Colors)]
+}
+
+// Plot draws the Pie, implementing the plot.Plotter interface.
+func (p *Pie) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	at := func(theta, r float64) vg.Point {
+		sin, cos := math.Sincos(theta)
+		return vg.Point{X: trX(r * cos), Y: trY(r * sin)}
+	}
+
+	for i, v := range p.Values {
+		if v == 0 {
+			continue
+		}
+		start, end := p.Angles(i)
+		n := int(math.Ceil(math.Abs(end-start) / (2 * math.Pi) * polarCircleSegments))
+		if n < 1 {
+			n = 1
+		}
+		wedge := make([]vg.Point, 0, 2*n+2)
+		for j := 0; j <= n; j++ {
+			wedge = append(wedge, at(start+(end-start)*float64(j)/float64(n), p.Radius))
+		}
+		if p.InnerRadius > 0 {
+			for j := n; j >= 0; j-- {
+				wedge = append(wedge, at(start+(end-start)*float64(j)/float64(n), p.InnerRadius))
+			}
+		} else {
+			wedge = append(wedge, at(0, 0))
+		}
+
+		if col := p.color(i); col != nil {
+			c.FillPolygon(col, c.ClipPolygonXY(wedge))
+		}
+		if p.LineStyle.Width != 0 {
+			c.StrokeLines(p.LineStyle, c.ClipLinesXY(append(wedge, wedge[0]))...)
+		}
+	}
+
+	if p.PercentFormat == "" {
+		return
+	}
+	for i, v := range p.Values {
+		if v == 0 {
+			continue
+		}
+		start, end := p.Angles(i)
+		pt := at((start+end)/2, (p.Radius+p.InnerRadius)/2)
+		if !c.Contains(pt) {
+			continue
+		}
+		c.FillText(p.Percent, pt, fmt.Sprintf(p.PercentFormat, 100*v/p.total))
+	}
+}
+
+// DataRange returns the extent of the outer circle of the
+// wedges, implementing the plot.DataRanger interface.
+func (p *Pie) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return -p.Radius, p.Radius, -p.Radius, p.Radius
+}
+
+// Thumbnailers returns a plot.Thumbnailer for each wedge
+// of the pie, in the order of the values, that can be
+// used to add legend entries with the Labels.
+func (p *Pie) Thumbnailers() []plot.Thumbnailer {
+	ts := make([]plot.Thumbnailer, len(p.Values))
+	for i := range ts {
+		ts[i] = pieWedge{pie: p, wedge: i}
+	}
+	return ts
+}
+
+// pieWedge implements the Thumbnailer interface
+// for a wedge of a pie chart.
+type pieWedge struct {
+	pie   *Pie
+	wedge int
+}
+
+// Thumbnail satisfies the plot.Thumbnailer interface.
+func (w pieWedge) Thumbnail(c *draw.Canvas) {
+	pts := []vg.Point{
+		{X: c.Min.X, Y: c.Min.Y},
+		{X: c.Min.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Min.Y},
+	}
+	if col := w.pie.color(w.wedge); col != nil {
+		c.FillPolygon(col, c.ClipPolygonY(pts))
+	}
+}
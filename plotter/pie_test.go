@@ -0,0 +1,121 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestPie(t *testing.T) {
+	cmpimg.CheckPlot(ExamplePie, t, "pie.png")
+}
+
+func TestPieAngles(t *testing.T) {
+	values := plotter.Values{1, 2, 0, 3, 4}
+	pie, err := plotter.NewPie(values, make([]string, len(values)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, clockwise := range []bool{true, false} {
+		pie.Clockwise = clockwise
+		pie.StartAngle = 1
+		dir := 1.0
+		if clockwise {
+			dir = -1
+		}
+
+		var sum float64
+		prev := pie.StartAngle
+		for i, v := range values {
+			start, end := pie.Angles(i)
+			if start != prev {
+				t.Errorf("clockwise=%t: wedge %d does not start at the end of the previous wedge: got:%v want:%v",
+					clockwise, i, start, prev)
+			}
+			want := dir * 2 * math.Pi * v / 10
+			if math.Abs((end-start)-want) > 1e-12 {
+				t.Errorf("clockwise=%t: unexpected angle of wedge %d: got:%v want:%v", clockwise, i, end-start, want)
+			}
+			sum += end - start
+			prev = end
+		}
+		if math.Abs(sum-dir*2*math.Pi) > 1e-12 {
+			t.Errorf("clockwise=%t: unexpected sum of angles: got:%v want:%v", clockwise, sum, dir*2*math.Pi)
+		}
+	}
+
+	for _, test := range []struct {
+		values plotter.Values
+		labels []string
+	}{
+		{values: plotter.Values{1, -1}, labels: []string{"a", "b"}},
+		{values: plotter.Values{0, 0}, labels: []string{"a", "b"}},
+		{values: plotter.Values{1, 2}, labels: []string{"a"}},
+	} {
+		if _, err := plotter.NewPie(test.values, test.labels); err == nil {
+			t.Errorf("expected error for values %v and labels %q", test.values, test.labels)
+		}
+	}
+}
+
+func TestPieDonut(t *testing.T) {
+	pie, err := plotter.NewPie(plotter.Values{1, 1, 2}, []string{"a", "b", "c"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pie.InnerRadius = 0.4
+	pie.LineStyle.Width = 0
+
+	plt, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	plt.X.Min, plt.X.Max = -1, 1
+	plt.Y.Min, plt.Y.Max = -1, 1
+
+	c := new(recorder.Canvas)
+	dc := draw.NewCanvas(c, 10*vg.Centimeter, 10*vg.Centimeter)
+	pie.Plot(dc, plt)
+	trX, _ := plt.Transforms(&dc)
+	center := vg.Point{X: trX(0), Y: dc.Center().Y}
+	unit := float64(trX(1) - trX(0))
+
+	var fills int
+	for _, a := range c.Actions {
+		f, ok := a.(*recorder.Fill)
+		if !ok {
+			continue
+		}
+		fills++
+		min, max := math.Inf(1), math.Inf(-1)
+		for _, p := range f.Path {
+			if p.Type == vg.CloseComp {
+				continue
+			}
+			d := p.Pos.Sub(center)
+			r := math.Hypot(float64(d.X), float64(d.Y)) / unit
+			min = math.Min(min, r)
+			max = math.Max(max, r)
+		}
+		if math.Abs(min-pie.InnerRadius) > 1e-9 {
+			t.Errorf("unexpected inner radius of wedge %d: got:%v want:%v", fills-1, min, pie.InnerRadius)
+		}
+		if math.Abs(max-pie.Radius) > 1e-9 {
+			t.Errorf("unexpected outer radius of wedge %d: got:%v want:%v", fills-1, max, pie.Radius)
+		}
+	}
+	if fills != 3 {
+		t.Errorf("unexpected number of wedges: got:%d want:3", fills)
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"strconv"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Polar is an XYer converting points given in polar
+// coordinates to Cartesian coordinates. The X value of
+// each point of the wrapped XYer is its angle θ, in
+// radians counter-clockwise from the positive X axis,
+// and its Y value is its distance r from the origin.
+//
+// Polar can be used to create any plotter taking an
+// XYer from polar data, and drawn over a PolarGrid.
+type Polar struct {
+	XYer
+}
+
+// XY returns the Cartesian coordinates
+// (r cos θ, r sin θ) of the ith point.
+func (p Polar) XY(i int) (x, y float64) {
+	theta, r := p.XYer.XY(i)
+	sin, cos := math.Sincos(theta)
+	return r * cos, r * sin
+}
+
+// NewPolarLine returns a Line that uses the default line style
+// and does not draw glyphs, joining points given as (θ, r)
+// polar coordinates.
+func NewPolarLine(pts XYer) (*Line, error) {
+	return NewLine(Polar{pts})
+}
+
+// NewPolarScatter returns a Scatter that uses the default glyph
+// style, drawing points given as (θ, r) polar coordinates.
+func NewPolarScatter(pts XYer) (*Scatter, error) {
+	return NewScatter(Polar{pts})
+}
+
+// PolarGrid implements the plot.Plotter interface, drawing a
+// polar grid centered on the origin, made of circles at the
+// major ticks of the radius and of radial lines at the given
+// angles. Labels are drawn for the radii of the circles and
+// around the grid for the angles.
+//
+// The circles of the grid are only drawn as circles if the X
+// and Y axes of the plot have the same scale. The axes of polar
+// plots are usually hidden with plot.HideAxes.
+type PolarGrid struct {
+	// Radius is the radius of the outer circle of the grid.
+	Radius float64
+
+	// Ticker returns the radii of the circles of the
+	// grid. Circles are drawn at the major ticks between
+	// zero and Radius, and at Radius.
+	Ticker plot.Ticker
+
+	// Angles are the angles of the radial lines of the
+	// grid, in radians counter-clockwise from the
+	// positive X axis.
+	Angles []float64
+
+	// AngleLabels are the labels of the radial lines.
+	// If AngleLabels does not have a label for each
+	// angle, the angles are labelled in degrees.
+	AngleLabels []string
+
+	// RadiusAngle is the angle, in radians, of the
+	// radial line along which the radii of the circles
+	// are labelled.
+	RadiusAngle float64
+
+	// LineStyle is the style of the lines of the grid.
+	LineStyle draw.LineStyle
+
+	// TextStyle is the style of the labels.
+	TextStyle draw.TextStyle
+
+	// LabelPadding is the distance between the outer
+	// circle of the grid and the labels of the angles.
+	LabelPadding vg.Length
+}
+
+// NewPolarGrid returns a polar grid of the given radius,
+// with radial lines every 30 degrees, using the default
+// grid line style and the DefaultFont.
+func NewPolarGrid(radius float64) (*PolarGrid, error) {
+	if !(radius > 0) || math.IsInf(radius, 1) {
+		return nil, errors.New("plotter: invalid polar grid radius")
+	}
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
+	angles := make([]float64, 12)
+	for i := range angles {
+		angles[i] = float64(i) * math.Pi / 6
+	}
+	return &PolarGrid{
+		Radius:      radius,
+		Ticker:      plot.DefaultTicks{},
+		Angles:      angles,
+		RadiusAngle: math.Pi / 12,
+		LineStyle:   DefaultGridLineStyle,
+		TextStyle: draw.TextStyle{
+			Color:   color.Black,
+			Font:    fnt,
+			Handler: plot.DefaultTextHandler,
+		},
+		LabelPadding: vg.Points(4),
+	}, nil
+}
+
+// polarCircleSegments is the number of line segments
+// used to draw the circles of a PolarGrid.
+const polarCircleSegments = 120
+
+// Plot implements the plot.Plotter interface.
+func (g *PolarGrid) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	at := func(theta, r float64) vg.Point {
+		sin, cos := math.Sincos(theta)
+		return vg.Point{X: trX(r * cos), Y: trY(r * sin)}
+	}
+
+	sty := g.TextStyle
+	sty.XAlign = draw.XLeft
+	sty.YAlign = draw.YBottom
+	for _, t := range g.radii() {
+		pts := make([]vg.Point, polarCircleSegments+1)
+		for i := range pts {
+			pts[i] = at(2*math.Pi*float64(i)/polarCircleSegments, t.Value)
+		}
+		c.StrokeLines(g.LineStyle, c.ClipLinesXY(pts)...)
+		if pt := at(g.RadiusAngle, t.Value); c.Contains(pt) {
+			c.FillText(sty, pt, t.Label)
+		}
+	}
+
+	for i, theta := range g.Angles {
+		c.StrokeLines(g.LineStyle, c.ClipLinesXY([]vg.Point{at(theta, 0), at(theta, g.Radius)})...)
+		sty, pt := g.angleLabel(theta)
+		pt = pt.Add(at(theta, g.Radius))
+		c.FillText(sty, pt, g.label(i))
+	}
+}
+
+// radii returns the ticks at the radii of the circles of the grid.
+func (g *PolarGrid) radii() []plot.Tick {
+	var ticks []plot.Tick
+	for _, t := range g.Ticker.Ticks(0, g.Radius) {
+		if t.IsMinor() || t.Value <= 0 || t.Value >= g.Radius {
+			continue
+		}
+		ticks = append(ticks, t)
+	}
+	return append(ticks, plot.Tick{
+		Value: g.Radius,
+		Label: strconv.FormatFloat(g.Radius, 'g', -1, 64),
+	})
+}
+
+// label returns the label of the ith angle.
+func (g *PolarGrid) label(i int) string {
+	if len(g.AngleLabels) == len(g.Angles) {
+		return g.AngleLabels[i]
+	}
+	deg := math.Round(g.Angles[i]*180/math.Pi*1e6) / 1e6
+	return strconv.FormatFloat(deg, 'f', -1, 64) + "°"
+}
+
+// angleLabel returns the style of the label of the given
+// angle and its offset from the outer circle of the grid.
+// The label is aligned so that it extends away from the grid.
+func (g *PolarGrid) angleLabel(theta float64) (draw.TextStyle, vg.Point) {
+	sin, cos := math.Sincos(theta)
+	sty := g.TextStyle
+	sty.XAlign = draw.XAlignment(-(1 - cos) / 2)
+	sty.YAlign = draw.YAlignment(-(1 - sin) / 2)
+	off := vg.Point{X: g.LabelPadding * vg.Length(cos), Y: g.LabelPadding * vg.Length(sin)}
+	return sty, off
+}
+
+// DataRange returns the extent of the outer circle of the
+// grid, implementing the plot.DataRanger interface.
+func (g *PolarGrid) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return -g.Radius, g.Radius, -g.Radius, g.Radius
+}
+
+// GlyphBoxes returns a GlyphBox for the label of each angle,
+// implementing the plot.GlyphBoxer interface.
+func (g *PolarGrid) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	bs := make([]plot.GlyphBox, len(g.Angles))
+	for i, theta := range g.Angles {
+		sin, cos := math.Sincos(theta)
+		sty, off := g.angleLabel(theta)
+		r := sty.Rectangle(g.label(i))
+		bs[i].X = plt.X.Norm(g.Radius * cos)
+		bs[i].Y = plt.Y.Norm(g.Radius * sin)
+		bs[i].Rectangle = vg.Rectangle{Min: r.Min.Add(off), Max: r.Max.Add(off)}
+	}
+	return bs
+}
@@ -0,0 +1,59 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestPolarGrid_radar(t *testing.T) {
+	cmpimg.CheckPlot(ExamplePolarGrid_radar, t, "radar.png")
+}
+
+func TestPolar(t *testing.T) {
+	const tol = 1e-12
+	pts := plotter.Polar{plotter.XYs{
+		{X: 0, Y: 2},
+		{X: math.Pi / 2, Y: 2},
+		{X: math.Pi, Y: 2},
+		{X: 3 * math.Pi / 2, Y: 0.5},
+	}}
+	want := []plotter.XY{
+		{X: 2, Y: 0},
+		{X: 0, Y: 2},
+		{X: -2, Y: 0},
+		{X: 0, Y: -0.5},
+	}
+	if pts.Len() != len(want) {
+		t.Fatalf("unexpected length: got:%d want:%d", pts.Len(), len(want))
+	}
+	for i, w := range want {
+		x, y := pts.XY(i)
+		if math.Abs(x-w.X) > tol || math.Abs(y-w.Y) > tol {
+			t.Errorf("unexpected point %d: got:(%g, %g) want:(%g, %g)", i, x, y, w.X, w.Y)
+		}
+	}
+}
+
+func TestPolarGridLabels(t *testing.T) {
+	g, err := plotter.NewPolarGrid(10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	xmin, xmax, ymin, ymax := g.DataRange()
+	if xmin != -10 || xmax != 10 || ymin != -10 || ymax != 10 {
+		t.Errorf("unexpected data range: got:[%g, %g]×[%g, %g] want:[-10, 10]×[-10, 10]", xmin, xmax, ymin, ymax)
+	}
+
+	for _, r := range []float64{0, -1, math.Inf(1), math.NaN()} {
+		if _, err := plotter.NewPolarGrid(r); err == nil {
+			t.Errorf("expected error for radius %g", r)
+		}
+	}
+}
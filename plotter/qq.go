@@ -0,0 +1,210 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Quantiler wraps the Quantile method of a distribution,
+// such as the distributions of gonum.org/v1/gonum/stat/distuv.
+type Quantiler interface {
+	// Quantile returns the value below which
+	// the fraction p of the distribution lies.
+	Quantile(p float64) float64
+}
+
+// QQ implements the Plotter interface, drawing a
+// quantile-quantile plot comparing the distribution
+// of a sample with a reference distribution or with
+// the distribution of a second sample: a glyph is
+// drawn at each pair of matching quantiles, with the
+// reference quantile along the X axis and the sample
+// quantile along the Y axis, together with a reference
+// line through the pairs of quartiles. The points lie
+// close to the line if the distributions have the same
+// shape, up to their location and scale.
+type QQ struct {
+	// XYs are the pairs of matching quantiles,
+	// in increasing order.
+	XYs
+
+	// GlyphStyle is the style of the glyphs
+	// drawn at each pair of quantiles.
+	draw.GlyphStyle
+
+	// LineStyle is the style of the reference line,
+	// drawn across the X range of the plot.
+	// Use zero width to disable the line.
+	LineStyle draw.LineStyle
+
+	// Slope and Intercept are the parameters of the
+	// reference line, y = Intercept + Slope×x, through
+	// the first and the third quartiles of the reference
+	// and of the sample.
+	Slope, Intercept float64
+}
+
+// NewQQ returns a Q-Q plot of the sample against the reference
+// distribution. The ith smallest of the n values of the sample
+// is paired with the quantile of the reference at (i+0.5)/n.
+// The points use the default glyph style, and the reference
+// line the default line style.
+//
+// An error is returned if the sample is empty, if a value is
+// infinite or NaN, or if a quantile of the reference is not
+// finite.
+func NewQQ(sample Valuer, ref Quantiler) (*QQ, error) {
+	ys, err := sortedValues(sample)
+	if err != nil {
+		return nil, err
+	}
+	n := float64(len(ys))
+	data := make(XYs, len(ys))
+	for i, y := range ys {
+		data[i] = XY{X: ref.Quantile((float64(i) + 0.5) / n), Y: y}
+	}
+	return newQQ(data,
+		ref.Quantile(0.25), ref.Quantile(0.75),
+		sampleQuantile(ys, 0.25), sampleQuantile(ys, 0.75),
+	)
+}
+
+// NewQQSamples returns a Q-Q plot of the sample y against the
+// reference sample x. If the samples have the same size, their
+// ith smallest values are paired. Otherwise the values of the
+// smaller sample are paired with the quantiles of the larger
+// sample at the same fractions, interpolated linearly between
+// its values.
+//
+// An error is returned if a sample is empty, or if a value is
+// infinite or NaN.
+func NewQQSamples(x, y Valuer) (*QQ, error) {
+	xs, err := sortedValues(x)
+	if err != nil {
+		return nil, err
+	}
+	ys, err := sortedValues(y)
+	if err != nil {
+		return nil, err
+	}
+	small := len(xs)
+	if len(ys) < small {
+		small = len(ys)
+	}
+	data := make(XYs, small)
+	for i := range data {
+		p := (float64(i) + 0.5) / float64(small)
+		data[i] = XY{X: sampleQuantile(xs, p), Y: sampleQuantile(ys, p)}
+	}
+	return newQQ(data,
+		sampleQuantile(xs, 0.25), sampleQuantile(xs, 0.75),
+		sampleQuantile(ys, 0.25), sampleQuantile(ys, 0.75),
+	)
+}
+
+// newQQ returns a QQ of the pairs of quantiles, with a
+// reference line through the quartiles (x1, y1) and
+// (x3, y3). The line is horizontal, halfway between y1
+// and y3, if x1 equals x3.
+func newQQ(data XYs, x1, x3, y1, y3 float64) (*QQ, error) {
+	for _, p := range data {
+		if err := CheckFloats(p.X, p.Y); err != nil {
+			return nil, err
+		}
+	}
+	q := &QQ{
+		XYs:        data,
+		GlyphStyle: DefaultGlyphStyle,
+		LineStyle:  DefaultLineStyle,
+		Intercept:  (y1 + y3) / 2,
+	}
+	if x1 != x3 {
+		q.Slope = (y3 - y1) / (x3 - x1)
+		q.Intercept = y1 - q.Slope*x1
+	}
+	return q, nil
+}
+
+// sortedValues returns the values of vs in increasing order.
+func sortedValues(vs Valuer) ([]float64, error) {
+	values, err := CopyValues(vs)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, ErrNoData
+	}
+	sort.Float64s(values)
+	return values, nil
+}
+
+// sampleQuantile returns the pth quantile of the sorted values,
+// placing the ith smallest of n values at the fraction (i+0.5)/n
+// and interpolating linearly between them. Quantiles below the
+// smallest or above the largest of these fractions are clamped
+// to the smallest or the largest value.
+func sampleQuantile(sorted []float64, p float64) float64 {
+	h := p*float64(len(sorted)) - 0.5
+	if h <= 0 {
+		return sorted[0]
+	}
+	if h >= float64(len(sorted)-1) {
+		return sorted[len(sorted)-1]
+	}
+	i := int(h)
+	return sorted[i] + (h-float64(i))*(sorted[i+1]-sorted[i])
+}
+
+// Plot draws the QQ, implementing the plot.Plotter interface.
+func (q *QQ) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	if q.LineStyle.Width != 0 {
+		// The line is drawn first so that
+		// it lies under the points.
+		line := func(x float64) vg.Point {
+			return vg.Point{X: trX(x), Y: trY(q.Intercept + q.Slope*x)}
+		}
+		c.StrokeLines(q.LineStyle, c.ClipLinesXY([]vg.Point{line(plt.X.Min), line(plt.X.Max)})...)
+	}
+	for _, p := range q.XYs {
+		c.DrawGlyph(q.GlyphStyle, vg.Point{X: trX(p.X), Y: trY(p.Y)})
+	}
+}
+
+// DataRange returns the minimum and maximum quantiles
+// of the reference and of the sample, implementing the
+// plot.DataRanger interface.
+func (q *QQ) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return XYRange(q.XYs)
+}
+
+// GlyphBoxes returns a GlyphBox for the glyph of each
+// pair of quantiles, implementing the plot.GlyphBoxer
+// interface.
+func (q *QQ) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	bs := make([]plot.GlyphBox, len(q.XYs))
+	for i, p := range q.XYs {
+		bs[i].X = plt.X.Norm(p.X)
+		bs[i].Y = plt.Y.Norm(p.Y)
+		bs[i].Rectangle = q.GlyphStyle.Rectangle()
+	}
+	return bs
+}
+
+// Thumbnail draws the reference line across the thumbnail
+// and a glyph at its center, implementing the
+// plot.Thumbnailer interface.
+func (q *QQ) Thumbnail(c *draw.Canvas) {
+	if q.LineStyle.Width != 0 {
+		y := c.Center().Y
+		c.StrokeLine2(q.LineStyle, c.Min.X, y, c.Max.X, y)
+	}
+	c.DrawGlyph(q.GlyphStyle, c.Center())
+}
@@ -0,0 +1,115 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/stat/distuv"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestQQ(t *testing.T) {
+	cmpimg.CheckPlot(ExampleQQ, t, "qq.png")
+}
+
+func TestQQNormal(t *testing.T) {
+	// The sample holds the quantiles of a normal
+	// distribution of mean 3 and standard deviation
+	// 2 at the plotting positions, out of order.
+	const n = 9
+	order := []int{4, 0, 8, 2, 6, 1, 7, 3, 5}
+	sample := make(plotter.Values, n)
+	for i, j := range order {
+		sample[i] = 3 + 2*distuv.UnitNormal.Quantile((float64(j)+0.5)/n)
+	}
+
+	qq, err := plotter.NewQQ(sample, distuv.UnitNormal)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(qq.XYs) != n {
+		t.Fatalf("unexpected number of quantile pairs: got:%d want:%d", len(qq.XYs), n)
+	}
+	for i, p := range qq.XYs {
+		wantX := distuv.UnitNormal.Quantile((float64(i) + 0.5) / n)
+		if math.Abs(p.X-wantX) > 1e-12 || math.Abs(p.Y-(3+2*wantX)) > 1e-12 {
+			t.Errorf("unexpected quantile pair %d: got:%v want:{%v %v}", i, p, wantX, 3+2*wantX)
+		}
+	}
+
+	// The reference line through the quartiles
+	// approximates the location and scale of the
+	// sample.
+	if math.Abs(qq.Slope-2) > 0.05 || math.Abs(qq.Intercept-3) > 1e-12 {
+		t.Errorf("unexpected reference line: got:y=%v+%v×x want:y=3+2×x", qq.Intercept, qq.Slope)
+	}
+
+	xmin, xmax, ymin, ymax := qq.DataRange()
+	if xmin != qq.XYs[0].X || xmax != qq.XYs[n-1].X || ymin != qq.XYs[0].Y || ymax != qq.XYs[n-1].Y {
+		t.Errorf("unexpected data range: got:[%v, %v]×[%v, %v]", xmin, xmax, ymin, ymax)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Add(qq)
+	var rec recorder.Canvas
+	p.Draw(draw.NewCanvas(&rec, 10*vg.Centimeter, 10*vg.Centimeter))
+	var glyphs int
+	for _, a := range rec.Actions {
+		if s, ok := a.(*recorder.Stroke); ok && len(s.Path) != 0 && s.Path[len(s.Path)-1].Type == vg.CloseComp {
+			glyphs++
+		}
+	}
+	if glyphs != n {
+		t.Errorf("unexpected number of glyphs: got:%d want:%d", glyphs, n)
+	}
+}
+
+func TestQQSamples(t *testing.T) {
+	x := plotter.Values{4, 1, 3, 2}
+	y := plotter.Values{2, 8, 6, 4}
+	qq, err := plotter.NewQQSamples(x, y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := plotter.XYs{{X: 1, Y: 2}, {X: 2, Y: 4}, {X: 3, Y: 6}, {X: 4, Y: 8}}
+	for i, p := range qq.XYs {
+		if p != want[i] {
+			t.Errorf("unexpected quantile pair %d: got:%v want:%v", i, p, want[i])
+		}
+	}
+	if qq.Slope != 2 || qq.Intercept != 0 {
+		t.Errorf("unexpected reference line: got:y=%v+%v×x want:y=0+2×x", qq.Intercept, qq.Slope)
+	}
+
+	// The values of the smaller sample are paired with
+	// interpolated quantiles of the larger sample.
+	qq, err = plotter.NewQQSamples(plotter.Values{0, 1, 2, 3, 4, 5, 6, 7}, plotter.Values{10, 20})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = plotter.XYs{{X: 1.5, Y: 10}, {X: 5.5, Y: 20}}
+	if len(qq.XYs) != len(want) {
+		t.Fatalf("unexpected number of quantile pairs: got:%d want:%d", len(qq.XYs), len(want))
+	}
+	for i, p := range qq.XYs {
+		if p != want[i] {
+			t.Errorf("unexpected quantile pair %d: got:%v want:%v", i, p, want[i])
+		}
+	}
+
+	if _, err := plotter.NewQQSamples(plotter.Values{}, y); err != plotter.ErrNoData {
+		t.Errorf("unexpected error for an empty sample: got:%v want:%v", err, plotter.ErrNoData)
+	}
+}
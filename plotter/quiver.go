@@ -0,0 +1,217 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Quiver implements the Plotter interface, drawing the
+// vectors of the values in the FieldXY field as arrows
+// centered on the points of the grid, with a length
+// proportional to the magnitude of the vectors.
+type Quiver struct {
+	FieldXY FieldXY
+
+	// Scale is the length of the arrow of a vector of
+	// unit magnitude. If Scale is zero, the arrows are
+	// scaled so that the longest arrow spans the smallest
+	// distance between neighbouring points of the grid.
+	Scale vg.Length
+
+	// HeadLength is the length of the arrowheads.
+	// The head of an arrow shorter than HeadLength
+	// is as long as the arrow.
+	HeadLength vg.Length
+
+	// LineStyle is the style of the arrows. Vectors
+	// of zero magnitude are drawn as dots with a radius
+	// of the width of the line.
+	draw.LineStyle
+
+	// ColorMap, if not nil, is used to color the arrows
+	// according to the magnitude of their vectors,
+	// instead of the color of the LineStyle. Magnitudes
+	// outside the range of the ColorMap are clamped to it.
+	ColorMap palette.ColorMap
+
+	// min and max are the range of the magnitudes
+	// of the finite vectors of the field.
+	min, max float64
+}
+
+// quiverHeadAngle is the angle between the shaft
+// and the barbs of the arrows of a Quiver.
+const quiverHeadAngle = math.Pi / 8
+
+// NewQuiver creates a new quiver plotter of the field.
+func NewQuiver(f FieldXY) *Quiver {
+	min, max := math.Inf(1), math.Inf(-1)
+	c, r := f.Dims()
+	for i := 0; i < c; i++ {
+		for j := 0; j < r; j++ {
+			v := f.Vector(i, j)
+			d := math.Hypot(v.X, v.Y)
+			if math.IsNaN(d) || math.IsInf(d, 0) {
+				continue
+			}
+			min = math.Min(min, d)
+			max = math.Max(max, d)
+		}
+	}
+
+	return &Quiver{
+		FieldXY:    f,
+		HeadLength: vg.Points(4),
+		LineStyle:  DefaultLineStyle,
+		min:        min,
+		max:        max,
+	}
+}
+
+// MagnitudeRange returns the minimum and maximum
+// magnitudes of the finite vectors of the field.
+// It can be used to set the range of the ColorMap.
+func (q *Quiver) MagnitudeRange() (min, max float64) {
+	return q.min, q.max
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+// Vectors with non-finite components are not drawn.
+func (q *Quiver) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+
+	// length returns the length of the arrow of
+	// a vector of magnitude m.
+	length := func(m float64) vg.Length { return q.Scale * vg.Length(m) }
+	if q.Scale == 0 {
+		// Scale relative to the largest magnitude so that
+		// fields with very large or very small magnitudes
+		// do not overflow.
+		d := q.spacing(trX, trY)
+		length = func(m float64) vg.Length { return d * vg.Length(m/q.max) }
+	}
+
+	cols, rows := q.FieldXY.Dims()
+	for i := 0; i < cols; i++ {
+		for j := 0; j < rows; j++ {
+			pt := vg.Point{X: trX(q.FieldXY.X(i)), Y: trY(q.FieldXY.Y(j))}
+			if !c.Contains(pt) {
+				continue
+			}
+			v := q.FieldXY.Vector(i, j)
+			m := math.Hypot(v.X, v.Y)
+			if math.IsNaN(m) || math.IsInf(m, 0) {
+				continue
+			}
+
+			sty := q.LineStyle
+			if q.ColorMap != nil {
+				col, err := q.ColorMap.At(math.Max(q.ColorMap.Min(), math.Min(q.ColorMap.Max(), m)))
+				if err != nil {
+					panic(err)
+				}
+				sty.Color = col
+			}
+
+			if m == 0 {
+				c.DrawGlyphNoClip(draw.GlyphStyle{
+					Color:  sty.Color,
+					Radius: sty.Width,
+					Shape:  draw.CircleGlyph{},
+				}, pt)
+				continue
+			}
+
+			l := length(m)
+			if math.IsNaN(float64(l)) || math.IsInf(float64(l), 0) {
+				continue
+			}
+			ux, uy := v.X/m, v.Y/m
+			half := vg.Point{X: l * vg.Length(ux) / 2, Y: l * vg.Length(uy) / 2}
+			tail, tip := pt.Sub(half), pt.Add(half)
+			c.StrokeLines(sty, c.ClipLinesXY([]vg.Point{tail, tip})...)
+
+			h := q.HeadLength
+			if h > l {
+				h = l
+			}
+			if h <= 0 {
+				continue
+			}
+			barb := func(a float64) vg.Point {
+				sin, cos := math.Sincos(a)
+				return vg.Point{
+					X: tip.X - h*vg.Length(ux*cos-uy*sin),
+					Y: tip.Y - h*vg.Length(ux*sin+uy*cos),
+				}
+			}
+			head := []vg.Point{barb(quiverHeadAngle), tip, barb(-quiverHeadAngle)}
+			c.StrokeLines(sty, c.ClipLinesXY(head)...)
+		}
+	}
+}
+
+// spacing returns the smallest distance on the canvas
+// between neighbouring points of the grid. A unit length
+// is used along a dimension with a single point.
+func (q *Quiver) spacing(trX, trY func(float64) vg.Length) vg.Length {
+	d := vg.Length(math.Inf(1))
+	update := func(l vg.Length) {
+		l = vg.Length(math.Abs(float64(l)))
+		if l > 0 && l < d {
+			d = l
+		}
+	}
+	grid := func(tr func(float64) vg.Length, n int, at func(int) float64) {
+		if n == 1 {
+			update(tr(at(0)+0.5) - tr(at(0)-0.5))
+			return
+		}
+		for i := 1; i < n; i++ {
+			update(tr(at(i)) - tr(at(i-1)))
+		}
+	}
+	cols, rows := q.FieldXY.Dims()
+	grid(trX, cols, q.FieldXY.X)
+	grid(trY, rows, q.FieldXY.Y)
+	if math.IsInf(float64(d), 1) {
+		return 0
+	}
+	return d
+}
+
+// DataRange implements the DataRange method
+// of the plot.DataRanger interface.
+func (q *Quiver) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return fieldDataRange(q.FieldXY)
+}
+
+// GlyphBoxes implements the GlyphBoxes method
+// of the plot.GlyphBoxer interface. The boxes cover
+// the dots of vectors of zero magnitude.
+func (q *Quiver) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	r := q.LineStyle.Width
+	c, n := q.FieldXY.Dims()
+	b := make([]plot.GlyphBox, 0, c*n)
+	for i := 0; i < c; i++ {
+		for j := 0; j < n; j++ {
+			b = append(b, plot.GlyphBox{
+				X: plt.X.Norm(q.FieldXY.X(i)),
+				Y: plt.Y.Norm(q.FieldXY.Y(j)),
+				Rectangle: vg.Rectangle{
+					Min: vg.Point{X: -r, Y: -r},
+					Max: vg.Point{X: +r, Y: +r},
+				},
+			})
+		}
+	}
+	return b
+}
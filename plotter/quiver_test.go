@@ -0,0 +1,101 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestQuiver(t *testing.T) {
+	cmpimg.CheckPlot(ExampleQuiver, t, "quiver.png")
+}
+
+func TestQuiverArrows(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		fn   func(x, y float64) plotter.XY
+
+		wantArrows, wantDots int
+		wantMin, wantMax     float64
+	}{
+		{
+			name: "rotation",
+			fn: func(x, y float64) plotter.XY {
+				return plotter.XY{X: -y, Y: x}
+			},
+			wantArrows: 14, wantDots: 1,
+			wantMin: 0, wantMax: math.Sqrt(5),
+		},
+		{
+			name: "large",
+			fn: func(x, y float64) plotter.XY {
+				return plotter.XY{X: 1e300 * (x + 3), Y: 1e300}
+			},
+			wantArrows: 15,
+			wantMin:    math.Hypot(1e300, 1e300), wantMax: math.Hypot(5e300, 1e300),
+		},
+		{
+			name: "non-finite",
+			fn: func(x, y float64) plotter.XY {
+				if x == 0 {
+					return plotter.XY{X: math.NaN(), Y: 1}
+				}
+				if y == 0 {
+					return plotter.XY{X: math.Inf(1), Y: 1}
+				}
+				return plotter.XY{X: x, Y: y}
+			},
+			wantArrows: 8,
+			wantMin:    math.Sqrt(2), wantMax: math.Sqrt(5),
+		},
+	} {
+		q := plotter.NewQuiver(field{c: 5, r: 3, fn: test.fn})
+
+		min, max := q.MagnitudeRange()
+		if min != test.wantMin || max != test.wantMax {
+			t.Errorf("unexpected magnitude range for %s: got:[%g, %g] want:[%g, %g]",
+				test.name, min, max, test.wantMin, test.wantMax)
+		}
+		xmin, xmax, ymin, ymax := q.DataRange()
+		if xmin != -2.5 || xmax != 2.5 || ymin != -1.5 || ymax != 1.5 {
+			t.Errorf("unexpected data range for %s: got:[%g, %g]×[%g, %g] want:[-2.5, 2.5]×[-1.5, 1.5]",
+				test.name, xmin, xmax, ymin, ymax)
+		}
+
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		p.X.Min, p.X.Max, p.Y.Min, p.Y.Max = xmin, xmax, ymin, ymax
+
+		var rec recorder.Canvas
+		q.Plot(draw.NewCanvas(&rec, 10*vg.Centimeter, 6*vg.Centimeter), p)
+
+		var strokes, fills int
+		for _, a := range rec.Actions {
+			switch a.(type) {
+			case *recorder.Stroke:
+				strokes++
+			case *recorder.Fill:
+				fills++
+			}
+		}
+		// Each arrow is drawn as a shaft and a head.
+		if got, want := strokes, 2*test.wantArrows; got != want {
+			t.Errorf("unexpected number of strokes for %s: got:%d want:%d", test.name, got, want)
+		}
+		if got, want := fills, test.wantDots; got != want {
+			t.Errorf("unexpected number of dots for %s: got:%d want:%d", test.name, got, want)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"strconv"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Radar implements the Plotter interface, drawing a radar
+// chart centered on the origin: each series is drawn as a
+// closed polygon across spokes, one for each category, at
+// distances from the center given by the values of the
+// series for the categories. All the spokes share the same
+// radial scale, from zero at the center to Max at the end
+// of the spokes.
+//
+// The chart is only drawn as a regular polygon if the X
+// and Y axes of the plot have the same scale. The axes of
+// radar charts are usually hidden with plot.HideAxes.
+type Radar struct {
+	// Categories are the labels of the spokes.
+	Categories []string
+
+	// Series are copies of the values of the series, each
+	// holding a value for each category.
+	Series []Values
+
+	// Names are the names of the series, for the
+	// legend entries of their Thumbnailers.
+	Names []string
+
+	// Colors are the colors of the outlines of the
+	// polygons of the series, used in turn.
+	Colors []color.Color
+
+	// FillOpacity is the opacity, from zero to one, of
+	// the colors filling the polygons of the series.
+	// The polygons are not filled if FillOpacity is zero.
+	FillOpacity float64
+
+	// LineStyle is the style of the outlines of the
+	// polygons, drawn in the Colors of the series.
+	// Use zero width to disable outlines.
+	LineStyle draw.LineStyle
+
+	// Max is the value at the end of the spokes.
+	Max float64
+
+	// Ticker returns the values of the rings of the grid
+	// drawn across the spokes. Rings are drawn at the major
+	// ticks between zero and Max, and at Max.
+	Ticker plot.Ticker
+
+	// GridStyle is the style of the spokes and the rings.
+	GridStyle draw.LineStyle
+
+	// StartAngle is the angle in radians,
+	// counter-clockwise from the positive X
+	// axis, of the spoke of the first category.
+	StartAngle float64
+
+	// Clockwise specifies whether the spokes are
+	// laid out clockwise from the StartAngle,
+	// instead of counter-clockwise.
+	Clockwise bool
+
+	// TextStyle is the style of the labels of the
+	// categories and of the rings.
+	TextStyle draw.TextStyle
+
+	// LabelPadding is the distance between the end
+	// of the spokes and the labels of the categories.
+	LabelPadding vg.Length
+}
+
+// NewRadar returns a Radar chart of the named series of values
+// over the given categories, starting at the top of the chart
+// and laid out clockwise. The end of the spokes is at the largest
+// value of the series. The polygons are outlined with colors from
+// a rainbow palette in the default line style, and filled with
+// the same colors at an opacity of one quarter.
+//
+// An error is returned if there are fewer than three categories,
+// if a series does not have a value for each category, if a value
+// is negative, infinite or NaN, if all values are zero, or if the
+// number of names does not match the number of series.
+func NewRadar(categories, names []string, series ...Valuer) (*Radar, error) {
+	if len(categories) < 3 {
+		return nil, errors.New("plotter: radar chart needs at least three categories")
+	}
+	if len(names) != len(series) {
+		return nil, errors.New("plotter: number of names does not match the number of series")
+	}
+	r := &Radar{
+		Categories:  append([]string(nil), categories...),
+		Series:      make([]Values, len(series)),
+		Names:       append([]string(nil), names...),
+		Colors:      stackColors(len(series)),
+		FillOpacity: 0.25,
+		LineStyle:   DefaultLineStyle,
+		Ticker:      plot.DefaultTicks{},
+		GridStyle:   DefaultGridLineStyle,
+		StartAngle:  math.Pi / 2,
+		Clockwise:   true,
+	}
+	for i, vs := range series {
+		values, err := CopyValues(vs)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) != len(categories) {
+			return nil, errors.New("plotter: number of values does not match the number of categories")
+		}
+		for _, v := range values {
+			if v < 0 {
+				return nil, errors.New("plotter: negative radar value")
+			}
+			r.Max = math.Max(r.Max, v)
+		}
+		r.Series[i] = values
+	}
+	if r.Max == 0 {
+		return nil, errors.New("plotter: radar values are all zero")
+	}
+
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
+	r.TextStyle = draw.TextStyle{
+		Color:   color.Black,
+		Font:    fnt,
+		Handler: plot.DefaultTextHandler,
+	}
+	r.LabelPadding = vg.Points(4)
+	return r, nil
+}
+
+// Angle returns the angle in radians, counter-clockwise
+// from the positive X axis, of the spoke of the ith
+// category.
+func (r *Radar) Angle(i int) float64 {
+	dir := 1.0
+	if r.Clockwise {
+		dir = -1
+	}
+	return r.StartAngle + dir*2*math.Pi*float64(i)/float64(len(r.Categories))
+}
+
+// Vertex returns the position, in data coordinates, of
+// the vertex of the polygon of the ith series on the
+// spoke of the jth category.
+func (r *Radar) Vertex(i, j int) XY {
+	sin, cos := math.Sincos(r.Angle(j))
+	v := r.Series[i][j]
+	return XY{X: v * cos, Y: v * sin}
+}
+
+// color returns the outline color of the ith series.
+func (r *Radar) color(i int) color.Color {
+	if len(r.Colors) == 0 {
+		return nil
+	}
+	return r.Colors[i%len(r.Colors)]
+}
+
+// fill returns the fill color of the ith series.
+func (r *Radar) fill(i int) color.Color {
+	c := r.color(i)
+	if c == nil || r.FillOpacity <= 0 {
+		return nil
+	}
+	op := math.Min(r.FillOpacity, 1)
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	n.A = uint8(math.Round(float64(n.A) * op))
+	return n
+}
+
+// Plot draws the Radar, implementing the plot.Plotter interface.
+func (r *Radar) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	at := func(j int, v float64) vg.Point {
+		sin, cos := math.Sincos(r.Angle(j))
+		return vg.Point{X: trX(v * cos), Y: trY(v * sin)}
+	}
+	n := len(r.Categories)
+
+	// The grid is drawn first so
+	// that it lies under the series.
+	if r.GridStyle.Color != nil && r.GridStyle.Width > 0 {
+		for j := 0; j < n; j++ {
+			c.StrokeLines(r.GridStyle, c.ClipLinesXY([]vg.Point{at(j, 0), at(j, r.Max)})...)
+		}
+		for _, t := range r.rings() {
+			ring := make([]vg.Point, n+1)
+			for j := range ring {
+				ring[j] = at(j%n, t.Value)
+			}
+			c.StrokeLines(r.GridStyle, c.ClipLinesXY(ring)...)
+		}
+	}
+
+	for i, vs := range r.Series {
+		poly := make([]vg.Point, n)
+		for j, v := range vs {
+			poly[j] = at(j, v)
+		}
+		if col := r.fill(i); col != nil {
+			c.FillPolygon(col, c.ClipPolygonXY(poly))
+		}
+		if col := r.color(i); col != nil && r.LineStyle.Width > 0 {
+			sty := r.LineStyle
+			sty.Color = col
+			c.StrokeLines(sty, c.ClipLinesXY(append(poly, poly[0]))...)
+		}
+	}
+
+	// The rings are labelled at the middle of their edges
+	// between the first two spokes, clear of the labels
+	// of the categories.
+	sty := r.TextStyle
+	sty.XAlign = draw.XCenter
+	sty.YAlign = draw.YCenter
+	for _, t := range r.rings() {
+		a, b := at(0, t.Value), at(1, t.Value)
+		if pt := (vg.Point{X: (a.X + b.X) / 2, Y: (a.Y + b.Y) / 2}); c.Contains(pt) {
+			c.FillText(sty, pt, t.Label)
+		}
+	}
+	for j, cat := range r.Categories {
+		sty, off := r.categoryLabel(j)
+		c.FillText(sty, at(j, r.Max).Add(off), cat)
+	}
+}
+
+// rings returns the ticks at the values of the rings of the grid.
+func (r *Radar) rings() []plot.Tick {
+	var ticks []plot.Tick
+	if r.Ticker != nil {
+		for _, t := range r.Ticker.Ticks(0, r.Max) {
+			if t.IsMinor() || t.Value <= 0 || t.Value >= r.Max {
+				continue
+			}
+			ticks = append(ticks, t)
+		}
+	}
+	return append(ticks, plot.Tick{
+		Value: r.Max,
+		Label: strconv.FormatFloat(r.Max, 'g', -1, 64),
+	})
+}
+
+// categoryLabel returns the style of the label of the jth
+// category and its offset from the end of its spoke. The
+// label is aligned so that it extends away from the chart.
+func (r *Radar) categoryLabel(j int) (draw.TextStyle, vg.Point) {
+	sin, cos := math.Sincos(r.Angle(j))
+	sty := r.TextStyle
+	sty.XAlign = draw.XAlignment(-(1 - cos) / 2)
+	sty.YAlign = draw.YAlignment(-(1 - sin) / 2)
+	off := vg.Point{X: r.LabelPadding * vg.Length(cos), Y: r.LabelPadding * vg.Length(sin)}
+	return sty, off
+}
+
+// DataRange returns the extent of the circle through the
+// ends of the spokes, implementing the plot.DataRanger
+// interface.
+func (r *Radar) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return -r.Max, r.Max, -r.Max, r.Max
+}
+
+// GlyphBoxes returns a GlyphBox for the label of each category,
+// implementing the plot.GlyphBoxer interface.
+func (r *Radar) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	bs := make([]plot.GlyphBox, len(r.Categories))
+	for j, cat := range r.Categories {
+		sin, cos := math.Sincos(r.Angle(j))
+		sty, off := r.categoryLabel(j)
+		rect := sty.Rectangle(cat)
+		bs[j].X = plt.X.Norm(r.Max * cos)
+		bs[j].Y = plt.Y.Norm(r.Max * sin)
+		bs[j].Rectangle = vg.Rectangle{Min: rect.Min.Add(off), Max: rect.Max.Add(off)}
+	}
+	return bs
+}
+
+// Thumbnailers returns a plot.Thumbnailer for each series
+// of the chart, in the order of the series, that can be
+// used to add legend entries with the Names.
+func (r *Radar) Thumbnailers() []plot.Thumbnailer {
+	ts := make([]plot.Thumbnailer, len(r.Series))
+	for i := range ts {
+		ts[i] = radarSeries{radar: r, series: i}
+	}
+	return ts
+}
+
+// radarSeries implements the Thumbnailer interface
+// for a series of a radar chart.
+type radarSeries struct {
+	radar  *Radar
+	series int
+}
+
+// Thumbnail satisfies the plot.Thumbnailer interface.
+func (s radarSeries) Thumbnail(c *draw.Canvas) {
+	pts := []vg.Point{
+		{X: c.Min.X, Y: c.Min.Y},
+		{X: c.Min.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Min.Y},
+	}
+	if col := s.radar.fill(s.series); col != nil {
+		c.FillPolygon(col, c.ClipPolygonY(pts))
+	}
+	if col := s.radar.color(s.series); col != nil && s.radar.LineStyle.Width > 0 {
+		sty := s.radar.LineStyle
+		sty.Color = col
+		c.StrokeLines(sty, c.ClipLinesY(append(pts, pts[0]))...)
+	}
+}
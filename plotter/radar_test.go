@@ -0,0 +1,104 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestRadar(t *testing.T) {
+	cmpimg.CheckPlot(ExampleRadar, t, "radarChart.png")
+}
+
+func TestRadarVertices(t *testing.T) {
+	const tol = 1e-12
+	r, err := plotter.NewRadar([]string{"a", "b", "c"}, []string{"s"}, plotter.Values{1, 2, 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r.StartAngle = 0
+	r.Clockwise = false
+	r.GridStyle.Color = nil
+	r.LineStyle.Width = 0
+
+	// The vertices are at 0, 120 and 240 degrees.
+	s3 := math.Sqrt(3)
+	want := []plotter.XY{{X: 1, Y: 0}, {X: -1, Y: s3}, {X: -1.5, Y: -1.5 * s3}}
+	for j, w := range want {
+		got := r.Vertex(0, j)
+		if math.Abs(got.X-w.X) > tol || math.Abs(got.Y-w.Y) > tol {
+			t.Errorf("unexpected vertex %d: got:%v want:%v", j, got, w)
+		}
+	}
+
+	xmin, xmax, ymin, ymax := r.DataRange()
+	if xmin != -3 || xmax != 3 || ymin != -3 || ymax != 3 {
+		t.Errorf("unexpected data range: got:[%g, %g]×[%g, %g] want:[-3, 3]×[-3, 3]", xmin, xmax, ymin, ymax)
+	}
+
+	// Draw on a 60×60 canvas at 10 points per unit.
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.X.Min, p.X.Max = -3, 3
+	p.Y.Min, p.Y.Max = -3, 3
+	var rec recorder.Canvas
+	r.Plot(draw.NewCanvas(&rec, 60, 60), p)
+
+	var fills []*recorder.Fill
+	for _, a := range rec.Actions {
+		if f, ok := a.(*recorder.Fill); ok {
+			fills = append(fills, f)
+		}
+	}
+	if len(fills) != 1 {
+		t.Fatalf("unexpected number of filled polygons: got:%d want:1", len(fills))
+	}
+	var got []vg.Point
+	for _, c := range fills[0].Path {
+		if c.Type != vg.CloseComp {
+			got = append(got, c.Pos)
+		}
+	}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected number of vertices: got:%d want:%d", len(got), len(want))
+	}
+	for j, w := range want {
+		wx, wy := vg.Length(10*(w.X+3)), vg.Length(10*(w.Y+3))
+		if math.Abs(float64(got[j].X-wx)) > 1e-9 || math.Abs(float64(got[j].Y-wy)) > 1e-9 {
+			t.Errorf("unexpected drawn vertex %d: got:%v want:{%v %v}", j, got[j], wx, wy)
+		}
+	}
+}
+
+func TestNewRadarErrors(t *testing.T) {
+	cats := []string{"a", "b", "c"}
+	for _, test := range []struct {
+		name   string
+		cats   []string
+		names  []string
+		series []plotter.Valuer
+	}{
+		{name: "two categories", cats: cats[:2], names: []string{"s"}, series: []plotter.Valuer{plotter.Values{1, 2}}},
+		{name: "names", cats: cats, names: []string{"s", "t"}, series: []plotter.Valuer{plotter.Values{1, 2, 3}}},
+		{name: "length", cats: cats, names: []string{"s"}, series: []plotter.Valuer{plotter.Values{1, 2}}},
+		{name: "negative", cats: cats, names: []string{"s"}, series: []plotter.Valuer{plotter.Values{1, -2, 3}}},
+		{name: "NaN", cats: cats, names: []string{"s"}, series: []plotter.Valuer{plotter.Values{1, math.NaN(), 3}}},
+		{name: "zero", cats: cats, names: []string{"s"}, series: []plotter.Valuer{plotter.Values{0, 0, 0}}},
+	} {
+		if _, err := plotter.NewRadar(test.cats, test.names, test.series...); err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+}
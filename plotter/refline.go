@@ -0,0 +1,185 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// RefLabelPlacement specifies where the label
+// of a RefLine is drawn.
+type RefLabelPlacement int
+
+const (
+	// RefLabelEnd places the label at the end of the
+	// line, by the right edge of the data area for a
+	// horizontal line, or by the top edge for a vertical
+	// line, above or to the right of the line, or on the
+	// other side of the line if there is no room in the
+	// data area.
+	RefLabelEnd RefLabelPlacement = iota
+
+	// RefLabelStart places the label at the start of
+	// the line, by the left edge of the data area for a
+	// horizontal line, or by the bottom edge for a
+	// vertical line, on the same side of the line as
+	// for RefLabelEnd.
+	RefLabelStart
+
+	// RefLabelAbove places the label at the middle of
+	// the line, above a horizontal line or to the right
+	// of a vertical line.
+	RefLabelAbove
+
+	// RefLabelBelow places the label at the middle of
+	// the line, below a horizontal line or to the left
+	// of a vertical line.
+	RefLabelBelow
+)
+
+// RefLine implements the Plotter interface, drawing a
+// reference line across the whole data area at a value,
+// such as a threshold or a mean, with an optional label.
+type RefLine struct {
+	// Value is the value of the line.
+	Value float64
+
+	// Horizontal dictates whether the line is a horizontal
+	// line at a Y value, instead of a vertical line at an
+	// X value (default).
+	Horizontal bool
+
+	// LineStyle is the style of the line.
+	draw.LineStyle
+
+	// Label is the text of the label of the line.
+	// The label is not drawn if it is empty.
+	Label string
+
+	// LabelStyle is the style of the label.
+	LabelStyle draw.TextStyle
+
+	// Placement is the placement of the label.
+	Placement RefLabelPlacement
+
+	// Padding is the distance between the label and
+	// the line, and between the label and the edges of
+	// the data area, so that the label does not overlap
+	// the axes.
+	Padding vg.Length
+}
+
+// NewRefLine returns a vertical RefLine at the X value v with
+// the given label, placed at the end of the line, using the
+// default line style, the DefaultFont and the DefaultFontSize.
+func NewRefLine(v float64, label string) (*RefLine, error) {
+	if err := CheckFloats(v); err != nil {
+		return nil, err
+	}
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
+	return &RefLine{
+		Value:     v,
+		LineStyle: DefaultLineStyle,
+		Label:     label,
+		LabelStyle: draw.TextStyle{
+			Color:   color.Black,
+			Font:    fnt,
+			Handler: plot.DefaultTextHandler,
+		},
+		Padding: vg.Points(2),
+	}, nil
+}
+
+// Plot draws the RefLine, implementing the plot.Plotter interface.
+func (r *RefLine) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	var start, end vg.Point
+	if r.Horizontal {
+		y := trY(r.Value)
+		if !c.ContainsY(y) {
+			return
+		}
+		start, end = vg.Point{X: c.Min.X, Y: y}, vg.Point{X: c.Max.X, Y: y}
+	} else {
+		x := trX(r.Value)
+		if !c.ContainsX(x) {
+			return
+		}
+		start, end = vg.Point{X: x, Y: c.Min.Y}, vg.Point{X: x, Y: c.Max.Y}
+	}
+	c.StrokeLine2(r.LineStyle, start.X, start.Y, end.X, end.Y)
+
+	if r.Label == "" {
+		return
+	}
+	sty := r.LabelStyle
+	pt, pad := start, r.Padding
+	if r.Horizontal {
+		sty.YAlign = draw.YBottom
+		pt.Y += pad
+		if pt.Y+sty.Height(r.Label) > c.Max.Y && r.Placement != RefLabelAbove {
+			// Keep the label within the data area.
+			sty.YAlign = draw.YTop
+			pt.Y = start.Y - pad
+		}
+		switch r.Placement {
+		case RefLabelEnd:
+			sty.XAlign = draw.XRight
+			pt.X = end.X - pad
+		case RefLabelStart:
+			sty.XAlign = draw.XLeft
+			pt.X += pad
+		case RefLabelAbove, RefLabelBelow:
+			sty.XAlign = draw.XCenter
+			pt.X = (start.X + end.X) / 2
+			if r.Placement == RefLabelBelow {
+				sty.YAlign = draw.YTop
+				pt.Y = start.Y - pad
+			}
+		}
+	} else {
+		sty.XAlign = draw.XLeft
+		pt.X += pad
+		if pt.X+sty.Width(r.Label) > c.Max.X && r.Placement != RefLabelAbove {
+			sty.XAlign = draw.XRight
+			pt.X = start.X - pad
+		}
+		switch r.Placement {
+		case RefLabelEnd:
+			sty.YAlign = draw.YTop
+			pt.Y = end.Y - pad
+		case RefLabelStart:
+			sty.YAlign = draw.YBottom
+			pt.Y += pad
+		case RefLabelAbove, RefLabelBelow:
+			sty.YAlign = draw.YCenter
+			pt.Y = (start.Y + end.Y) / 2
+			if r.Placement == RefLabelBelow {
+				sty.XAlign = draw.XRight
+				pt.X = start.X - pad
+			}
+		}
+	}
+	c.FillText(sty, pt, r.Label)
+}
+
+// DataRange returns the value of the line as the range of its
+// axis, implementing the plot.DataRanger interface. The range
+// of the other axis is empty, so that the line does not change
+// it.
+func (r *RefLine) DataRange() (xmin, xmax, ymin, ymax float64) {
+	if r.Horizontal {
+		return math.Inf(1), math.Inf(-1), r.Value, r.Value
+	}
+	return r.Value, r.Value, math.Inf(1), math.Inf(-1)
+}
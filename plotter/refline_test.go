@@ -0,0 +1,120 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestRefLine(t *testing.T) {
+	cmpimg.CheckPlot(ExampleRefLine, t, "refline.png")
+}
+
+func TestRefLineLabel(t *testing.T) {
+	const pad = 2
+	for _, test := range []struct {
+		horizontal bool
+		placement  plotter.RefLabelPlacement
+
+		// want returns whether the label with the given
+		// bounds is at the expected place, for a line at 40
+		// on a 100×100 canvas.
+		want func(r vg.Rectangle) bool
+	}{
+		{
+			horizontal: true,
+			placement:  plotter.RefLabelStart,
+			want: func(r vg.Rectangle) bool {
+				return r.Min.X == pad && r.Min.Y == 40+pad
+			},
+		},
+		{
+			horizontal: true,
+			placement:  plotter.RefLabelEnd,
+			want: func(r vg.Rectangle) bool {
+				return r.Max.X == 100-pad && r.Min.Y == 40+pad
+			},
+		},
+		{
+			horizontal: false,
+			placement:  plotter.RefLabelStart,
+			want: func(r vg.Rectangle) bool {
+				return r.Min.X == 40+pad && r.Min.Y == pad
+			},
+		},
+		{
+			horizontal: false,
+			placement:  plotter.RefLabelEnd,
+			want: func(r vg.Rectangle) bool {
+				return r.Min.X == 40+pad && r.Max.Y == 100-pad
+			},
+		},
+	} {
+		l, err := plotter.NewRefLine(4, "label")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		l.Horizontal = test.horizontal
+		l.Placement = test.placement
+		l.Padding = pad
+
+		xmin, xmax, ymin, ymax := l.DataRange()
+		min, max := xmin, xmax
+		other := []float64{ymin, ymax}
+		if test.horizontal {
+			min, max = ymin, ymax
+			other = []float64{xmin, xmax}
+		}
+		if min != 4 || max != 4 {
+			t.Errorf("horizontal=%t: unexpected data range: got:[%v,%v] want:[4,4]", test.horizontal, min, max)
+		}
+		if !math.IsInf(other[0], 1) || !math.IsInf(other[1], -1) {
+			t.Errorf("horizontal=%t: unexpected data range of the other axis: got:%v", test.horizontal, other)
+		}
+
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		p.X.Min, p.X.Max = 0, 10
+		p.Y.Min, p.Y.Max = 0, 10
+		var rec recorder.Canvas
+		l.Plot(draw.NewCanvas(&rec, 100, 100), p)
+
+		var (
+			got   vg.Rectangle
+			found bool
+		)
+		for _, a := range rec.Actions {
+			if s, ok := a.(*recorder.FillString); ok {
+				// The baseline of the text is drawn above the
+				// bottom of its bounds by the font size less
+				// its ascent.
+				sty := l.LabelStyle
+				min := vg.Point{X: s.Point.X, Y: s.Point.Y - sty.Font.Size + sty.Font.Extents().Ascent}
+				got = vg.Rectangle{
+					Min: min,
+					Max: min.Add(vg.Point{X: sty.Width(s.String), Y: sty.Height(s.String)}),
+				}
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("horizontal=%t placement=%d: label not drawn", test.horizontal, test.placement)
+			continue
+		}
+		if !test.want(got) {
+			t.Errorf("horizontal=%t placement=%d: unexpected label bounds: %+v", test.horizontal, test.placement, got)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Rug implements the Plotter interface, drawing a rug
+// plot: a short tick at each of a set of values, along
+// an edge of the data area. Rug plots show the individual
+// values of a distribution, for example beneath a histogram.
+type Rug struct {
+	// Values is a copy of the values of the rug.
+	Values
+
+	// Horizontal dictates whether the values are Y values
+	// marked by horizontal ticks along the left edge of the
+	// data area, instead of X values marked by vertical ticks
+	// along the bottom edge (default).
+	Horizontal bool
+
+	// Length is the length of the ticks.
+	Length vg.Length
+
+	// LineStyle is the style of the ticks.
+	draw.LineStyle
+}
+
+// NewRug returns a rug plot of the given X values, using
+// the default line style with ticks 5 points long.
+func NewRug(vs Valuer) (*Rug, error) {
+	values, err := CopyValues(vs)
+	if err != nil {
+		return nil, err
+	}
+	return &Rug{
+		Values:    values,
+		Length:    vg.Points(5),
+		LineStyle: DefaultLineStyle,
+	}, nil
+}
+
+// Plot implements the Plotter interface, drawing
+// a tick at each value.
+func (r *Rug) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	for _, v := range r.Values {
+		if r.Horizontal {
+			y := trY(v)
+			if !c.ContainsY(y) {
+				continue
+			}
+			c.StrokeLine2(r.LineStyle, c.Min.X, y, c.Min.X+r.Length, y)
+			continue
+		}
+		x := trX(v)
+		if !c.ContainsX(x) {
+			continue
+		}
+		c.StrokeLine2(r.LineStyle, x, c.Min.Y, x, c.Min.Y+r.Length)
+	}
+}
+
+// DataRange implements the plot.DataRanger interface.
+// The range of the other axis is empty, so that the
+// rug does not change it.
+func (r *Rug) DataRange() (xmin, xmax, ymin, ymax float64) {
+	min, max := Range(r.Values)
+	if r.Horizontal {
+		return math.Inf(1), math.Inf(-1), min, max
+	}
+	return min, max, math.Inf(1), math.Inf(-1)
+}
@@ -0,0 +1,77 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestRug(t *testing.T) {
+	cmpimg.CheckPlot(ExampleRug, t, "rug.png")
+}
+
+func TestRugTicks(t *testing.T) {
+	for _, horizontal := range []bool{false, true} {
+		r, err := plotter.NewRug(plotter.Values{1, 2.5, 4, 12})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		r.Horizontal = horizontal
+		r.Length = 3
+
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		p.Add(r)
+		xmin, xmax, ymin, ymax := r.DataRange()
+		min, max := xmin, xmax
+		other := []float64{ymin, ymax}
+		if horizontal {
+			min, max = ymin, ymax
+			other = []float64{xmin, xmax}
+		}
+		if min != 1 || max != 12 {
+			t.Errorf("horizontal=%t: unexpected data range: got:[%v,%v] want:[1,12]", horizontal, min, max)
+		}
+		if !math.IsInf(other[0], 1) || !math.IsInf(other[1], -1) {
+			t.Errorf("horizontal=%t: unexpected data range of the other axis: got:%v", horizontal, other)
+		}
+
+		// Draw on a 100×100 canvas at 10 points per unit,
+		// with the last value out of range.
+		p.X.Min, p.X.Max = 0, 10
+		p.Y.Min, p.Y.Max = 0, 10
+		var rec recorder.Canvas
+		r.Plot(draw.NewCanvas(&rec, 100, 100), p)
+
+		var got [][2]vg.Point
+		for _, a := range rec.Actions {
+			if s, ok := a.(*recorder.Stroke); ok {
+				got = append(got, [2]vg.Point{s.Path[0].Pos, s.Path[1].Pos})
+			}
+		}
+		var want [][2]vg.Point
+		for _, v := range []vg.Length{10, 25, 40} {
+			tick := [2]vg.Point{{X: v, Y: 0}, {X: v, Y: 3}}
+			if horizontal {
+				tick = [2]vg.Point{{X: 0, Y: v}, {X: 3, Y: v}}
+			}
+			want = append(want, tick)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("horizontal=%t: unexpected ticks:\ngot: %v\nwant:%v", horizontal, got, want)
+		}
+	}
+}
@@ -5,6 +5,8 @@
 package plotter
 
 import (
+	"golang.org/x/exp/rand"
+
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
@@ -20,9 +22,38 @@ type Scatter struct {
 	// for individual points
 	GlyphStyleFunc func(int) draw.GlyphStyle
 
+	// LinkFunc, if not nil, specifies hyperlinks
+	// for individual points. The glyph of each point
+	// for which LinkFunc returns a non-empty URL is made
+	// a hyperlink to the URL on canvases supporting
+	// hyperlinks, see vg.Linker.
+	LinkFunc func(int) string
+
+	// TooltipFunc, if not nil, specifies tooltips
+	// for individual points. The glyph of each point
+	// for which TooltipFunc returns non-empty text is
+	// given the text as a tooltip on canvases supporting
+	// tooltips, see vg.Tooltipper.
+	TooltipFunc func(int) string
+
 	// GlyphStyle is the style of the glyphs drawn
 	// at each point.
 	draw.GlyphStyle
+
+	// Jitter is the width of the band, centered on
+	// each point, within which the glyph of the point
+	// is randomly displaced horizontally. Jitter helps
+	// to distinguish points sharing the same X value,
+	// as in categorical plots. The data range of the
+	// Scatter is not changed by the jitter.
+	// If Jitter is zero, points are not displaced.
+	Jitter vg.Length
+
+	// JitterSeed is the seed of the random source used
+	// to compute the jitter offsets, so that the points
+	// are displaced in the same way each time the
+	// Scatter is drawn.
+	JitterSeed uint64
 }
 
 // NewScatter returns a Scatter that uses the
@@ -46,9 +77,49 @@ func (pts *Scatter) Plot(c draw.Canvas, plt *plot.Plot) {
 	if pts.GlyphStyleFunc != nil {
 		glyph = pts.GlyphStyleFunc
 	}
+	offsets := pts.jitter()
 	for i, p := range pts.XYs {
-		c.DrawGlyph(glyph(i), vg.Point{X: trX(p.X), Y: trY(p.Y)})
+		pt := vg.Point{X: trX(p.X) + offsets[i], Y: trY(p.Y)}
+		sty := glyph(i)
+		pts.drawGlyph(c, i, sty, pt)
+		if pts.LinkFunc == nil || !c.Contains(pt) {
+			continue
+		}
+		if url := pts.LinkFunc(i); url != "" {
+			r := vg.Point{X: sty.Radius, Y: sty.Radius}
+			c.Link(vg.Rectangle{Min: pt.Sub(r), Max: pt.Add(r)}, url)
+		}
+	}
+}
+
+// drawGlyph draws the glyph of the ith point,
+// with its tooltip if it has one.
+func (pts *Scatter) drawGlyph(c draw.Canvas, i int, sty draw.GlyphStyle, pt vg.Point) {
+	var tip string
+	if pts.TooltipFunc != nil {
+		tip = pts.TooltipFunc(i)
+	}
+	if tip == "" {
+		c.DrawGlyph(sty, pt)
+		return
+	}
+	c.Push()
+	c.Tooltip(tip)
+	c.DrawGlyph(sty, pt)
+	c.Pop()
+}
+
+// jitter returns the horizontal offset of the glyph of each point.
+func (pts *Scatter) jitter() []vg.Length {
+	offsets := make([]vg.Length, len(pts.XYs))
+	if pts.Jitter == 0 {
+		return offsets
 	}
+	rnd := rand.New(rand.NewSource(pts.JitterSeed))
+	for i := range offsets {
+		offsets[i] = pts.Jitter * vg.Length(rnd.Float64()-0.5)
+	}
+	return offsets
 }
 
 // DataRange returns the minimum and maximum
@@ -70,9 +141,13 @@ func (pts *Scatter) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
 		bs[i].X = plt.X.Norm(p.X)
 		bs[i].Y = plt.Y.Norm(p.Y)
 		r := glyph(i).Radius
+		j := pts.Jitter / 2
+		if j < 0 {
+			j = -j
+		}
 		bs[i].Rectangle = vg.Rectangle{
-			Min: vg.Point{X: -r, Y: -r},
-			Max: vg.Point{X: +r, Y: +r},
+			Min: vg.Point{X: -r - j, Y: -r},
+			Max: vg.Point{X: +r + j, Y: +r},
 		}
 	}
 	return bs
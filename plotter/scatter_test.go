@@ -5,11 +5,140 @@
 package plotter_test
 
 import (
+	"math"
+	"reflect"
 	"testing"
 
+	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 func TestScatter(t *testing.T) {
 	cmpimg.CheckPlot(ExampleScatter, t, "scatter.png")
 }
+
+func TestScatterJitter(t *testing.T) {
+	// centers returns the centers of the glyphs
+	// drawn for the given scatter.
+	centers := func(s *plotter.Scatter) []vg.Point {
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("could not create plot: %v", err)
+		}
+		p.HideAxes()
+		p.X.Min, p.X.Max = -1, 3
+		p.Y.Min, p.Y.Max = -1, 3
+		p.Add(s)
+
+		var c recorder.Canvas
+		p.Draw(draw.NewCanvas(&c, 100, 100))
+		var pts []vg.Point
+		for _, a := range c.Actions {
+			if s, ok := a.(*recorder.Stroke); ok {
+				for _, comp := range s.Path {
+					if comp.Type == vg.ArcComp {
+						pts = append(pts, comp.Pos)
+					}
+				}
+			}
+		}
+		return pts
+	}
+
+	data := plotter.XYs{{X: 1, Y: 0}, {X: 1, Y: 1}, {X: 1, Y: 1}, {X: 1, Y: 2}}
+	s, err := plotter.NewScatter(data)
+	if err != nil {
+		t.Fatalf("could not create scatter: %v", err)
+	}
+	exact := centers(s)
+	if len(exact) != len(data) {
+		t.Fatalf("unexpected number of glyphs: got:%d want:%d", len(exact), len(data))
+	}
+
+	s.Jitter = 10
+	s.JitterSeed = 1
+	jittered := centers(s)
+	if len(jittered) != len(data) {
+		t.Fatalf("unexpected number of jittered glyphs: got:%d want:%d", len(jittered), len(data))
+	}
+	if got := centers(s); !reflect.DeepEqual(got, jittered) {
+		t.Errorf("jitter offsets not stable:\ngot: %v\nwant:%v", got, jittered)
+	}
+	if jittered[1] == jittered[2] {
+		t.Error("coincident points not separated by jitter")
+	}
+	for i, pt := range jittered {
+		if pt.Y != exact[i].Y {
+			t.Errorf("unexpected vertical offset for point %d: got:%v want:%v", i, pt.Y, exact[i].Y)
+		}
+		if d := pt.X - exact[i].X; d < -s.Jitter/2 || s.Jitter/2 < d {
+			t.Errorf("jitter offset out of bounds for point %d: got:%v", i, d)
+		}
+	}
+
+	xmin, xmax, _, _ := s.DataRange()
+	if xmin != 1 || xmax != 1 {
+		t.Errorf("unexpected X data range with jitter: got:[%v, %v] want:[1, 1]", xmin, xmax)
+	}
+
+	s.Jitter = 0
+	if got := centers(s); !reflect.DeepEqual(got, exact) {
+		t.Errorf("unexpected glyph positions without jitter:\ngot: %v\nwant:%v", got, exact)
+	}
+}
+
+func TestScatterNamedGlyph(t *testing.T) {
+	// star draws a five-pointed star with
+	// its outer points at the radius.
+	var n int
+	star := draw.GlyphDrawerFunc(func(c *draw.Canvas, sty draw.GlyphStyle, pt vg.Point) {
+		n++
+		poly := make([]vg.Point, 10)
+		for i := range poly {
+			r := sty.Radius
+			if i%2 == 1 {
+				r /= 2.5
+			}
+			sin, cos := math.Sincos(math.Pi/2 + float64(i)*math.Pi/5)
+			poly[i] = vg.Point{X: pt.X + r*vg.Length(cos), Y: pt.Y + r*vg.Length(sin)}
+		}
+		c.FillPolygon(sty.Color, poly)
+	})
+	draw.RegisterGlyph("star", star)
+
+	data := plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 2}, {X: 2, Y: 1}}
+	s, err := plotter.NewScatter(data)
+	if err != nil {
+		t.Fatalf("could not create scatter: %v", err)
+	}
+	shape, ok := draw.NamedGlyph("star")
+	if !ok {
+		t.Fatal("star glyph not registered")
+	}
+	s.GlyphStyle.Shape = shape
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	p.Add(s)
+	var c recorder.Canvas
+	p.Draw(draw.NewCanvas(&c, 100, 100))
+
+	if n != len(data) {
+		t.Errorf("unexpected number of star glyphs: got:%d want:%d", n, len(data))
+	}
+	var fills int
+	for _, a := range c.Actions {
+		if f, ok := a.(*recorder.Fill); ok && len(f.Path) == 11 {
+			fills++
+		}
+	}
+	if fills != len(data) {
+		t.Errorf("unexpected number of star fills: got:%d want:%d", fills, len(data))
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Smooth implements the Plotter interface, drawing
+// a line through a smoothed version of a set of
+// points, showing the trend of noisy data.
+type Smooth struct {
+	// XYs are the smoothed points, in increasing
+	// order of X, at the X values of the data.
+	XYs
+
+	// LineStyle is the style of the line.
+	draw.LineStyle
+}
+
+// NewSmooth returns a Smooth drawing the LOESS fit of the
+// points: the value at each X is given by the linear least
+// squares fit of the nearest span fraction of the points,
+// weighted by the tricube of their distance to X relative
+// to the distance of the farthest of them. The span must
+// be in (0, 1]; larger spans give smoother lines.
+//
+// The points do not need to be sorted by X.
+func NewSmooth(xys XYer, span float64) (*Smooth, error) {
+	if !(span > 0 && span <= 1) {
+		return nil, errors.New("plotter: span not in (0, 1]")
+	}
+	data, err := sortedXYs(xys)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(data)
+	q := int(math.Ceil(span * float64(n)))
+	if q < 2 {
+		q = 2
+	}
+	if q > n {
+		q = n
+	}
+	smooth := make(XYs, n)
+	dist := make([]float64, n)
+	for i, p := range data {
+		for j, d := range data {
+			dist[j] = math.Abs(d.X - p.X)
+		}
+		smooth[i] = XY{X: p.X, Y: loess(data, dist, p.X, q)}
+	}
+	return &Smooth{
+		XYs:       smooth,
+		LineStyle: DefaultLineStyle,
+	}, nil
+}
+
+// loess returns the value at x of the weighted linear fit of
+// the q points of data nearest to x, where dist holds the
+// distances of the points to x. The point at x, which is
+// one of the points of data, always has a weight of one.
+func loess(data XYs, dist []float64, x float64, q int) float64 {
+	sorted := append([]float64(nil), dist...)
+	sort.Float64s(sorted)
+	max := sorted[q-1]
+
+	var sw, swx, swy float64
+	w := make([]float64, len(data))
+	for j, d := range dist {
+		switch {
+		case max == 0:
+			if d == 0 {
+				w[j] = 1
+			}
+		case d < max:
+			u := d / max
+			u = 1 - u*u*u
+			w[j] = u * u * u
+		}
+		sw += w[j]
+		swx += w[j] * data[j].X
+		swy += w[j] * data[j].Y
+	}
+	mx, my := swx/sw, swy/sw
+
+	var sxx, sxy float64
+	for j, p := range data {
+		dx := p.X - mx
+		sxx += w[j] * dx * dx
+		sxy += w[j] * dx * (p.Y - my)
+	}
+	if sxx == 0 {
+		return my
+	}
+	return my + sxy/sxx*(x-mx)
+}
+
+// NewMovingAverage returns a Smooth drawing the moving
+// average of the points: the value at each X is the mean
+// of the Y values of the window points centered on it,
+// sorted by X. The window is shortened at the ends of
+// the data. The window must be at least one.
+//
+// The points do not need to be sorted by X.
+func NewMovingAverage(xys XYer, window int) (*Smooth, error) {
+	if window < 1 {
+		return nil, errors.New("plotter: window less than one")
+	}
+	data, err := sortedXYs(xys)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(data)
+	smooth := make(XYs, n)
+	for i, p := range data {
+		lo := i - (window-1)/2
+		hi := lo + window
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > n {
+			hi = n
+		}
+		var sum float64
+		for _, d := range data[lo:hi] {
+			sum += d.Y
+		}
+		smooth[i] = XY{X: p.X, Y: sum / float64(hi-lo)}
+	}
+	return &Smooth{
+		XYs:       smooth,
+		LineStyle: DefaultLineStyle,
+	}, nil
+}
+
+// sortedXYs returns a copy of the points, sorted by X.
+func sortedXYs(xys XYer) (XYs, error) {
+	data, err := CopyXYs(xys)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].X < data[j].X
+	})
+	return data, nil
+}
+
+// Plot draws the Smooth, implementing the plot.Plotter interface.
+func (s *Smooth) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	ps := make([]vg.Point, len(s.XYs))
+	for i, p := range s.XYs {
+		ps[i] = vg.Point{X: trX(p.X), Y: trY(p.Y)}
+	}
+	c.StrokeLines(s.LineStyle, c.ClipLinesXY(ps)...)
+}
+
+// DataRange returns the minimum and maximum x and y values
+// of the smoothed points, implementing the plot.DataRanger
+// interface.
+func (s *Smooth) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return XYRange(s)
+}
+
+// Thumbnail draws a line in the style of the
+// Smooth, implementing the plot.Thumbnailer
+// interface.
+func (s *Smooth) Thumbnail(c *draw.Canvas) {
+	y := c.Center().Y
+	c.StrokeLine2(s.LineStyle, c.Min.X, y, c.Max.X, y)
+}
@@ -0,0 +1,93 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestSmooth(t *testing.T) {
+	cmpimg.CheckPlot(ExampleSmooth, t, "smooth.png")
+}
+
+func TestSmoothLOESS(t *testing.T) {
+	// At X=2 the span covers the whole data, and the
+	// farthest points at X=0 and X=4 have no weight.
+	// The fit is symmetric, so its value is the mean
+	// of the Y values weighted by the tricube of the
+	// distances 0, 1 and 1.
+	s, err := plotter.NewSmooth(plotter.XYs{
+		{X: 3, Y: 1}, {X: 0, Y: 0}, {X: 4, Y: 0}, {X: 2, Y: 0}, {X: 1, Y: 1},
+	}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, p := range s.XYs {
+		if p.X != float64(i) {
+			t.Errorf("unexpected X of smoothed point %d: got:%v want:%d", i, p.X, i)
+		}
+	}
+	const w = 343.0 / 512 // (1 - (1/2)³)³
+	if got, want := s.XYs[2].Y, 2*w/(1+2*w); !floats.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+		t.Errorf("unexpected LOESS value at X=2: got:%v want:%v", got, want)
+	}
+	// The values are symmetric about X=2.
+	for i := 0; i < 2; i++ {
+		if a, b := s.XYs[i].Y, s.XYs[4-i].Y; !floats.EqualWithinAbsOrRel(a, b, 1e-12, 1e-12) {
+			t.Errorf("asymmetric LOESS values at X=%d and X=%d: %v and %v", i, 4-i, a, b)
+		}
+	}
+
+	// A local linear fit reproduces a line.
+	var line plotter.XYs
+	for i := 0; i < 20; i++ {
+		x := float64((i * 7) % 20)
+		line = append(line, plotter.XY{X: x, Y: 3*x - 2})
+	}
+	s, err = plotter.NewSmooth(line, 0.3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, p := range s.XYs {
+		if !floats.EqualWithinAbsOrRel(p.Y, 3*p.X-2, 1e-9, 1e-9) {
+			t.Errorf("unexpected LOESS value of a line at X=%v: got:%v want:%v", p.X, p.Y, 3*p.X-2)
+		}
+	}
+	xmin, xmax, ymin, ymax := s.DataRange()
+	if xmin != 0 || xmax != 19 || !floats.EqualWithinAbsOrRel(ymin, -2, 1e-9, 1e-9) || !floats.EqualWithinAbsOrRel(ymax, 55, 1e-9, 1e-9) {
+		t.Errorf("unexpected data range: got:[%v, %v]x[%v, %v] want:[0, 19]x[-2, 55]", xmin, xmax, ymin, ymax)
+	}
+
+	for _, span := range []float64{0, -1, 1.5} {
+		if _, err := plotter.NewSmooth(line, span); err == nil {
+			t.Errorf("expected error for span %v", span)
+		}
+	}
+}
+
+func TestMovingAverage(t *testing.T) {
+	s, err := plotter.NewMovingAverage(plotter.XYs{
+		{X: 4, Y: 8}, {X: 0, Y: 0}, {X: 2, Y: 4}, {X: 1, Y: 2}, {X: 3, Y: 0},
+	}, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := plotter.XYs{
+		{X: 0, Y: 1}, {X: 1, Y: 2}, {X: 2, Y: 2}, {X: 3, Y: 4}, {X: 4, Y: 4},
+	}
+	for i, p := range s.XYs {
+		if p.X != want[i].X || !floats.EqualWithinAbsOrRel(p.Y, want[i].Y, 1e-12, 1e-12) {
+			t.Errorf("unexpected moving average point %d: got:%v want:%v", i, p, want[i])
+		}
+	}
+	if _, err := plotter.NewMovingAverage(want, 0); err == nil {
+		t.Error("expected error for an empty window")
+	}
+}
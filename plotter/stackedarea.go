@@ -0,0 +1,188 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// StackedArea implements the Plotter interface, drawing a stacked
+// area chart. Each series is drawn as a band filling the area between
+// the cumulative sum of the series below it and the cumulative sum
+// including itself.
+type StackedArea struct {
+	// XYs is a copy of the points of each series,
+	// from the bottom to the top of the stack.
+	// All series share the same X values.
+	XYs []XYs
+
+	// Colors are the fill colors of the bands.
+	// A band with a nil color is not filled.
+	Colors []color.Color
+
+	// LineStyle is the style of the line drawn
+	// at the top of each band.
+	// Use zero width to disable lines.
+	draw.LineStyle
+}
+
+// NewStackedArea returns a StackedArea for the given series, stacked
+// in the order they are given. The bands are filled with colors from
+// a rainbow palette and drawn without outline.
+//
+// An error is returned if the series do not have the same X values.
+func NewStackedArea(xys ...XYer) (*StackedArea, error) {
+	data, err := copyStack(xys)
+	if err != nil {
+		return nil, err
+	}
+	sty := DefaultLineStyle
+	sty.Width = 0
+
+	return &StackedArea{
+		XYs:       data,
+		Colors:    stackColors(len(data)),
+		LineStyle: sty,
+	}, nil
+}
+
+// copyStack returns a copy of the series of a stack,
+// or an error if they do not have the same X values.
+func copyStack(xys []XYer) ([]XYs, error) {
+	if len(xys) == 0 {
+		return nil, ErrNoData
+	}
+	data := make([]XYs, len(xys))
+	for i, d := range xys {
+		var err error
+		data[i], err = CopyXYs(d)
+		if err != nil {
+			return nil, err
+		}
+		if len(data[i]) != len(data[0]) {
+			return nil, errors.New("plotter: stacked series have different lengths")
+		}
+		for j, p := range data[i] {
+			if p.X != data[0][j].X {
+				return nil, errors.New("plotter: stacked series have different X values")
+			}
+		}
+	}
+	return data, nil
+}
+
+// stackColors returns n colors from a rainbow
+// palette for the bands of a stack.
+func stackColors(n int) []color.Color {
+	m := n
+	if m < 2 {
+		m = 2 // Rainbow needs at least two colors.
+	}
+	return palette.Rainbow(m, palette.Blue, palette.Red, 1, 1, 1).Colors()[:n]
+}
+
+// Top returns the Y value of the top of the ith band
+// at the jth point.
+func (a *StackedArea) Top(i, j int) float64 {
+	var y float64
+	for _, xys := range a.XYs[:i+1] {
+		y += xys[j].Y
+	}
+	return y
+}
+
+// Plot draws the StackedArea, implementing the plot.Plotter interface.
+func (a *StackedArea) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+
+	n := len(a.XYs[0])
+	bottom := make([]vg.Point, n)
+	for j, p := range a.XYs[0] {
+		bottom[j] = vg.Point{X: trX(p.X), Y: trY(0)}
+	}
+
+	tops := make([][]vg.Point, len(a.XYs))
+	for i := range a.XYs {
+		top := make([]vg.Point, n)
+		for j, p := range a.XYs[i] {
+			top[j] = vg.Point{X: trX(p.X), Y: trY(a.Top(i, j))}
+		}
+
+		if i < len(a.Colors) && a.Colors[i] != nil {
+			poly := make([]vg.Point, 0, 2*n)
+			poly = append(poly, top...)
+			for j := n - 1; j >= 0; j-- {
+				poly = append(poly, bottom[j])
+			}
+			c.FillPolygon(a.Colors[i], c.ClipPolygonXY(poly))
+		}
+		tops[i] = top
+		bottom = top
+	}
+
+	if a.LineStyle.Width == 0 {
+		return
+	}
+	for _, top := range tops {
+		c.StrokeLines(a.LineStyle, c.ClipLinesXY(top)...)
+	}
+}
+
+// DataRange returns the minimum and maximum x and y values,
+// implementing the plot.DataRanger interface.
+// The Y range includes the zero baseline of the stack.
+func (a *StackedArea) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, xmax = Range(XValues{a.XYs[0]})
+	ymin, ymax = 0, 0
+	for i := range a.XYs {
+		for j := range a.XYs[i] {
+			y := a.Top(i, j)
+			ymin = math.Min(ymin, y)
+			ymax = math.Max(ymax, y)
+		}
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// Thumbnailers returns a plot.Thumbnailer for each band of the
+// stacked area chart, that can be used to add legend entries.
+func (a *StackedArea) Thumbnailers() []plot.Thumbnailer {
+	ts := make([]plot.Thumbnailer, len(a.XYs))
+	for i := range ts {
+		ts[i] = stackedAreaBand{area: a, band: i}
+	}
+	return ts
+}
+
+// stackedAreaBand implements the Thumbnailer interface for
+// a band of a stacked area chart.
+type stackedAreaBand struct {
+	area *StackedArea
+	band int
+}
+
+// Thumbnail satisfies the plot.Thumbnailer interface.
+func (b stackedAreaBand) Thumbnail(c *draw.Canvas) {
+	pts := []vg.Point{
+		{X: c.Min.X, Y: c.Min.Y},
+		{X: c.Min.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Min.Y},
+	}
+	if b.band < len(b.area.Colors) && b.area.Colors[b.band] != nil {
+		c.FillPolygon(b.area.Colors[b.band], c.ClipPolygonY(pts))
+	}
+	if b.area.LineStyle.Width != 0 {
+		y := c.Max.Y
+		c.StrokeLine2(b.area.LineStyle, c.Min.X, y, c.Max.X, y)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"testing"
+
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestStackedArea(t *testing.T) {
+	cmpimg.CheckPlot(ExampleStackedArea, t, "stackedArea.png")
+}
+
+func TestStackedAreaDataRange(t *testing.T) {
+	a, err := plotter.NewStackedArea(
+		plotter.XYs{{X: 0, Y: 1}, {X: 1, Y: 2}, {X: 2, Y: 3}},
+		plotter.XYs{{X: 0, Y: 4}, {X: 1, Y: 5}, {X: 2, Y: 1}},
+		plotter.XYs{{X: 0, Y: 2}, {X: 1, Y: 2}, {X: 2, Y: 2}},
+	)
+	if err != nil {
+		t.Fatalf("could not create stacked area: %+v", err)
+	}
+	xmin, xmax, ymin, ymax := a.DataRange()
+	if xmin != 0 || xmax != 2 || ymin != 0 || ymax != 9 {
+		t.Errorf("unexpected data range: got (%v, %v, %v, %v), want (0, 2, 0, 9)", xmin, xmax, ymin, ymax)
+	}
+	if got, want := a.Top(1, 2), 4.0; got != want {
+		t.Errorf("unexpected top of band: got %v, want %v", got, want)
+	}
+}
+
+func TestStackedAreaMismatch(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		xys  []plotter.XYer
+	}{
+		{
+			name: "lengths",
+			xys: []plotter.XYer{
+				plotter.XYs{{X: 0, Y: 1}, {X: 1, Y: 2}},
+				plotter.XYs{{X: 0, Y: 1}},
+			},
+		},
+		{
+			name: "x values",
+			xys: []plotter.XYer{
+				plotter.XYs{{X: 0, Y: 1}, {X: 1, Y: 2}},
+				plotter.XYs{{X: 0, Y: 1}, {X: 2, Y: 2}},
+			},
+		},
+	} {
+		_, err := plotter.NewStackedArea(test.xys...)
+		if err == nil {
+			t.Errorf("%s: expected an error for mismatched series", test.name)
+		}
+	}
+}
@@ -5,11 +5,100 @@
 package plotter_test
 
 import (
+	"reflect"
 	"testing"
 
+	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 func TestStep(t *testing.T) {
 	cmpimg.CheckPlot(ExampleLine_stepLine, t, "step.png")
 }
+
+func TestStepPath(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		style plotter.StepKind
+		want  []vg.Point
+	}{
+		{
+			name:  "none",
+			style: plotter.NoStep,
+			want:  []vg.Point{{X: 0, Y: 0}, {X: 50, Y: 100}, {X: 100, Y: 0}},
+		},
+		{
+			name:  "pre",
+			style: plotter.PreStep,
+			want: []vg.Point{
+				{X: 0, Y: 0},
+				{X: 0, Y: 100}, {X: 50, Y: 100},
+				{X: 50, Y: 0}, {X: 100, Y: 0},
+			},
+		},
+		{
+			name:  "mid",
+			style: plotter.MidStep,
+			want: []vg.Point{
+				{X: 0, Y: 0},
+				{X: 25, Y: 0}, {X: 25, Y: 100}, {X: 50, Y: 100},
+				{X: 75, Y: 100}, {X: 75, Y: 0}, {X: 100, Y: 0},
+			},
+		},
+		{
+			name:  "post",
+			style: plotter.PostStep,
+			want: []vg.Point{
+				{X: 0, Y: 0},
+				{X: 50, Y: 0}, {X: 50, Y: 100},
+				{X: 100, Y: 100}, {X: 100, Y: 0},
+			},
+		},
+	} {
+		l, err := plotter.NewLine(plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 0}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		l.StepStyle = test.style
+
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		p.X.Min, p.X.Max = 0, 2
+		p.Y.Min, p.Y.Max = 0, 1
+
+		var rec recorder.Canvas
+		l.Plot(draw.NewCanvas(&rec, 100, 100), p)
+
+		var strokes []*recorder.Stroke
+		for _, a := range rec.Actions {
+			if a, ok := a.(*recorder.Stroke); ok {
+				strokes = append(strokes, a)
+			}
+		}
+		if len(strokes) != 1 {
+			t.Errorf("unexpected number of strokes for %s: got:%d want:1", test.name, len(strokes))
+			continue
+		}
+
+		var got []vg.Point
+		for i, comp := range strokes[0].Path {
+			want := vg.LineComp
+			if i == 0 {
+				want = vg.MoveComp
+			}
+			if comp.Type != want {
+				t.Errorf("unexpected type of path component %d for %s: got:%v want:%v", i, test.name, comp.Type, want)
+			}
+			got = append(got, comp.Pos)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected path for %s:\ngot: %v\nwant:%v", test.name, got, test.want)
+		}
+	}
+}
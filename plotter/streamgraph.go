@@ -0,0 +1,261 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// StreamBaseline specifies the baseline
+// around which a streamgraph is stacked.
+type StreamBaseline int
+
+const (
+	// SymmetricBaseline centers the stack on zero,
+	// as in ThemeRiver.
+	SymmetricBaseline StreamBaseline = iota
+
+	// WiggleBaseline minimizes the sum of the squared
+	// slopes of the boundaries of the bands, following
+	// Byron and Wattenberg, "Stacked Graphs – Geometry
+	// & Aesthetics".
+	WiggleBaseline
+)
+
+// Streamgraph implements the Plotter interface, drawing a
+// streamgraph: a stacked area chart whose baseline moves
+// so that the stack is laid around zero.
+type Streamgraph struct {
+	// XYs is a copy of the points of each series.
+	// All series share the same X values.
+	XYs []XYs
+
+	// Colors are the fill colors of the bands
+	// of the series.
+	// A band with a nil color is not filled.
+	Colors []color.Color
+
+	// Order is the order in which the series are
+	// stacked, from the bottom to the top of the
+	// stream, as indices into XYs. If Order is nil
+	// the series are stacked in the order of XYs.
+	Order []int
+
+	// Baseline is the baseline of the stream.
+	Baseline StreamBaseline
+
+	// LineStyle is the style of the line drawn
+	// at the top of each band.
+	// Use zero width to disable lines.
+	draw.LineStyle
+}
+
+// NewStreamgraph returns a Streamgraph for the given series,
+// stacked around a symmetric baseline in the order they are
+// given. The bands are filled with colors from a rainbow
+// palette and drawn without outline.
+//
+// An error is returned if the series do not have the same X values.
+func NewStreamgraph(xys ...XYer) (*Streamgraph, error) {
+	data, err := copyStack(xys)
+	if err != nil {
+		return nil, err
+	}
+	sty := DefaultLineStyle
+	sty.Width = 0
+
+	return &Streamgraph{
+		XYs:       data,
+		Colors:    stackColors(len(data)),
+		LineStyle: sty,
+	}, nil
+}
+
+// InsideOut sets the Order of the stream so that the series
+// peaking earliest are in the middle of the stream, and later
+// series are added alternately on its top and bottom, keeping
+// the sums of the values of both sides balanced.
+func (s *Streamgraph) InsideOut() {
+	peaks := make([]int, len(s.XYs))
+	sums := make([]float64, len(s.XYs))
+	byPeak := make([]int, len(s.XYs))
+	for i, xys := range s.XYs {
+		for j, p := range xys {
+			if p.Y > xys[peaks[i]].Y {
+				peaks[i] = j
+			}
+			sums[i] += p.Y
+		}
+		byPeak[i] = i
+	}
+	sort.SliceStable(byPeak, func(a, b int) bool {
+		return peaks[byPeak[a]] < peaks[byPeak[b]]
+	})
+
+	var (
+		top, bottom   float64
+		tops, bottoms []int
+	)
+	for _, i := range byPeak {
+		if top < bottom {
+			top += sums[i]
+			tops = append(tops, i)
+		} else {
+			bottom += sums[i]
+			bottoms = append(bottoms, i)
+		}
+	}
+	s.Order = make([]int, 0, len(s.XYs))
+	for k := len(bottoms) - 1; k >= 0; k-- {
+		s.Order = append(s.Order, bottoms[k])
+	}
+	s.Order = append(s.Order, tops...)
+}
+
+// order returns the stacking order of the series.
+func (s *Streamgraph) order() []int {
+	if s.Order != nil {
+		return s.Order
+	}
+	order := make([]int, len(s.XYs))
+	for i := range order {
+		order[i] = i
+	}
+	return order
+}
+
+// Base returns the Y value of the baseline
+// of the stream at the jth point.
+func (s *Streamgraph) Base(j int) float64 {
+	order := s.order()
+	var base float64
+	switch s.Baseline {
+	case WiggleBaseline:
+		n := len(order)
+		for k, i := range order {
+			base -= float64(n-k) * s.XYs[i][j].Y
+		}
+		base /= float64(n + 1)
+	default:
+		for _, i := range order {
+			base -= s.XYs[i][j].Y
+		}
+		base /= 2
+	}
+	return base
+}
+
+// Top returns the Y value of the top of the
+// band of the ith series at the jth point.
+func (s *Streamgraph) Top(i, j int) float64 {
+	y := s.Base(j)
+	for _, k := range s.order() {
+		y += s.XYs[k][j].Y
+		if k == i {
+			break
+		}
+	}
+	return y
+}
+
+// Plot draws the Streamgraph, implementing the plot.Plotter interface.
+func (s *Streamgraph) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+
+	n := len(s.XYs[0])
+	ys := make([]float64, n)
+	bottom := make([]vg.Point, n)
+	for j, p := range s.XYs[0] {
+		ys[j] = s.Base(j)
+		bottom[j] = vg.Point{X: trX(p.X), Y: trY(ys[j])}
+	}
+
+	var tops [][]vg.Point
+	for _, i := range s.order() {
+		top := make([]vg.Point, n)
+		for j, p := range s.XYs[i] {
+			ys[j] += p.Y
+			top[j] = vg.Point{X: trX(p.X), Y: trY(ys[j])}
+		}
+
+		if i < len(s.Colors) && s.Colors[i] != nil {
+			poly := make([]vg.Point, 0, 2*n)
+			poly = append(poly, top...)
+			for j := n - 1; j >= 0; j-- {
+				poly = append(poly, bottom[j])
+			}
+			c.FillPolygon(s.Colors[i], c.ClipPolygonXY(poly))
+		}
+		tops = append(tops, top)
+		bottom = top
+	}
+
+	if s.LineStyle.Width == 0 {
+		return
+	}
+	for _, top := range tops {
+		c.StrokeLines(s.LineStyle, c.ClipLinesXY(top)...)
+	}
+}
+
+// DataRange returns the minimum and maximum x and y values,
+// implementing the plot.DataRanger interface. The Y range
+// is the range of the envelope of the stream.
+func (s *Streamgraph) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, xmax = Range(XValues{s.XYs[0]})
+	ymin, ymax = math.Inf(1), math.Inf(-1)
+	for j := range s.XYs[0] {
+		y := s.Base(j)
+		ymin = math.Min(ymin, y)
+		ymax = math.Max(ymax, y)
+		for _, xys := range s.XYs {
+			y += xys[j].Y
+			ymin = math.Min(ymin, y)
+			ymax = math.Max(ymax, y)
+		}
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// Thumbnailers returns a plot.Thumbnailer for the band of
+// each series of the streamgraph, in the order of XYs, that
+// can be used to add legend entries.
+func (s *Streamgraph) Thumbnailers() []plot.Thumbnailer {
+	ts := make([]plot.Thumbnailer, len(s.XYs))
+	for i := range ts {
+		ts[i] = streamBand{stream: s, band: i}
+	}
+	return ts
+}
+
+// streamBand implements the Thumbnailer interface
+// for a band of a streamgraph.
+type streamBand struct {
+	stream *Streamgraph
+	band   int
+}
+
+// Thumbnail satisfies the plot.Thumbnailer interface.
+func (b streamBand) Thumbnail(c *draw.Canvas) {
+	pts := []vg.Point{
+		{X: c.Min.X, Y: c.Min.Y},
+		{X: c.Min.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Min.Y},
+	}
+	if b.band < len(b.stream.Colors) && b.stream.Colors[b.band] != nil {
+		c.FillPolygon(b.stream.Colors[b.band], c.ClipPolygonY(pts))
+	}
+	if b.stream.LineStyle.Width != 0 {
+		y := c.Max.Y
+		c.StrokeLine2(b.stream.LineStyle, c.Min.X, y, c.Max.X, y)
+	}
+}
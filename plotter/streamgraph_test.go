@@ -0,0 +1,62 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestStreamgraph(t *testing.T) {
+	cmpimg.CheckPlot(ExampleStreamgraph, t, "streamgraph.png")
+}
+
+func TestStreamgraphBaseline(t *testing.T) {
+	series := plotter.XYs{{X: 0, Y: 2}, {X: 1, Y: 4}, {X: 2, Y: 1}}
+	for _, baseline := range []plotter.StreamBaseline{plotter.SymmetricBaseline, plotter.WiggleBaseline} {
+		s, err := plotter.NewStreamgraph(series, series, series)
+		if err != nil {
+			t.Fatalf("could not create streamgraph: %+v", err)
+		}
+		s.Baseline = baseline
+
+		// Three equal series are centered
+		// on zero by both baselines.
+		for j, p := range series {
+			if got, want := s.Base(j), -1.5*p.Y; got != want {
+				t.Errorf("baseline %d: unexpected base at %d: got:%v want:%v", baseline, j, got, want)
+			}
+			if got, want := s.Top(1, j), 0.5*p.Y; got != want {
+				t.Errorf("baseline %d: unexpected top of middle band at %d: got:%v want:%v", baseline, j, got, want)
+			}
+		}
+		xmin, xmax, ymin, ymax := s.DataRange()
+		if xmin != 0 || xmax != 2 || ymin != -6 || ymax != 6 {
+			t.Errorf("baseline %d: unexpected data range: got (%v, %v, %v, %v), want (0, 2, -6, 6)",
+				baseline, xmin, xmax, ymin, ymax)
+		}
+	}
+}
+
+func TestStreamgraphInsideOut(t *testing.T) {
+	s, err := plotter.NewStreamgraph(
+		plotter.XYs{{X: 0, Y: 1}, {X: 1, Y: 1}, {X: 2, Y: 1}, {X: 3, Y: 5}},
+		plotter.XYs{{X: 0, Y: 5}, {X: 1, Y: 1}, {X: 2, Y: 1}, {X: 3, Y: 1}},
+		plotter.XYs{{X: 0, Y: 1}, {X: 1, Y: 1}, {X: 2, Y: 5}, {X: 3, Y: 1}},
+		plotter.XYs{{X: 0, Y: 1}, {X: 1, Y: 5}, {X: 2, Y: 1}, {X: 3, Y: 1}},
+	)
+	if err != nil {
+		t.Fatalf("could not create streamgraph: %+v", err)
+	}
+	s.InsideOut()
+	// The series peak in the order 1, 3, 2, 0, and
+	// are added to the bottom, top, bottom and top.
+	if want := []int{2, 1, 3, 0}; !reflect.DeepEqual(s.Order, want) {
+		t.Errorf("unexpected inside-out order: got:%v want:%v", s.Order, want)
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// KDE is a kernel density estimator of a sample of values.
+type KDE struct {
+	// Sample is the sorted sample from which the density
+	// is estimated.
+	Sample Values
+
+	// Bandwidth is the smoothing parameter of the estimator.
+	// If Bandwidth is zero, it is computed from the sample
+	// using Silverman's rule of thumb.
+	Bandwidth float64
+
+	// Kernel is the kernel function, integrating to one.
+	// If Kernel is nil, the Gaussian kernel is used.
+	Kernel func(u float64) float64
+}
+
+// bandwidth returns the bandwidth of the estimator.
+func (k *KDE) bandwidth() float64 {
+	if k.Bandwidth > 0 {
+		return k.Bandwidth
+	}
+	return silverman(k.Sample)
+}
+
+// Density returns the estimated probability density at x.
+func (k *KDE) Density(x float64) float64 {
+	if len(k.Sample) == 0 {
+		return 0
+	}
+	kern := k.Kernel
+	if kern == nil {
+		kern = gaussianKernel
+	}
+	h := k.bandwidth()
+	var sum float64
+	for _, v := range k.Sample {
+		sum += kern((x - v) / h)
+	}
+	return sum / (float64(len(k.Sample)) * h)
+}
+
+func gaussianKernel(u float64) float64 {
+	return math.Exp(-u*u/2) / math.Sqrt(2*math.Pi)
+}
+
+// silverman returns the bandwidth computed from the sorted values
+// using Silverman's rule of thumb.
+func silverman(sorted Values) float64 {
+	n := float64(len(sorted))
+	if n < 2 {
+		return 1
+	}
+	var mean float64
+	for _, v := range sorted {
+		mean += v
+	}
+	mean /= n
+	var ss float64
+	for _, v := range sorted {
+		ss += (v - mean) * (v - mean)
+	}
+	sd := math.Sqrt(ss / (n - 1))
+
+	spread := sd
+	lo := median(sorted[:len(sorted)/2])
+	hi := median(sorted[len(sorted)/2:])
+	if iqr := (hi - lo) / 1.34; iqr > 0 && iqr < spread {
+		spread = iqr
+	}
+	if spread == 0 {
+		return 1
+	}
+	return 0.9 * spread * math.Pow(n, -0.2)
+}
+
+// Violin implements the Plotter interface, drawing
+// a violin plot to represent the distribution of values.
+// The outline of the violin is the kernel density estimate
+// of the values, mirrored around the violin's location.
+type Violin struct {
+	// Values is a copy of the values used
+	// to create this violin plot.
+	Values
+
+	// Location is the location of the violin along its axis.
+	Location float64
+
+	// KDE is the kernel density estimator used to
+	// compute the outline of the violin.
+	KDE KDE
+
+	// Min and Max are the extreme values of the data.
+	Min, Max float64
+
+	// Cut is the extent of the density beyond the extreme
+	// values of the data, in units of the bandwidth.
+	Cut float64
+
+	// Samples is the number of points at which the
+	// density is evaluated to draw the outline.
+	Samples int
+
+	// Offset is added to the location of the violin.
+	// When the Offset is zero, the violin is drawn
+	// centered at its location.
+	Offset vg.Length
+
+	// Width is the width of the violin where the
+	// density is at its maximum.
+	Width vg.Length
+
+	// LineStyle is the style of the outline of the violin.
+	draw.LineStyle
+
+	// FillColor is the color used to fill the violin.
+	// If FillColor is nil, the violin is not filled.
+	FillColor color.Color
+
+	// Horizontal dictates whether the Violin should be in the vertical
+	// (default) or horizontal direction.
+	Horizontal bool
+}
+
+// NewViolin returns a new Violin that represents the distribution
+// of the given values, at the given location, using a Gaussian
+// kernel density estimate.
+//
+// An error is returned if the violin is created with no values.
+func NewViolin(w vg.Length, loc float64, values Valuer) (*Violin, error) {
+	if w < 0 {
+		return nil, errors.New("plotter: negative violin width")
+	}
+
+	vs, err := CopyValues(values)
+	if err != nil {
+		return nil, err
+	}
+	if len(vs) == 0 {
+		return nil, ErrNoData
+	}
+	sorted := make(Values, len(vs))
+	copy(sorted, vs)
+	sort.Float64s(sorted)
+
+	return &Violin{
+		Values:    vs,
+		Location:  loc,
+		KDE:       KDE{Sample: sorted},
+		Min:       sorted[0],
+		Max:       sorted[len(sorted)-1],
+		Cut:       2,
+		Samples:   100,
+		Width:     w,
+		LineStyle: DefaultLineStyle,
+	}, nil
+}
+
+// extent returns the range of values over which the density is drawn.
+func (v *Violin) extent() (lo, hi float64) {
+	cut := v.Cut * v.KDE.bandwidth()
+	return v.Min - cut, v.Max + cut
+}
+
+// outline returns the half-width of the violin, as a fraction of
+// its width, at regularly spaced values covering its extent.
+func (v *Violin) outline() (vals, widths []float64) {
+	n := v.Samples
+	if n < 2 {
+		n = 2
+	}
+	lo, hi := v.extent()
+	vals = make([]float64, n)
+	widths = make([]float64, n)
+	var max float64
+	for i := range vals {
+		vals[i] = lo + (hi-lo)*float64(i)/float64(n-1)
+		widths[i] = v.KDE.Density(vals[i])
+		max = math.Max(max, widths[i])
+	}
+	if max > 0 {
+		for i := range widths {
+			widths[i] /= 2 * max
+		}
+	}
+	return vals, widths
+}
+
+// Plot draws the Violin on Canvas c and Plot plt.
+func (v *Violin) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+
+	// pt returns the canvas point at the given value
+	// and distance from the center of the violin.
+	// Only the value axis is clipped, as for box plots.
+	var (
+		pt          func(val float64, d vg.Length) vg.Point
+		clipPolygon func([]vg.Point) []vg.Point
+		clipLines   func(...[]vg.Point) [][]vg.Point
+	)
+	if v.Horizontal {
+		y := trY(v.Location)
+		if !c.ContainsY(y) {
+			return
+		}
+		y += v.Offset
+		pt = func(val float64, d vg.Length) vg.Point {
+			return vg.Point{X: trX(val), Y: y + d}
+		}
+		clipPolygon, clipLines = c.ClipPolygonX, c.ClipLinesX
+	} else {
+		x := trX(v.Location)
+		if !c.ContainsX(x) {
+			return
+		}
+		x += v.Offset
+		pt = func(val float64, d vg.Length) vg.Point {
+			return vg.Point{X: x + d, Y: trY(val)}
+		}
+		clipPolygon, clipLines = c.ClipPolygonY, c.ClipLinesY
+	}
+
+	vals, widths := v.outline()
+	pts := make([]vg.Point, 0, 2*len(vals)+1)
+	for i, val := range vals {
+		pts = append(pts, pt(val, vg.Length(widths[i])*v.Width))
+	}
+	for i := len(vals) - 1; i >= 0; i-- {
+		pts = append(pts, pt(vals[i], -vg.Length(widths[i])*v.Width))
+	}
+
+	if v.FillColor != nil {
+		c.FillPolygon(v.FillColor, clipPolygon(pts))
+	}
+	pts = append(pts, pts[0])
+	c.StrokeLines(v.LineStyle, clipLines(pts)...)
+}
+
+// DataRange returns the minimum and maximum x
+// and y values, implementing the plot.DataRanger
+// interface.
+func (v *Violin) DataRange() (xmin, xmax, ymin, ymax float64) {
+	lo, hi := v.extent()
+	if v.Horizontal {
+		return lo, hi, v.Location, v.Location
+	}
+	return v.Location, v.Location, lo, hi
+}
+
+// GlyphBoxes returns a GlyphBox covering the width of
+// the violin, implementing the plot.GlyphBoxer interface.
+func (v *Violin) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	lo, hi := v.extent()
+	mid := (lo + hi) / 2
+	half := v.Width/2 + v.LineStyle.Width/2
+	var b plot.GlyphBox
+	if v.Horizontal {
+		b.X = plt.X.Norm(mid)
+		b.Y = plt.Y.Norm(v.Location)
+		b.Rectangle = vg.Rectangle{
+			Min: vg.Point{Y: v.Offset - half},
+			Max: vg.Point{Y: v.Offset + half},
+		}
+	} else {
+		b.X = plt.X.Norm(v.Location)
+		b.Y = plt.Y.Norm(mid)
+		b.Rectangle = vg.Rectangle{
+			Min: vg.Point{X: v.Offset - half},
+			Max: vg.Point{X: v.Offset + half},
+		}
+	}
+	return []plot.GlyphBox{b}
+}
+
+// Thumbnail draws a rectangle in the given style of the violin,
+// implementing the plot.Thumbnailer interface.
+func (v *Violin) Thumbnail(c *draw.Canvas) {
+	pts := []vg.Point{
+		{X: c.Min.X, Y: c.Min.Y},
+		{X: c.Min.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Min.Y},
+	}
+	if v.FillColor != nil {
+		c.FillPolygon(v.FillColor, c.ClipPolygonY(pts))
+	}
+	pts = append(pts, pts[0])
+	c.StrokeLines(v.LineStyle, c.ClipLinesY(pts)...)
+}
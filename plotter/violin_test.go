@@ -0,0 +1,50 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestViolin(t *testing.T) {
+	cmpimg.CheckPlot(ExampleViolin, t, "verticalViolin.png", "horizontalViolin.png")
+}
+
+func TestKDE(t *testing.T) {
+	v, err := plotter.NewViolin(10, 0, plotter.Values{1, 2, 2, 3, 3, 3, 4, 4, 5})
+	if err != nil {
+		t.Fatalf("could not create violin: %+v", err)
+	}
+
+	for _, bw := range []float64{0, 0.5, 2} {
+		v.KDE.Bandwidth = bw
+		// The estimated density must integrate to one.
+		const (
+			lo, hi = -20.0, 30.0
+			n      = 10000
+		)
+		var sum float64
+		dx := (hi - lo) / n
+		for i := 0; i < n; i++ {
+			sum += v.KDE.Density(lo+(float64(i)+0.5)*dx) * dx
+		}
+		if math.Abs(sum-1) > 1e-6 {
+			t.Errorf("bandwidth=%v: density integrates to %v, want 1", bw, sum)
+		}
+		if got, want := v.KDE.Density(3), v.KDE.Density(5); got <= want {
+			t.Errorf("bandwidth=%v: density at mode %v <= density in tail %v", bw, got, want)
+		}
+	}
+
+	v.KDE.Bandwidth = 1
+	xmin, xmax, ymin, ymax := v.DataRange()
+	if xmin != 0 || xmax != 0 || ymin != 1-v.Cut || ymax != 5+v.Cut {
+		t.Errorf("unexpected data range: got (%v, %v, %v, %v)", xmin, xmax, ymin, ymax)
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// WaterfallStep is a step of a waterfall chart.
+type WaterfallStep struct {
+	// Label is the label of the step.
+	Label string
+
+	// Value is the change of the running total
+	// at the step. It is ignored for totals.
+	Value float64
+
+	// Total specifies whether the step is a total,
+	// drawn as a bar from zero to the running total,
+	// instead of a change of the running total.
+	Total bool
+}
+
+// Waterfall implements the plot.Plotter interface, drawing
+// a waterfall chart: a floating bar for each step, starting
+// where the bar of the previous step ended, so that the bars
+// show how the steps add up to the running total. The ith
+// step is at the X location i, so that the steps can be
+// named with plot.NominalX and the Labels of the steps.
+type Waterfall struct {
+	// Steps is a copy of the steps of the chart.
+	Steps []WaterfallStep
+
+	// Width is the width of the bars.
+	Width vg.Length
+
+	// IncreaseColor, DecreaseColor and TotalColor are
+	// the fill colors of the bars of the steps increasing
+	// and decreasing the running total, and of the totals.
+	IncreaseColor, DecreaseColor, TotalColor color.Color
+
+	// LineStyle is the style of the outline of the bars.
+	draw.LineStyle
+
+	// ConnectorStyle is the style of the lines connecting
+	// the end of each bar to the start of the next bar.
+	// Use zero width to disable the connectors.
+	ConnectorStyle draw.LineStyle
+}
+
+// NewWaterfall returns a waterfall chart of the steps, with
+// bars of the given width. Increases are filled in green,
+// decreases in red and totals in gray, and the bars are
+// connected with thin lines.
+func NewWaterfall(steps []WaterfallStep, width vg.Length) (*Waterfall, error) {
+	if width <= 0 {
+		return nil, errors.New("plotter: width parameter was not positive")
+	}
+	if len(steps) == 0 {
+		return nil, ErrNoData
+	}
+	for _, s := range steps {
+		if err := CheckFloats(s.Value); err != nil {
+			return nil, err
+		}
+	}
+	conn := DefaultLineStyle
+	conn.Width = vg.Points(0.5)
+	return &Waterfall{
+		Steps:          append([]WaterfallStep(nil), steps...),
+		Width:          width,
+		IncreaseColor:  color.NRGBA{R: 0x2c, G: 0xa0, B: 0x2c, A: 0xff},
+		DecreaseColor:  color.NRGBA{R: 0xd6, G: 0x27, B: 0x28, A: 0xff},
+		TotalColor:     color.Gray{Y: 0x80},
+		LineStyle:      DefaultLineStyle,
+		ConnectorStyle: conn,
+	}, nil
+}
+
+// Labels returns the labels of the steps, suitable
+// for use with plot.NominalX.
+func (w *Waterfall) Labels() []string {
+	labels := make([]string, len(w.Steps))
+	for i, s := range w.Steps {
+		labels[i] = s.Label
+	}
+	return labels
+}
+
+// Bars returns the start and the end of the bar of each
+// step. The running total after step i is end[i]. Totals
+// start at zero.
+func (w *Waterfall) Bars() (start, end []float64) {
+	start = make([]float64, len(w.Steps))
+	end = make([]float64, len(w.Steps))
+	var sum float64
+	for i, s := range w.Steps {
+		if s.Total {
+			start[i], end[i] = 0, sum
+			continue
+		}
+		start[i] = sum
+		sum += s.Value
+		end[i] = sum
+	}
+	return start, end
+}
+
+// Plot implements the plot.Plotter interface.
+func (w *Waterfall) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	start, end := w.Bars()
+	for i, s := range w.Steps {
+		x := trX(float64(i))
+		if !c.ContainsX(x) {
+			continue
+		}
+		xmin, xmax := x-w.Width/2, x+w.Width/2
+		y0, y1 := trY(start[i]), trY(end[i])
+
+		clr := w.IncreaseColor
+		switch {
+		case s.Total:
+			clr = w.TotalColor
+		case end[i] < start[i]:
+			clr = w.DecreaseColor
+		}
+		pts := []vg.Point{
+			{X: xmin, Y: y0},
+			{X: xmin, Y: y1},
+			{X: xmax, Y: y1},
+			{X: xmax, Y: y0},
+		}
+		if clr != nil {
+			c.FillPolygon(clr, c.ClipPolygonY(pts))
+		}
+		pts = append(pts, pts[0])
+		c.StrokeLines(w.LineStyle, c.ClipLinesY(pts)...)
+
+		if w.ConnectorStyle.Width == 0 || i == len(w.Steps)-1 {
+			continue
+		}
+		y := trY(end[i])
+		next := trX(float64(i + 1))
+		c.StrokeLines(w.ConnectorStyle, c.ClipLinesXY([]vg.Point{
+			{X: xmax, Y: y},
+			{X: next - w.Width/2, Y: y},
+		})...)
+	}
+}
+
+// DataRange implements the plot.DataRanger interface.
+// The Y range is the range of the running total, and
+// includes zero.
+func (w *Waterfall) DataRange() (xmin, xmax, ymin, ymax float64) {
+	_, end := w.Bars()
+	ymin, ymax = 0, 0
+	for _, v := range end {
+		ymin = math.Min(ymin, v)
+		ymax = math.Max(ymax, v)
+	}
+	return 0, float64(len(w.Steps) - 1), ymin, ymax
+}
+
+// GlyphBoxes implements the plot.GlyphBoxer
+// interface, so that the bars are not clipped.
+func (w *Waterfall) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	boxes := make([]plot.GlyphBox, len(w.Steps))
+	for i := range w.Steps {
+		boxes[i].X = plt.X.Norm(float64(i))
+		boxes[i].Rectangle = vg.Rectangle{
+			Min: vg.Point{X: -w.Width / 2},
+			Max: vg.Point{X: w.Width / 2},
+		}
+	}
+	return boxes
+}
@@ -0,0 +1,56 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestWaterfall(t *testing.T) {
+	cmpimg.CheckPlot(ExampleWaterfall, t, "waterfall.png")
+}
+
+func TestWaterfallBars(t *testing.T) {
+	for _, test := range []struct {
+		steps      []plotter.WaterfallStep
+		start, end []float64
+		ymin, ymax float64
+	}{
+		{
+			steps: []plotter.WaterfallStep{
+				{Value: 5}, {Value: -3}, {Value: 2}, {Total: true},
+			},
+			start: []float64{0, 5, 2, 0},
+			end:   []float64{5, 2, 4, 4},
+			ymin:  0, ymax: 5,
+		},
+		{
+			steps: []plotter.WaterfallStep{
+				{Value: 2}, {Value: -6}, {Total: true}, {Value: 3},
+			},
+			start: []float64{0, 2, 0, -4},
+			end:   []float64{2, -4, -4, -1},
+			ymin:  -4, ymax: 2,
+		},
+	} {
+		w, err := plotter.NewWaterfall(test.steps, 10)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		start, end := w.Bars()
+		if !reflect.DeepEqual(start, test.start) || !reflect.DeepEqual(end, test.end) {
+			t.Errorf("unexpected bars of %v: got:%v-%v want:%v-%v", test.steps, start, end, test.start, test.end)
+		}
+		xmin, xmax, ymin, ymax := w.DataRange()
+		if xmin != 0 || xmax != 3 || ymin != test.ymin || ymax != test.ymax {
+			t.Errorf("unexpected data range of %v: got:(%v, %v, %v, %v) want:(0, 3, %v, %v)",
+				test.steps, xmin, xmax, ymin, ymax, test.ymin, test.ymax)
+		}
+	}
+}
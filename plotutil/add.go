@@ -33,6 +33,8 @@ type item struct {
 // Plots should be added in order of tallest to shortest,
 // because they will be drawn in the order they are added
 // (i.e. later plots will be painted over earlier plots).
+// The values are the tops of the areas; AddStackedArea
+// stacks series by summing their values instead.
 //
 // If an error occurs then none of the plotters are added
 // to the plot, and the error is returned.
@@ -83,6 +85,53 @@ func AddStackedAreaPlots(plt *plot.Plot, xs plotter.Valuer, vs ...interface{}) e
 	return nil
 }
 
+// AddStackedArea adds a plotter.StackedArea to a plot.
+// The variadic arguments must be either strings
+// or plotter.XYers sharing the same X values.
+// Each plotter.XYer adds a band to the stack, on top
+// of the bands added before it, filled using the next
+// color via the Color function. If a plotter.XYer is
+// immediately preceeded by a string then a legend entry
+// is added to the plot using the string as the name.
+//
+// If an error occurs then the stacked area is not added
+// to the plot, and the error is returned.
+func AddStackedArea(plt *plot.Plot, vs ...interface{}) error {
+	var xys []plotter.XYer
+	var names []string
+	name := ""
+	for _, v := range vs {
+		switch t := v.(type) {
+		case string:
+			name = t
+
+		case plotter.XYer:
+			xys = append(xys, t)
+			names = append(names, name)
+			name = ""
+
+		default:
+			panic(fmt.Sprintf("plotutil: AddStackedArea handles strings and plotter.XYers, got %T", t))
+		}
+	}
+
+	a, err := plotter.NewStackedArea(xys...)
+	if err != nil {
+		return err
+	}
+	for i := range a.Colors {
+		a.Colors[i] = Color(i)
+	}
+
+	plt.Add(a)
+	for i, t := range a.Thumbnailers() {
+		if names[i] != "" {
+			plt.Legend.Add(names[i], t)
+		}
+	}
+	return nil
+}
+
 // AddBoxPlots adds box plot plotters to a plot and
 // sets the X axis of the plot to be nominal.
 // The variadic arguments must be either strings
@@ -0,0 +1,82 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotutil_test
+
+import (
+	"image/color"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/plotutil"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestAddStackedArea(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	err = plotutil.AddStackedArea(p,
+		"low", plotter.XYs{{X: 0, Y: 1}, {X: 1, Y: 2}, {X: 2, Y: 1}},
+		plotter.XYs{{X: 0, Y: 2}, {X: 1, Y: 1}, {X: 2, Y: 3}},
+		"high", plotter.XYs{{X: 0, Y: 3}, {X: 1, Y: 4}, {X: 2, Y: 2}},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The top of the stack is the sum of the series.
+	if p.Y.Max != 7 {
+		t.Errorf("unexpected top of the stack: got:%v want:7", p.Y.Max)
+	}
+
+	var r recorder.Canvas
+	p.Legend.Draw(draw.NewCanvas(&r, 10*vg.Centimeter, 10*vg.Centimeter))
+	var (
+		names []string
+		fills []color.Color
+	)
+	for i, a := range r.Actions {
+		switch a := a.(type) {
+		case *recorder.FillString:
+			names = append(names, a.String)
+		case *recorder.SetColor:
+			if i+1 < len(r.Actions) {
+				if _, ok := r.Actions[i+1].(*recorder.Fill); ok {
+					fills = append(fills, a.Color)
+				}
+			}
+		}
+	}
+	wantNames := []string{"low", "high"}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Errorf("unexpected legend entries: got:%q want:%q", names, wantNames)
+	}
+	wantFills := []color.Color{plotutil.Color(0), plotutil.Color(2)}
+	if !reflect.DeepEqual(fills, wantFills) {
+		t.Errorf("unexpected legend colors: got:%v want:%v", fills, wantFills)
+	}
+
+	p, err = plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	err = plotutil.AddStackedArea(p,
+		"a", plotter.XYs{{X: 0, Y: 1}, {X: 1, Y: 2}},
+		"b", plotter.XYs{{X: 0, Y: 1}},
+	)
+	if err == nil {
+		t.Error("expected an error for series of different lengths")
+	}
+	r.Reset()
+	p.Legend.Draw(draw.NewCanvas(&r, 10*vg.Centimeter, 10*vg.Centimeter))
+	if len(r.Actions) != 0 {
+		t.Error("unexpected legend entries added after an error")
+	}
+}
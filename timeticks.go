@@ -0,0 +1,189 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"math"
+	"time"
+)
+
+// timeUnit is a calendar unit of time.
+type timeUnit int
+
+const (
+	second timeUnit = iota
+	minute
+	hour
+	day
+	month
+	year
+)
+
+// seconds returns the approximate length of the unit in seconds.
+func (u timeUnit) seconds() float64 {
+	switch u {
+	case second:
+		return 1
+	case minute:
+		return 60
+	case hour:
+		return 60 * 60
+	case day:
+		return 24 * 60 * 60
+	case month:
+		return 30.436875 * 24 * 60 * 60
+	case year:
+		return 365.2425 * 24 * 60 * 60
+	default:
+		panic("plot: invalid time unit")
+	}
+}
+
+// layout returns the default label layout for the unit.
+func (u timeUnit) layout() string {
+	switch u {
+	case second:
+		return "15:04:05"
+	case minute, hour:
+		return "15:04"
+	case day:
+		return "Jan 2"
+	case month:
+		return "Jan 2006"
+	case year:
+		return "2006"
+	default:
+		panic("plot: invalid time unit")
+	}
+}
+
+// timeStep is an interval between time ticks.
+type timeStep struct {
+	unit timeUnit
+	n    int
+}
+
+// timeSteps are the intervals between time ticks,
+// in increasing order.
+var timeSteps = []timeStep{
+	{second, 1}, {second, 2}, {second, 5}, {second, 10}, {second, 15}, {second, 30},
+	{minute, 1}, {minute, 2}, {minute, 5}, {minute, 10}, {minute, 15}, {minute, 30},
+	{hour, 1}, {hour, 2}, {hour, 3}, {hour, 6}, {hour, 12},
+	{day, 1}, {day, 2}, {day, 7}, {day, 14},
+	{month, 1}, {month, 2}, {month, 3}, {month, 6},
+	{year, 1}, {year, 2}, {year, 5}, {year, 10},
+}
+
+// suggestedTimeTicks is the number of intervals
+// between the calendar ticks of TimeTicks aims for.
+const suggestedTimeTicks = 5
+
+// chooseTimeStep returns the shortest interval that
+// divides the range of d seconds in at most
+// suggestedTimeTicks intervals.
+func chooseTimeStep(d float64) timeStep {
+	for _, s := range timeSteps {
+		if float64(s.n)*s.unit.seconds()*suggestedTimeTicks >= d {
+			return s
+		}
+	}
+	n := 10
+	for float64(n)*year.seconds()*suggestedTimeTicks < d {
+		n *= 10
+	}
+	return timeStep{year, n}
+}
+
+// start returns the first tick time not after t.
+func (s timeStep) start(t time.Time) time.Time {
+	yr, mo, dy := t.Date()
+	hr, mi, sc := t.Clock()
+	loc := t.Location()
+	switch s.unit {
+	case second:
+		return time.Date(yr, mo, dy, hr, mi, sc-sc%s.n, 0, loc)
+	case minute:
+		return time.Date(yr, mo, dy, hr, mi-mi%s.n, 0, 0, loc)
+	case hour:
+		return time.Date(yr, mo, dy, hr-hr%s.n, 0, 0, 0, loc)
+	case day:
+		return time.Date(yr, mo, dy-(dy-1)%s.n, 0, 0, 0, 0, loc)
+	case month:
+		return time.Date(yr, mo-(mo-1)%time.Month(s.n), 1, 0, 0, 0, 0, loc)
+	case year:
+		return time.Date(yr-yr%s.n, 1, 1, 0, 0, 0, 0, loc)
+	default:
+		panic("plot: invalid time unit")
+	}
+}
+
+// add returns the time k intervals after the start time t,
+// computed on the wall clock.
+func (s timeStep) add(t time.Time, k int) time.Time {
+	yr, mo, dy := t.Date()
+	hr, mi, sc := t.Clock()
+	loc := t.Location()
+	n := k * s.n
+	switch s.unit {
+	case second:
+		return time.Date(yr, mo, dy, hr, mi, sc+n, 0, loc)
+	case minute:
+		return time.Date(yr, mo, dy, hr, mi+n, sc, 0, loc)
+	case hour:
+		return time.Date(yr, mo, dy, hr+n, mi, sc, 0, loc)
+	case day:
+		return time.Date(yr, mo, dy+n, hr, mi, sc, 0, loc)
+	case month:
+		return time.Date(yr, mo+time.Month(n), dy, hr, mi, sc, 0, loc)
+	case year:
+		return time.Date(yr+n, mo, dy, hr, mi, sc, 0, loc)
+	default:
+		panic("plot: invalid time unit")
+	}
+}
+
+// calendarTicks returns ticks at calendar intervals between min
+// and max, labelled with the layout, or with a layout suitable for
+// the interval if layout is empty. The ticks are computed on the
+// wall clock of the times returned by conv, which must map values
+// to times in seconds.
+func calendarTicks(min, max float64, conv func(float64) time.Time, layout string) []Tick {
+	step := chooseTimeStep(max - min)
+	if layout == "" {
+		layout = step.unit.layout()
+	}
+
+	// Tick values are offsets in seconds from
+	// a whole value, so that they are exact for
+	// conversions truncating to the second.
+	base := math.Floor(min)
+	origin := conv(base)
+	start := step.start(origin)
+
+	var (
+		ticks []Tick
+		last  = math.Inf(-1)
+	)
+	for k := 0; ; k++ {
+		t := step.add(start, k)
+		v := base + t.Sub(origin).Seconds()
+		if v > max {
+			break
+		}
+		// Wall clock arithmetic yields times out of order
+		// around daylight saving time transitions, when
+		// wall clock times are skipped or repeated.
+		if v < min || v <= last {
+			continue
+		}
+		label := t.Format(layout)
+		if len(ticks) != 0 && ticks[len(ticks)-1].Label == label {
+			continue
+		}
+		ticks = append(ticks, Tick{Value: v, Label: label})
+		last = v
+	}
+	return ticks
+}
@@ -0,0 +1,125 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// unixSeconds returns t as the number of
+// seconds elapsed since January 1, 1970 UTC.
+func unixSeconds(t time.Time) float64 {
+	return float64(t.Unix())
+}
+
+func TestTimeTicksCalendar(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		min, max   time.Time
+		format     string
+		wantLabels []string
+		wantStep   time.Duration
+	}{
+		{
+			name:       "3 hours",
+			min:        time.Date(2020, time.March, 1, 10, 17, 0, 0, time.UTC),
+			max:        time.Date(2020, time.March, 1, 13, 17, 0, 0, time.UTC),
+			wantLabels: []string{"11:00", "12:00", "13:00"},
+			wantStep:   time.Hour,
+		},
+		{
+			name:       "2 years",
+			min:        time.Date(2019, time.February, 10, 0, 0, 0, 0, time.UTC),
+			max:        time.Date(2021, time.February, 10, 0, 0, 0, 0, time.UTC),
+			wantLabels: []string{"Jul 2019", "Jan 2020", "Jul 2020", "Jan 2021"},
+		},
+		{
+			name:       "2 years with format",
+			min:        time.Date(2019, time.February, 10, 0, 0, 0, 0, time.UTC),
+			max:        time.Date(2021, time.February, 10, 0, 0, 0, 0, time.UTC),
+			format:     "2006-01",
+			wantLabels: []string{"2019-07", "2020-01", "2020-07", "2021-01"},
+		},
+	} {
+		ticks := TimeTicks{Format: test.format}.Ticks(unixSeconds(test.min), unixSeconds(test.max))
+		var labels []string
+		for i, tick := range ticks {
+			labels = append(labels, tick.Label)
+			if i > 0 && test.wantStep != 0 {
+				if got := time.Duration(tick.Value-ticks[i-1].Value) * time.Second; got != test.wantStep {
+					t.Errorf("%s: unexpected step: got:%v want:%v", test.name, got, test.wantStep)
+				}
+			}
+		}
+		if !reflect.DeepEqual(labels, test.wantLabels) {
+			t.Errorf("%s: unexpected labels: got:%q want:%q", test.name, labels, test.wantLabels)
+		}
+	}
+
+	// The ticks of a Ticker are labelled
+	// with the RFC 3339 layout by default.
+	min := time.Date(2020, time.March, 1, 10, 17, 0, 0, time.UTC)
+	ticks := TimeTicks{Ticker: ConstantTicks{{Value: unixSeconds(min), Label: "x"}}}.Ticks(0, 1)
+	if len(ticks) != 1 || ticks[0].Label != min.Format(time.RFC3339) {
+		t.Errorf("unexpected ticks of ticker: %v", ticks)
+	}
+}
+
+func TestTimeTicksCalendarDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+	tt := TimeTicks{Time: UnixTimeIn(loc)}
+
+	// Daily ticks stay at midnight across the spring transition.
+	ticks := tt.Ticks(
+		unixSeconds(time.Date(2020, time.March, 4, 12, 0, 0, 0, loc)),
+		unixSeconds(time.Date(2020, time.March, 11, 12, 0, 0, 0, loc)),
+	)
+	if len(ticks) == 0 {
+		t.Fatal("no ticks across spring transition")
+	}
+	for _, tick := range ticks {
+		tm := UTCUnixTime(tick.Value).In(loc)
+		if h, m, s := tm.Clock(); h != 0 || m != 0 || s != 0 {
+			t.Errorf("tick %q not at midnight: %v", tick.Label, tm)
+		}
+	}
+
+	// Ticks are not repeated when the clocks are set back.
+	for _, r := range []struct {
+		min, max time.Time
+		want     int
+	}{
+		{
+			min:  time.Date(2020, time.November, 1, 0, 0, 0, 0, loc),
+			max:  time.Date(2020, time.November, 1, 4, 0, 0, 0, loc),
+			want: 4,
+		},
+		{
+			min:  time.Date(2020, time.November, 1, 0, 50, 0, 0, loc),
+			max:  time.Date(2020, time.November, 1, 2, 10, 0, 0, loc),
+			want: 3,
+		},
+	} {
+		ticks := tt.Ticks(unixSeconds(r.min), unixSeconds(r.max))
+		seen := make(map[string]bool)
+		for i, tick := range ticks {
+			if seen[tick.Label] {
+				t.Errorf("duplicate label %q in %v", tick.Label, ticks)
+			}
+			seen[tick.Label] = true
+			if i > 0 && tick.Value <= ticks[i-1].Value {
+				t.Errorf("ticks not increasing: %v then %v", ticks[i-1].Value, tick.Value)
+			}
+		}
+		if len(ticks) < r.want {
+			t.Errorf("too few ticks across autumn transition: got:%v", ticks)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vg
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// BoundsCanvas is a Canvas that does not draw, but measures the
+// bounding box of its drawing operations instead, such as the
+// extent of a plot drawn on it. It can be used to find the size
+// a canvas needs for a drawing to fit, before drawing it on a
+// canvas of that size.
+//
+// Strokes are measured including the width of the lines, curves
+// by their control points, and text by the outlines of its glyphs,
+// so the bounding box may be slightly larger than the drawing.
+// Clipping paths set by a Clipper are ignored.
+type BoundsCanvas struct {
+	w, h Length
+
+	bounds Rectangle
+	empty  bool
+
+	ctx   boundsContext
+	stack []boundsContext
+}
+
+// boundsContext is the drawing state of a BoundsCanvas.
+type boundsContext struct {
+	m     Matrix
+	width Length
+}
+
+// NewBoundsCanvas returns a BoundsCanvas of the given size,
+// returned by its Size method, with an empty bounding box.
+func NewBoundsCanvas(w, h Length) *BoundsCanvas {
+	return &BoundsCanvas{
+		w: w, h: h,
+		empty: true,
+		ctx:   boundsContext{m: Identity(), width: 1},
+	}
+}
+
+// Bounds returns the bounding box, in the coordinates of the
+// canvas, of everything drawn on the canvas. The returned bool
+// is false if nothing was drawn.
+func (c *BoundsCanvas) Bounds() (Rectangle, bool) {
+	return c.bounds, !c.empty
+}
+
+// Size returns the width and height of the canvas,
+// implementing the CanvasSizer interface.
+func (c *BoundsCanvas) Size() (w, h Length) {
+	return c.w, c.h
+}
+
+// SetLineWidth sets the width of stroked paths.
+func (c *BoundsCanvas) SetLineWidth(w Length) {
+	c.ctx.width = w
+}
+
+// SetLineDash does nothing, the dash pattern
+// does not change the bounds of strokes.
+func (c *BoundsCanvas) SetLineDash(pattern []Length, offset Length) {}
+
+// SetColor does nothing.
+func (c *BoundsCanvas) SetColor(clr color.Color) {}
+
+// Rotate applies a rotation transform to the context.
+func (c *BoundsCanvas) Rotate(rad float64) {
+	c.Transform(Rotation(rad))
+}
+
+// Translate applies a translational transform to the context.
+func (c *BoundsCanvas) Translate(pt Point) {
+	c.Transform(Translation(pt))
+}
+
+// Scale applies a scaling transform to the context.
+func (c *BoundsCanvas) Scale(x, y float64) {
+	c.Transform(Scaling(x, y))
+}
+
+// Transform applies the affine transform m to the context,
+// implementing the Transformer interface.
+func (c *BoundsCanvas) Transform(m Matrix) {
+	c.ctx.m = c.ctx.m.Mul(m)
+}
+
+// Push saves the current line width and
+// transforms onto a stack.
+func (c *BoundsCanvas) Push() {
+	c.stack = append(c.stack, c.ctx)
+}
+
+// Pop restores the context saved by the
+// corresponding call to Push().
+func (c *BoundsCanvas) Pop() {
+	c.ctx = c.stack[len(c.stack)-1]
+	c.stack = c.stack[:len(c.stack)-1]
+}
+
+// Stroke adds the bounds of the stroked
+// path to the bounding box. Paths are not
+// stroked if the line width is not positive.
+func (c *BoundsCanvas) Stroke(p Path) {
+	if c.ctx.width <= 0 {
+		return
+	}
+	c.addPath(p, c.ctx.width/2)
+}
+
+// Fill adds the bounds of the path
+// to the bounding box.
+func (c *BoundsCanvas) Fill(p Path) {
+	c.addPath(p, 0)
+}
+
+// FillString adds the bounds of the glyphs of the text
+// to the bounding box. If the font size is zero, the
+// text is not drawn.
+func (c *BoundsCanvas) FillString(f Font, pt Point, text string) {
+	if f.Size == 0 {
+		return
+	}
+	ext := f.TextExtents(text)
+	if ext.Ascent == 0 && ext.Descent == 0 {
+		return
+	}
+	c.addRect(Rectangle{
+		Min: Point{X: pt.X, Y: pt.Y + ext.Descent},
+		Max: Point{X: pt.X + ext.Width, Y: pt.Y + ext.Ascent},
+	})
+}
+
+// DrawImage adds the destination rectangle
+// to the bounding box.
+func (c *BoundsCanvas) DrawImage(rect Rectangle, img image.Image) {
+	c.addRect(rect)
+}
+
+// addPath adds the points of the path, grown by pad in
+// each direction, to the bounding box.
+func (c *BoundsCanvas) addPath(p Path, pad Length) {
+	add := func(pt Point) {
+		c.addRect(Rectangle{
+			Min: Point{X: pt.X - pad, Y: pt.Y - pad},
+			Max: Point{X: pt.X + pad, Y: pt.Y + pad},
+		})
+	}
+	for _, comp := range p {
+		switch comp.Type {
+		case MoveComp, LineComp:
+			add(comp.Pos)
+		case ArcComp:
+			// The arc is measured by points along it,
+			// close enough for the curvature of the
+			// arcs drawn by plots.
+			const n = 32
+			for i := 0; i <= n; i++ {
+				sin, cos := math.Sincos(comp.Start + comp.Angle*float64(i)/n)
+				add(Point{
+					X: comp.Pos.X + comp.Radius*Length(cos),
+					Y: comp.Pos.Y + comp.Radius*Length(sin),
+				})
+			}
+		case CurveComp:
+			// A Bézier curve lies in the convex
+			// hull of its control points.
+			for _, pt := range comp.Control {
+				add(pt)
+			}
+			add(comp.Pos)
+		}
+	}
+}
+
+// addRect adds the rectangle, in the coordinates of the
+// current context, to the bounding box.
+func (c *BoundsCanvas) addRect(r Rectangle) {
+	for _, pt := range []Point{
+		r.Min,
+		{X: r.Max.X, Y: r.Min.Y},
+		r.Max,
+		{X: r.Min.X, Y: r.Max.Y},
+	} {
+		pt = c.ctx.m.Apply(pt)
+		if c.empty {
+			c.bounds = Rectangle{Min: pt, Max: pt}
+			c.empty = false
+			continue
+		}
+		c.bounds.Min.X = Length(math.Min(float64(c.bounds.Min.X), float64(pt.X)))
+		c.bounds.Min.Y = Length(math.Min(float64(c.bounds.Min.Y), float64(pt.Y)))
+		c.bounds.Max.X = Length(math.Max(float64(c.bounds.Max.X), float64(pt.X)))
+		c.bounds.Max.Y = Length(math.Max(float64(c.bounds.Max.Y), float64(pt.Y)))
+	}
+}
+
+var (
+	_ Canvas      = (*BoundsCanvas)(nil)
+	_ CanvasSizer = (*BoundsCanvas)(nil)
+	_ Transformer = (*BoundsCanvas)(nil)
+)
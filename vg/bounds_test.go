@@ -0,0 +1,77 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vg_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot/vg"
+)
+
+func TestBoundsCanvas(t *testing.T) {
+	c := vg.NewBoundsCanvas(100, 100)
+	if _, ok := c.Bounds(); ok {
+		t.Errorf("unexpected bounds of an empty canvas")
+	}
+
+	var p vg.Path
+	p.Move(vg.Point{X: 10, Y: 20})
+	p.Line(vg.Point{X: 30, Y: 40})
+	c.SetLineWidth(2)
+	c.Stroke(p)
+	if got, ok := c.Bounds(); !ok || got != (vg.Rectangle{Min: vg.Point{X: 9, Y: 19}, Max: vg.Point{X: 31, Y: 41}}) {
+		t.Errorf("unexpected bounds of a stroke: got:%v", got)
+	}
+
+	// Transforms apply to the measured shapes,
+	// and are restored by Pop.
+	c.Push()
+	c.Translate(vg.Point{X: 100, Y: 0})
+	c.Rotate(math.Pi / 2)
+	c.Fill(vg.Rectangle{Max: vg.Point{X: 10, Y: 10}}.Path())
+	c.Pop()
+	c.DrawImage(vg.Rectangle{Min: vg.Point{X: -5, Y: 0}, Max: vg.Point{X: 0, Y: 5}}, nil)
+	got, _ := c.Bounds()
+	want := vg.Rectangle{Min: vg.Point{X: -5, Y: 0}, Max: vg.Point{X: 100, Y: 41}}
+	if math.Abs(float64(got.Min.X-want.Min.X)) > 1e-9 || math.Abs(float64(got.Min.Y-want.Min.Y)) > 1e-9 ||
+		math.Abs(float64(got.Max.X-want.Max.X)) > 1e-9 || math.Abs(float64(got.Max.Y-want.Max.Y)) > 1e-9 {
+		t.Errorf("unexpected bounds: got:%v want:%v", got, want)
+	}
+
+	// Strokes of zero width are not drawn.
+	c.SetLineWidth(0)
+	var far vg.Path
+	far.Move(vg.Point{X: 1000, Y: 1000})
+	far.Line(vg.Point{X: 2000, Y: 2000})
+	c.Stroke(far)
+	if got2, _ := c.Bounds(); got2 != got {
+		t.Errorf("unexpected bounds after a stroke of zero width: got:%v want:%v", got2, got)
+	}
+}
+
+func TestBoundsCanvasText(t *testing.T) {
+	fnt, err := vg.MakeFont("Helvetica", 12)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c := vg.NewBoundsCanvas(100, 100)
+	c.FillString(fnt, vg.Point{X: 10, Y: 50}, "Hg")
+	got, ok := c.Bounds()
+	if !ok {
+		t.Fatal("text not measured")
+	}
+	ext := fnt.TextExtents("Hg")
+	want := vg.Rectangle{
+		Min: vg.Point{X: 10, Y: 50 + ext.Descent},
+		Max: vg.Point{X: 10 + ext.Width, Y: 50 + ext.Ascent},
+	}
+	if got != want {
+		t.Errorf("unexpected bounds of text: got:%v want:%v", got, want)
+	}
+	if got.Min.Y >= 50 || got.Max.Y <= 50 {
+		t.Errorf("text bounds do not straddle the baseline: got:%v", got)
+	}
+}
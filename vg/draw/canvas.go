@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"image/color"
 	"math"
+	"sync"
 
 	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/vgeps"
@@ -72,6 +73,12 @@ type LineStyle struct {
 
 	Dashes   []vg.Length
 	DashOffs vg.Length
+
+	// RelativeDashes specifies whether Dashes and DashOffs
+	// are multiples of the Width of the line, expanded with
+	// vg.ScaleDashes when the line is drawn, instead of
+	// absolute lengths.
+	RelativeDashes bool
 }
 
 // A GlyphStyle specifies the look of a glyph used to draw
@@ -94,6 +101,53 @@ type GlyphDrawer interface {
 	DrawGlyph(*Canvas, GlyphStyle, vg.Point)
 }
 
+// GlyphDrawerFunc is a function drawing a custom glyph, such
+// as a star or an arbitrary path, at the given point with the
+// given style. It implements the GlyphDrawer interface, so it
+// can be used as the Shape of a GlyphStyle.
+type GlyphDrawerFunc func(c *Canvas, sty GlyphStyle, pt vg.Point)
+
+// DrawGlyph implements the GlyphDrawer interface.
+func (f GlyphDrawerFunc) DrawGlyph(c *Canvas, sty GlyphStyle, pt vg.Point) {
+	f(c, sty, pt)
+}
+
+var (
+	glyphLock sync.RWMutex
+
+	// glyphs are the registered glyph shapes.
+	glyphs = map[string]GlyphDrawer{
+		"circle":   CircleGlyph{},
+		"ring":     RingGlyph{},
+		"square":   SquareGlyph{},
+		"box":      BoxGlyph{},
+		"triangle": TriangleGlyph{},
+		"pyramid":  PyramidGlyph{},
+		"plus":     PlusGlyph{},
+		"cross":    CrossGlyph{},
+	}
+)
+
+// RegisterGlyph associates a GlyphDrawer with the given name,
+// so that the glyph can be referenced by name with NamedGlyph,
+// replacing any glyph previously registered with the name.
+func RegisterGlyph(name string, g GlyphDrawer) {
+	glyphLock.Lock()
+	glyphs[name] = g
+	glyphLock.Unlock()
+}
+
+// NamedGlyph returns the GlyphDrawer registered with the given
+// name, and whether a glyph is registered with the name. The
+// glyphs of this package are registered as "circle", "ring",
+// "square", "box", "triangle", "pyramid", "plus" and "cross".
+func NamedGlyph(name string) (GlyphDrawer, bool) {
+	glyphLock.RLock()
+	g, ok := glyphs[name]
+	glyphLock.RUnlock()
+	return g, ok
+}
+
 // DrawGlyph draws the given glyph to the draw
 // area.  If the point is not within the Canvas
 // or the sty.Shape is nil then nothing is drawn.
@@ -260,7 +314,7 @@ func New(c vg.CanvasSizer) Canvas {
 //
 // Supported formats are:
 //
-//  eps, jpg|jpeg, pdf, png, svg, tex and tif|tiff.
+//  eps, jpg|jpeg, pdf, png, svg, tex, tif|tiff and webp.
 func NewFormattedCanvas(w, h vg.Length, format string) (vg.CanvasWriterTo, error) {
 	var c vg.CanvasWriterTo
 	switch format {
@@ -285,6 +339,9 @@ func NewFormattedCanvas(w, h vg.Length, format string) (vg.CanvasWriterTo, error
 	case "tif", "tiff":
 		c = vgimg.TiffCanvas{Canvas: vgimg.New(w, h)}
 
+	case "webp":
+		c = vgimg.WebpCanvas{Canvas: vgimg.New(w, h)}
+
 	default:
 		return nil, fmt.Errorf("unsupported format: %q", format)
 	}
@@ -302,6 +359,316 @@ func NewCanvas(c vg.Canvas, w, h vg.Length) Canvas {
 	}
 }
 
+// Link makes the rectangular region r a hyperlink to url,
+// if the underlying vg.Canvas is a vg.Linker. Otherwise,
+// Link does nothing.
+//
+// Link has a value receiver so that a Canvas is itself a
+// vg.Linker when used as the vg.Canvas of another Canvas.
+func (c Canvas) Link(r vg.Rectangle, url string) {
+	if l, ok := c.Canvas.(vg.Linker); ok {
+		l.Link(r, url)
+	}
+}
+
+// Tooltip attaches the text as a tooltip to everything drawn
+// until the next call to Pop, if the underlying vg.Canvas is a
+// vg.Tooltipper. Otherwise, Tooltip does nothing.
+//
+// Tooltip has a value receiver so that a Canvas is itself a
+// vg.Tooltipper when used as the vg.Canvas of another Canvas.
+func (c Canvas) Tooltip(text string) {
+	if t, ok := c.Canvas.(vg.Tooltipper); ok {
+		t.Tooltip(text)
+	}
+}
+
+// Group groups everything drawn until the next call to Pop,
+// identifying the group by the id and the class names, if
+// the underlying vg.Canvas is a vg.Grouper. Otherwise, Group
+// does nothing.
+//
+// Group has a value receiver so that a Canvas is itself a
+// vg.Grouper when used as the vg.Canvas of another Canvas.
+func (c Canvas) Group(id, class string) {
+	if g, ok := c.Canvas.(vg.Grouper); ok {
+		g.Group(id, class)
+	}
+}
+
+// SetLineCap sets the shape of the ends of stroked paths,
+// if the underlying vg.Canvas is a vg.LineCapJoiner.
+// Otherwise, SetLineCap does nothing.
+//
+// SetLineCap has a value receiver so that a Canvas is itself a
+// vg.LineCapJoiner when used as the vg.Canvas of another Canvas.
+func (c Canvas) SetLineCap(lc vg.LineCap) {
+	if l, ok := c.Canvas.(vg.LineCapJoiner); ok {
+		l.SetLineCap(lc)
+	}
+}
+
+// SetLineJoin sets the shape of the corners of stroked paths,
+// if the underlying vg.Canvas is a vg.LineCapJoiner.
+// Otherwise, SetLineJoin does nothing.
+//
+// SetLineJoin has a value receiver so that a Canvas is itself a
+// vg.LineCapJoiner when used as the vg.Canvas of another Canvas.
+func (c Canvas) SetLineJoin(lj vg.LineJoin) {
+	if l, ok := c.Canvas.(vg.LineCapJoiner); ok {
+		l.SetLineJoin(lj)
+	}
+}
+
+// Clip restricts the subsequent drawing to the interior of
+// the path, until the next call to Pop, if the underlying
+// vg.Canvas is a vg.Clipper. Otherwise, Clip does nothing.
+//
+// Clip has a value receiver so that a Canvas is itself a
+// vg.Clipper when used as the vg.Canvas of another Canvas.
+func (c Canvas) Clip(p vg.Path) {
+	if cl, ok := c.Canvas.(vg.Clipper); ok {
+		cl.Clip(p)
+	}
+}
+
+// Transform applies the affine transform m to the context
+// of the underlying vg.Canvas, see vg.Transform.
+//
+// Transform has a value receiver so that a Canvas is itself a
+// vg.Transformer when used as the vg.Canvas of another Canvas.
+func (c Canvas) Transform(m vg.Matrix) {
+	vg.Transform(c.Canvas, m)
+}
+
+// FillPattern fills the path with the pattern. If the
+// underlying vg.Canvas is a vg.PatternFiller, the pattern
+// is drawn by that canvas. Otherwise, the filled path is
+// rasterized at patternDPI and drawn as an image.
+//
+// FillPattern has a value receiver so that a Canvas is itself
+// a vg.PatternFiller when used as the vg.Canvas of another Canvas.
+func (c Canvas) FillPattern(p vg.Path, pat vg.Pattern) {
+	if f, ok := c.Canvas.(vg.PatternFiller); ok {
+		f.FillPattern(p, pat)
+		return
+	}
+	r, ok := pathBounds(p)
+	if !ok {
+		return
+	}
+	img := vgimg.NewWith(
+		vgimg.UseWH(r.Size().X, r.Size().Y),
+		vgimg.UseDPI(patternDPI),
+		vgimg.UseBackgroundColor(color.Transparent),
+	)
+	img.Translate(vg.Point{X: -r.Min.X, Y: -r.Min.Y})
+	img.FillPattern(p, pat)
+	c.DrawImage(r, img.Image())
+}
+
+// patternDPI is the resolution at which patterns are
+// rasterized for canvases that cannot draw them.
+const patternDPI = 300
+
+// FillPolygonPattern fills a polygon with the given pattern.
+func (c *Canvas) FillPolygonPattern(pat vg.Pattern, pts []vg.Point) {
+	if len(pts) == 0 {
+		return
+	}
+
+	p := make(vg.Path, 0, len(pts)+1)
+	p.Move(pts[0])
+	for _, pt := range pts[1:] {
+		p.Line(pt)
+	}
+	p.Close()
+	c.FillPattern(p, pat)
+}
+
+// FillGradient fills the path with the gradient. If the
+// underlying vg.Canvas is a vg.GradientFiller, the gradient
+// is drawn by that canvas. Otherwise, the filled path is
+// split into gradientBands bands across the gradient, each
+// filled with the color of the gradient at its middle. In
+// that case, the subpaths of the path are filled separately,
+// so they must not describe holes.
+//
+// FillGradient has a value receiver so that a Canvas is itself
+// a vg.GradientFiller when used as the vg.Canvas of another Canvas.
+func (c Canvas) FillGradient(p vg.Path, g vg.LinearGradient) {
+	if f, ok := c.Canvas.(vg.GradientFiller); ok {
+		f.FillGradient(p, g)
+		return
+	}
+	for _, poly := range flatten(p) {
+		if len(poly) < 3 {
+			continue
+		}
+		// The first and last bands extend beyond
+		// the ends of the gradient.
+		tmin, tmax := math.Inf(1), math.Inf(-1)
+		for _, pt := range poly {
+			t := g.Offset(pt)
+			tmin = math.Min(tmin, t)
+			tmax = math.Max(tmax, t)
+		}
+		for i := 0; i < gradientBands; i++ {
+			t0 := float64(i) / gradientBands
+			t1 := float64(i+1) / gradientBands
+			if i == 0 {
+				t0 = math.Min(t0, tmin)
+			}
+			if i == gradientBands-1 {
+				t1 = math.Max(t1, tmax)
+			}
+			if t1 < tmin || t0 > tmax {
+				continue
+			}
+			band := clipOffsets(poly, g, t0, t1)
+			if len(band) < 3 {
+				continue
+			}
+			c.FillPolygon(g.At(float64(2*i+1)/(2*gradientBands)), band)
+		}
+	}
+}
+
+// gradientBands is the number of bands in which gradients
+// are drawn for canvases that cannot draw them.
+const gradientBands = 64
+
+// FillPolygonGradient fills a polygon with the given gradient.
+func (c *Canvas) FillPolygonGradient(g vg.LinearGradient, pts []vg.Point) {
+	if len(pts) == 0 {
+		return
+	}
+
+	p := make(vg.Path, 0, len(pts)+1)
+	p.Move(pts[0])
+	for _, pt := range pts[1:] {
+		p.Line(pt)
+	}
+	p.Close()
+	c.FillGradient(p, g)
+}
+
+// clipOffsets returns the part of the polygon whose offsets
+// in the gradient are between t0 and t1.
+func clipOffsets(poly []vg.Point, g vg.LinearGradient, t0, t1 float64) []vg.Point {
+	clip := func(poly []vg.Point, in func(t float64) bool, bound float64) []vg.Point {
+		var out []vg.Point
+		for i, p := range poly {
+			q := poly[(i+1)%len(poly)]
+			tp, tq := g.Offset(p), g.Offset(q)
+			if in(tp) {
+				out = append(out, p)
+			}
+			if in(tp) != in(tq) {
+				f := vg.Length((bound - tp) / (tq - tp))
+				out = append(out, p.Add(q.Sub(p).Scale(f)))
+			}
+		}
+		return out
+	}
+	poly = clip(poly, func(t float64) bool { return t >= t0 }, t0)
+	return clip(poly, func(t float64) bool { return t <= t1 }, t1)
+}
+
+// flatten returns the subpaths of the path as polygons,
+// approximating arcs and curves by line segments.
+func flatten(p vg.Path) [][]vg.Point {
+	const n = 32
+	var (
+		polys [][]vg.Point
+		cur   []vg.Point
+	)
+	for _, comp := range p {
+		switch comp.Type {
+		case vg.MoveComp:
+			if len(cur) != 0 {
+				polys = append(polys, cur)
+			}
+			cur = []vg.Point{comp.Pos}
+		case vg.LineComp:
+			cur = append(cur, comp.Pos)
+		case vg.ArcComp:
+			for i := 0; i <= n; i++ {
+				a := comp.Start + comp.Angle*float64(i)/n
+				sin, cos := math.Sincos(a)
+				cur = append(cur, vg.Point{
+					X: comp.Pos.X + comp.Radius*vg.Length(cos),
+					Y: comp.Pos.Y + comp.Radius*vg.Length(sin),
+				})
+			}
+		case vg.CurveComp:
+			if len(cur) == 0 {
+				cur = append(cur, comp.Pos)
+				continue
+			}
+			ctl := append([]vg.Point{cur[len(cur)-1]}, comp.Control...)
+			ctl = append(ctl, comp.Pos)
+			for i := 1; i <= n; i++ {
+				cur = append(cur, bezier(ctl, float64(i)/n))
+			}
+		case vg.CloseComp:
+			if len(cur) != 0 {
+				polys = append(polys, cur)
+			}
+			cur = nil
+		}
+	}
+	if len(cur) != 0 {
+		polys = append(polys, cur)
+	}
+	return polys
+}
+
+// bezier returns the point at t of the Bézier
+// curve with the given control points, using
+// de Casteljau's algorithm.
+func bezier(ctl []vg.Point, t float64) vg.Point {
+	pts := append([]vg.Point(nil), ctl...)
+	for n := len(pts) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			pts[i] = pts[i].Add(pts[i+1].Sub(pts[i]).Scale(vg.Length(t)))
+		}
+	}
+	return pts[0]
+}
+
+// pathBounds returns the bounding box of the path, and
+// whether the box has a positive area. Arcs are bounded by
+// the bounding box of their circle, and curves by their
+// control points.
+func pathBounds(p vg.Path) (r vg.Rectangle, ok bool) {
+	inf := vg.Length(math.Inf(1))
+	r = vg.Rectangle{
+		Min: vg.Point{X: inf, Y: inf},
+		Max: vg.Point{X: -inf, Y: -inf},
+	}
+	add := func(pt vg.Point, rad vg.Length) {
+		r.Min.X = vg.Length(math.Min(float64(r.Min.X), float64(pt.X-rad)))
+		r.Min.Y = vg.Length(math.Min(float64(r.Min.Y), float64(pt.Y-rad)))
+		r.Max.X = vg.Length(math.Max(float64(r.Max.X), float64(pt.X+rad)))
+		r.Max.Y = vg.Length(math.Max(float64(r.Max.Y), float64(pt.Y+rad)))
+	}
+	for _, comp := range p {
+		switch comp.Type {
+		case vg.MoveComp, vg.LineComp:
+			add(comp.Pos, 0)
+		case vg.ArcComp:
+			add(comp.Pos, comp.Radius)
+		case vg.CurveComp:
+			add(comp.Pos, 0)
+			for _, ctl := range comp.Control {
+				add(ctl, 0)
+			}
+		}
+	}
+	return r, r.Min.X < r.Max.X && r.Min.Y < r.Max.Y
+}
+
 // Center returns the center point of the area
 func (c *Canvas) Center() vg.Point {
 	return vg.Point{
@@ -375,19 +742,36 @@ type Tiles struct {
 	// PadX and PadY specify the padding between columns and rows
 	// of tiles respectively..
 	PadX, PadY vg.Length
+
+	// ColWidths and RowHeights, if not empty, specify the
+	// relative widths of the columns of tiles from the left
+	// and the relative heights of the rows of tiles from the
+	// top. They must have Cols and Rows elements respectively.
+	// The columns or rows have equal sizes if they are empty.
+	ColWidths, RowHeights []float64
 }
 
 // At returns the subcanvas within c that corresponds to the
 // tile at column x, row y.
 func (ts Tiles) At(c Canvas, x, y int) Canvas {
-	tileH := (c.Max.Y - c.Min.Y - ts.PadTop - ts.PadBottom -
-		vg.Length(ts.Rows-1)*ts.PadY) / vg.Length(ts.Rows)
-	tileW := (c.Max.X - c.Min.X - ts.PadLeft - ts.PadRight -
-		vg.Length(ts.Cols-1)*ts.PadX) / vg.Length(ts.Cols)
+	h := c.Max.Y - c.Min.Y - ts.PadTop - ts.PadBottom - vg.Length(ts.Rows-1)*ts.PadY
+	w := c.Max.X - c.Min.X - ts.PadLeft - ts.PadRight - vg.Length(ts.Cols-1)*ts.PadX
+	tileH := h / vg.Length(ts.Rows)
+	tileW := w / vg.Length(ts.Cols)
 
 	ymax := c.Max.Y - ts.PadTop - vg.Length(y)*(ts.PadY+tileH)
-	ymin := ymax - tileH
 	xmin := c.Min.X + ts.PadLeft + vg.Length(x)*(ts.PadX+tileW)
+	if len(ts.RowHeights) != 0 {
+		start, size := tileSpan(ts.RowHeights, ts.Rows, y)
+		ymax = c.Max.Y - ts.PadTop - vg.Length(y)*ts.PadY - h*vg.Length(start)
+		tileH = h * vg.Length(size)
+	}
+	if len(ts.ColWidths) != 0 {
+		start, size := tileSpan(ts.ColWidths, ts.Cols, x)
+		xmin = c.Min.X + ts.PadLeft + vg.Length(x)*ts.PadX + w*vg.Length(start)
+		tileW = w * vg.Length(size)
+	}
+	ymin := ymax - tileH
 	xmax := xmin + tileW
 
 	return Canvas{
@@ -399,10 +783,31 @@ func (ts Tiles) At(c Canvas, x, y int) Canvas {
 	}
 }
 
+// tileSpan returns the start of the ith of n tiles
+// with the relative sizes, and its size, as fractions
+// of the total size of the tiles.
+func tileSpan(sizes []float64, n, i int) (start, size float64) {
+	if len(sizes) != n {
+		panic(fmt.Errorf("draw: number of tile sizes (%d) != number of tiles (%d)", len(sizes), n))
+	}
+	var sum float64
+	for k, s := range sizes {
+		if k < i {
+			start += s
+		}
+		sum += s
+	}
+	return start / sum, sizes[i] / sum
+}
+
 // SetLineStyle sets the current line style
 func (c *Canvas) SetLineStyle(sty LineStyle) {
 	c.SetColor(sty.Color)
 	c.SetLineWidth(sty.Width)
+	if sty.RelativeDashes {
+		c.SetLineDash(vg.ScaleDashes(sty.Dashes, sty.DashOffs, sty.Width))
+		return
+	}
 	c.SetLineDash(sty.Dashes, sty.DashOffs)
 }
 
@@ -131,6 +131,7 @@ func TestFormattedCanvas(t *testing.T) {
 		{format: "tex"},
 		{format: "tiff"},
 		{format: "tif"},
+		{format: "webp"},
 		{
 			format: "",
 			err:    fmt.Errorf("unsupported format: \"\""),
@@ -155,3 +156,69 @@ func TestFormattedCanvas(t *testing.T) {
 		})
 	}
 }
+
+func TestRelativeDashes(t *testing.T) {
+	for _, test := range []struct {
+		width      vg.Length
+		wantDashes []vg.Length
+		wantOffs   vg.Length
+	}{
+		{width: 1, wantDashes: []vg.Length{3, 1}, wantOffs: 1},
+		{width: 2, wantDashes: []vg.Length{6, 2}, wantOffs: 2},
+	} {
+		var rec recorder.Canvas
+		c := NewCanvas(&rec, 10, 10)
+		c.SetLineStyle(LineStyle{
+			Color:          color.Black,
+			Width:          test.width,
+			Dashes:         []vg.Length{3, 1},
+			DashOffs:       1,
+			RelativeDashes: true,
+		})
+		var got *recorder.SetLineDash
+		for _, a := range rec.Actions {
+			if d, ok := a.(*recorder.SetLineDash); ok {
+				got = d
+			}
+		}
+		if got == nil {
+			t.Fatalf("width=%v: no line dash set", test.width)
+		}
+		if !reflect.DeepEqual(got.Dashes, test.wantDashes) || got.Offsets != test.wantOffs {
+			t.Errorf("width=%v: got dashes=%v offset=%v, want dashes=%v offset=%v",
+				test.width, got.Dashes, got.Offsets, test.wantDashes, test.wantOffs,
+			)
+		}
+	}
+}
+
+func TestNamedGlyph(t *testing.T) {
+	for _, name := range []string{"circle", "ring", "square", "box", "triangle", "pyramid", "plus", "cross"} {
+		if _, ok := NamedGlyph(name); !ok {
+			t.Errorf("glyph %q not registered", name)
+		}
+	}
+	if _, ok := NamedGlyph("dot"); ok {
+		t.Error("unexpected glyph registered as \"dot\"")
+	}
+
+	var got []vg.Point
+	RegisterGlyph("dot", GlyphDrawerFunc(func(c *Canvas, sty GlyphStyle, pt vg.Point) {
+		got = append(got, pt)
+	}))
+	defer func() {
+		glyphLock.Lock()
+		delete(glyphs, "dot")
+		glyphLock.Unlock()
+	}()
+	g, ok := NamedGlyph("dot")
+	if !ok {
+		t.Fatal("registered glyph not found")
+	}
+	c := NewCanvas(new(recorder.Canvas), 10, 10)
+	pt := vg.Point{X: 4, Y: 5}
+	c.DrawGlyph(GlyphStyle{Color: color.Black, Radius: 1, Shape: g}, pt)
+	if !reflect.DeepEqual(got, []vg.Point{pt}) {
+		t.Errorf("unexpected glyph draws: got:%v want:%v", got, []vg.Point{pt})
+	}
+}
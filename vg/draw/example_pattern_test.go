@@ -0,0 +1,45 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package draw_test
+
+import (
+	"image/color"
+	"log"
+	"os"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+// An example of filling a polygon with diagonal hatching.
+func ExampleCanvas_FillPolygonPattern() {
+	img := vgimg.New(5*vg.Centimeter, 5*vg.Centimeter)
+	c := draw.New(img)
+
+	hatch := vg.Hatch{
+		Color:   color.RGBA{B: 160, A: 255},
+		Width:   vg.Points(1),
+		Spacing: vg.Points(6),
+	}
+	pts := []vg.Point{
+		{X: 1 * vg.Centimeter, Y: 1 * vg.Centimeter},
+		{X: 4 * vg.Centimeter, Y: 1.5 * vg.Centimeter},
+		{X: 3.5 * vg.Centimeter, Y: 4 * vg.Centimeter},
+		{X: 1.5 * vg.Centimeter, Y: 3 * vg.Centimeter},
+	}
+	c.FillPolygonPattern(hatch, pts)
+	c.StrokeLines(draw.LineStyle{Color: color.Black, Width: vg.Points(1)}, append(pts, pts[0]))
+
+	w, err := os.Create("testdata/hatch.png")
+	if err != nil {
+		log.Panic(err)
+	}
+	defer w.Close()
+	png := vgimg.PngCanvas{Canvas: img}
+	if _, err := png.WriteTo(w); err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package draw_test
+
+import (
+	"image/color"
+	"testing"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestFillGradientFallback(t *testing.T) {
+	var rec recorder.Canvas
+	c := draw.NewCanvas(&rec, 10, 10)
+	c.FillPolygonGradient(vg.LinearGradient{
+		Start: vg.Point{Y: 2},
+		End:   vg.Point{Y: 8},
+		Stops: []vg.GradientStop{
+			{Offset: 0, Color: color.NRGBA{A: 255}},
+			{Offset: 1, Color: color.NRGBA{R: 255, A: 255}},
+		},
+	}, []vg.Point{
+		{X: 1, Y: 2}, {X: 5, Y: 2}, {X: 5, Y: 8}, {X: 1, Y: 8},
+	})
+
+	var (
+		fills int
+		reds  []uint8
+	)
+	for _, a := range rec.Actions {
+		switch a := a.(type) {
+		case *recorder.Fill:
+			fills++
+		case *recorder.SetColor:
+			reds = append(reds, color.NRGBAModel.Convert(a.Color).(color.NRGBA).R)
+		}
+	}
+	if fills < 2 {
+		t.Fatalf("gradient was not drawn in bands: got %d fills", fills)
+	}
+	if fills != len(reds) {
+		t.Fatalf("mismatched number of fills and colors: %d != %d", fills, len(reds))
+	}
+	for i := 1; i < len(reds); i++ {
+		if reds[i] < reds[i-1] {
+			t.Errorf("band colors are not increasing: %v", reds)
+			break
+		}
+	}
+	if reds[0] > 10 || reds[len(reds)-1] < 245 {
+		t.Errorf("band colors do not span the gradient: %v", reds)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package draw_test
+
+import (
+	"testing"
+
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestFillPolygonPattern(t *testing.T) {
+	cmpimg.CheckPlot(ExampleCanvas_FillPolygonPattern, t, "hatch.png")
+}
+
+func TestFillPatternFallback(t *testing.T) {
+	var rec recorder.Canvas
+	c := draw.NewCanvas(&rec, 10, 10)
+	c.FillPolygonPattern(vg.Hatch{Width: 1, Spacing: 2}, []vg.Point{
+		{X: 1, Y: 2}, {X: 5, Y: 2}, {X: 5, Y: 8}, {X: 1, Y: 8},
+	})
+
+	var imgs []*recorder.DrawImage
+	for _, a := range rec.Actions {
+		if a, ok := a.(*recorder.DrawImage); ok {
+			imgs = append(imgs, a)
+		}
+	}
+	if len(imgs) != 1 {
+		t.Fatalf("unexpected number of images drawn: got:%d want:1", len(imgs))
+	}
+	want := vg.Rectangle{Min: vg.Point{X: 1, Y: 2}, Max: vg.Point{X: 5, Y: 8}}
+	if got := imgs[0].Rectangle; got != want {
+		t.Errorf("unexpected image rectangle: got:%v want:%v", got, want)
+	}
+}
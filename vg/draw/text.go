@@ -25,6 +25,16 @@ type TextHandler interface {
 	Draw(c *Canvas, txt string, sty TextStyle, pt vg.Point)
 }
 
+// StyleBoxer is implemented by text handlers whose measure
+// of text depends on more of its style than the font, such
+// as the line height of multi-line text.
+type StyleBoxer interface {
+	// StyleBox returns the bounding box of the text
+	// drawn in the style, as described by the Box
+	// method of TextHandler.
+	StyleBox(txt string, sty TextStyle) (width, height, depth vg.Length)
+}
+
 // TextStyle describes what text will look like.
 type TextStyle struct {
 	// Color is the text color.
@@ -38,9 +48,16 @@ type TextStyle struct {
 	Rotation float64
 
 	// XAlign and YAlign specify the alignment of the text.
+	// Each line of multi-line text is aligned horizontally
+	// on its own.
 	XAlign XAlignment
 	YAlign YAlignment
 
+	// LineHeight is the distance between the baselines
+	// of consecutive lines of text. If LineHeight is zero,
+	// the size of the font is used.
+	LineHeight vg.Length
+
 	// TextHandler parses and formats text according to a given
 	// dialect (Markdown, LaTeX, plain, ...)
 	// The default is a plain text handler.
@@ -74,7 +91,29 @@ func (sty TextStyle) Height(txt string) vg.Length {
 		return vg.Length(0)
 	}
 	e := sty.Font.Extents()
-	return e.Height*vg.Length(nl-1) + e.Ascent
+	return sty.LineSpacing()*vg.Length(nl-1) + e.Ascent
+}
+
+// Box returns the bounding box of the text drawn in the
+// style, as described by the Box method of TextHandler.
+// The text is measured with the whole style if the handler
+// is a StyleBoxer, and with the font of the style otherwise.
+func (sty TextStyle) Box(txt string) (width, height, depth vg.Length) {
+	hdlr := sty.handler()
+	if b, ok := hdlr.(StyleBoxer); ok {
+		return b.StyleBox(txt, sty)
+	}
+	return hdlr.Box(txt, sty.Font)
+}
+
+// LineSpacing returns the distance between the baselines
+// of consecutive lines of text: the LineHeight of the style,
+// or the size of its font if LineHeight is zero.
+func (sty TextStyle) LineSpacing() vg.Length {
+	if sty.LineHeight != 0 {
+		return sty.LineHeight
+	}
+	return sty.Font.Size
 }
 
 // Rectangle returns a rectangle giving the bounds of
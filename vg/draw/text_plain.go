@@ -18,12 +18,21 @@ type PlainTextHandler struct{}
 //  - width is the horizontal space from the origin.
 //  - height is the vertical space above the baseline.
 //  - depth is the vertical space below the baseline, a negative number.
+// Lines of text are one font size apart.
 func (hdlr PlainTextHandler) Box(txt string, fnt vg.Font) (width, height, depth vg.Length) {
+	return hdlr.StyleBox(txt, TextStyle{Font: fnt})
+}
+
+// StyleBox returns the bounding box of the given text drawn
+// in the style, with lines of text LineSpacing apart,
+// implementing the StyleBoxer interface.
+func (hdlr PlainTextHandler) StyleBox(txt string, sty TextStyle) (width, height, depth vg.Length) {
+	fnt := sty.Font
 	ext := fnt.Extents()
 
 	nl := hdlr.textNLines(txt)
 	if nl != 0 {
-		height = ext.Height*vg.Length(nl-1) + ext.Ascent
+		height = sty.LineSpacing()*vg.Length(nl-1) + ext.Ascent
 		depth = -ext.Descent
 	}
 
@@ -58,11 +67,17 @@ func (hdlr PlainTextHandler) Draw(c *Canvas, txt string, sty TextStyle, pt vg.Po
 
 	nl := hdlr.textNLines(txt)
 	ht := sty.Height(txt)
+	lh := sty.LineSpacing()
 	pt.Y += ht*vg.Length(sty.YAlign) - sty.Font.Extents().Ascent
+	tf, aligned := textFillerOf(c.Canvas)
 	for i, line := range strings.Split(txt, "\n") {
+		y := vg.Length(nl-i)*lh + sty.Font.Size - lh
+		if aligned {
+			tf.FillText(sty.Font, pt.Add(vg.Point{Y: y}), float64(sty.XAlign), line)
+			continue
+		}
 		xoffs := vg.Length(sty.XAlign) * sty.Font.Width(line)
-		n := vg.Length(nl - i)
-		c.FillString(sty.Font, pt.Add(vg.Point{X: xoffs, Y: n * sty.Font.Size}), line)
+		c.FillString(sty.Font, pt.Add(vg.Point{X: xoffs, Y: y}), line)
 	}
 
 	if sty.Rotation != 0 {
@@ -70,6 +85,29 @@ func (hdlr PlainTextHandler) Draw(c *Canvas, txt string, sty TextStyle, pt vg.Po
 	}
 }
 
+// textFiller is implemented by canvases that can align text themselves,
+// such as vgtex.Canvas.
+type textFiller interface {
+	FillText(f vg.Font, pt vg.Point, xalign float64, text string)
+}
+
+// textFillerOf returns the textFiller underlying c, if any,
+// looking through nested draw canvases.
+func textFillerOf(c vg.Canvas) (textFiller, bool) {
+	for {
+		switch v := c.(type) {
+		case textFiller:
+			return v, true
+		case Canvas:
+			c = v.Canvas
+		case *Canvas:
+			c = v.Canvas
+		default:
+			return nil, false
+		}
+	}
+}
+
 // textNLines returns the number of lines in the text.
 func (PlainTextHandler) textNLines(txt string) int {
 	txt = strings.TrimRight(txt, "\n")
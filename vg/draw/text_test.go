@@ -0,0 +1,131 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package draw
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestMultiLineText(t *testing.T) {
+	fnt, err := vg.MakeFont("Times-Roman", 10)
+	if err != nil {
+		t.Fatalf("could not create font: %+v", err)
+	}
+	lines := []string{"a long first line", "short"}
+	txt := lines[0] + "\n" + lines[1]
+	ascent := fnt.Extents().Ascent
+
+	for _, lh := range []vg.Length{0, 15} {
+		sty := TextStyle{
+			Font:       fnt,
+			XAlign:     XCenter,
+			YAlign:     YCenter,
+			LineHeight: lh,
+		}
+		want := lh
+		if want == 0 {
+			want = fnt.Size
+		}
+
+		if got := sty.Height(txt); !closeTo(got, want+ascent) {
+			t.Errorf("lh=%v: unexpected height: got:%v want:%v", lh, got, want+ascent)
+		}
+		if _, got, _ := sty.Box(txt); !closeTo(got, want+ascent) {
+			t.Errorf("lh=%v: unexpected box height: got:%v want:%v", lh, got, want+ascent)
+		}
+		r := sty.Rectangle(txt)
+		if got := r.Size().Y; !closeTo(got, want+ascent) {
+			t.Errorf("lh=%v: unexpected rectangle height: got:%v want:%v", lh, got, want+ascent)
+		}
+		if got := r.Size().X; !closeTo(got, fnt.Width(lines[0])) {
+			t.Errorf("lh=%v: unexpected rectangle width: got:%v want:%v", lh, got, fnt.Width(lines[0]))
+		}
+
+		var rec recorder.Canvas
+		c := NewCanvas(&rec, 100, 100)
+		pt := vg.Point{X: 50, Y: 50}
+		c.FillText(sty, pt, txt)
+
+		var got []*recorder.FillString
+		for _, a := range rec.Actions {
+			if a, ok := a.(*recorder.FillString); ok {
+				got = append(got, a)
+			}
+		}
+		if len(got) != len(lines) {
+			t.Fatalf("lh=%v: unexpected number of lines: got:%d want:%d", lh, len(got), len(lines))
+		}
+		for i, a := range got {
+			if a.String != lines[i] {
+				t.Errorf("lh=%v: unexpected line %d: got:%q want:%q", lh, i, a.String, lines[i])
+			}
+			// Each line is centered on its own.
+			x := pt.X - fnt.Width(lines[i])/2
+			if !closeTo(a.Point.X, x) {
+				t.Errorf("lh=%v: unexpected x of line %d: got:%v want:%v", lh, i, a.Point.X, x)
+			}
+		}
+		if d := got[0].Point.Y - got[1].Point.Y; !closeTo(d, want) {
+			t.Errorf("lh=%v: unexpected distance between baselines: got:%v want:%v", lh, d, want)
+		}
+		// The last line is drawn at the bottom of the rectangle,
+		// raised by the difference between the font size and
+		// its ascent as for single lines.
+		bottom := pt.Y + r.Min.Y + fnt.Size - ascent
+		if !closeTo(got[1].Point.Y, bottom) {
+			t.Errorf("lh=%v: unexpected baseline of the last line: got:%v want:%v", lh, got[1].Point.Y, bottom)
+		}
+	}
+}
+
+// fontBoxer is a text handler measuring text
+// only with its font.
+type fontBoxer struct{}
+
+func (fontBoxer) Box(txt string, fnt vg.Font) (width, height, depth vg.Length) {
+	return 1, fnt.Size, -1
+}
+
+func (fontBoxer) Draw(c *Canvas, txt string, sty TextStyle, pt vg.Point) {}
+
+func TestTextStyleBox(t *testing.T) {
+	fnt, err := vg.MakeFont("Times-Roman", 10)
+	if err != nil {
+		t.Fatalf("could not create font: %+v", err)
+	}
+	const txt = "first\nsecond"
+	ext := fnt.Extents()
+
+	var h fontBoxer
+	sty := TextStyle{Font: fnt, LineHeight: 20, Handler: h}
+	if w, ht, d := sty.Box(txt); w != 1 || ht != fnt.Size || d != -1 {
+		t.Errorf("unexpected box of handler: got:(%v, %v, %v) want:(1, %v, -1)", w, ht, d, fnt.Size)
+	}
+
+	// The plain text handler measures the
+	// lines of text with the line height.
+	sty.Handler = PlainTextHandler{}
+	w, ht, d := sty.Box(txt)
+	if want := fnt.Width("second"); !closeTo(w, want) {
+		t.Errorf("unexpected width: got:%v want:%v", w, want)
+	}
+	if want := 20 + ext.Ascent; !closeTo(ht, want) {
+		t.Errorf("unexpected height: got:%v want:%v", ht, want)
+	}
+	if !closeTo(d, -ext.Descent) {
+		t.Errorf("unexpected depth: got:%v want:%v", d, -ext.Descent)
+	}
+	if _, ht, _ := sty.Handler.Box(txt, fnt); !closeTo(ht, fnt.Size+ext.Ascent) {
+		t.Errorf("unexpected height of font box: got:%v want:%v", ht, fnt.Size+ext.Ascent)
+	}
+}
+
+func closeTo(a, b vg.Length) bool {
+	return math.Abs(float64(a-b)) < 1e-9
+}
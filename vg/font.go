@@ -28,6 +28,8 @@ var (
 	// FontMap maps Postscript/PDF font names to compatible
 	// free fonts (TrueType converted ghostscript fonts).
 	// Fonts that are not keys of this map are not supported.
+	// FontMap is not protected against concurrent use: it
+	// must not be modified while fonts are being made.
 	FontMap = map[string]string{
 
 		// We use fonts from RedHat's Liberation project:
@@ -53,7 +55,7 @@ var (
 	// caches the associated *truetype.Font.
 	loadedFonts = make(map[string]*truetype.Font)
 
-	// FontLock protects access to the loadedFonts map.
+	// fontLock protects access to the loadedFonts map.
 	fontLock sync.RWMutex
 )
 
@@ -158,6 +160,65 @@ func (f *Font) Extents() FontExtents {
 	}
 }
 
+// TextExtents contains the metrics of a
+// string measured from the glyphs that it
+// is drawn with.
+type TextExtents struct {
+	// Width is the horizontal advance of the
+	// text, as returned by Font.Width.
+	Width Length
+
+	// Ascent is the distance that the glyphs
+	// of the text extend above the baseline.
+	Ascent Length
+
+	// Descent is the distance that the glyphs
+	// of the text extend below the baseline.
+	// The descent is given as a negative value,
+	// and is positive for text that is drawn
+	// entirely above the baseline.
+	Descent Length
+}
+
+// TextExtents returns the TextExtents of a string
+// when drawn using the font. Unlike the FontExtents,
+// which bound every glyph of the font, the ascent and
+// descent are those of the outlines of the glyphs of
+// the string, so that, for example, "ace" has a smaller
+// ascent than "ACE". All the extents of a string without
+// visible glyphs are zero, apart from its width.
+func (f *Font) TextExtents(s string) TextExtents {
+	upem := fixed.Int26_6(f.font.FUnitsPerEm())
+	scale := f.Size / Points(float64(upem))
+
+	var (
+		buf      truetype.GlyphBuf
+		ext      TextExtents
+		ymin     fixed.Int26_6
+		ymax     fixed.Int26_6
+		hasGlyph bool
+	)
+	for _, rune := range s {
+		err := buf.Load(f.font, upem, f.font.Index(rune), font.HintingNone)
+		if err != nil || len(buf.Points) == 0 {
+			continue
+		}
+		if !hasGlyph || buf.Bounds.Min.Y < ymin {
+			ymin = buf.Bounds.Min.Y
+		}
+		if !hasGlyph || buf.Bounds.Max.Y > ymax {
+			ymax = buf.Bounds.Max.Y
+		}
+		hasGlyph = true
+	}
+	if hasGlyph {
+		ext.Ascent = Points(float64(ymax)) * scale
+		ext.Descent = Points(float64(ymin)) * scale
+	}
+	ext.Width = f.Width(s)
+	return ext
+}
+
 // Width returns width of a string when drawn using the font.
 func (f *Font) Width(s string) Length {
 	// scale converts truetype.FUnit to float64
@@ -192,25 +253,31 @@ func getFont(name string) (*truetype.Font, error) {
 		return f, nil
 	}
 
-	bytes, err := fontData(name)
+	bytes, err := FontData(name)
 	if err != nil {
 		return nil, err
 	}
 
 	font, err := truetype.Parse(bytes)
-	if err == nil {
-		fontLock.Lock()
-		loadedFonts[name] = font
-		fontLock.Unlock()
-	} else {
-		err = errors.New("Failed to parse font file: " + err.Error())
+	if err != nil {
+		return nil, errors.New("Failed to parse font file: " + err.Error())
 	}
 
-	return font, err
+	fontLock.Lock()
+	defer fontLock.Unlock()
+	// The font may have been loaded concurrently. Keep the
+	// first one so that all fonts share a single truetype.Font.
+	if f, ok := loadedFonts[name]; ok {
+		return f, nil
+	}
+	loadedFonts[name] = font
+	return font, nil
 }
 
-// fontData returns the []byte data for a font name or an error if it is not found.
-func fontData(name string) ([]byte, error) {
+// FontData returns the TrueType data for a font name or an error if it is
+// not found. The data is looked for in the FontDirs and then in the fonts
+// package, in the same way as fonts are located by MakeFont.
+func FontData(name string) ([]byte, error) {
 	fname, err := fontFile(name)
 	if err != nil {
 		return nil, err
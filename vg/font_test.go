@@ -0,0 +1,60 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vg_test
+
+import (
+	"testing"
+
+	"gonum.org/v1/plot/vg"
+)
+
+func TestTextExtents(t *testing.T) {
+	// The metrics of the glyphs of Liberation Serif,
+	// in font units of 1/2048 em.
+	const upem = 2048
+	fnt, err := vg.MakeFont("Times-Roman", upem)
+	if err != nil {
+		t.Fatalf("could not create font: %+v", err)
+	}
+	for _, test := range []struct {
+		text    string
+		ascent  float64
+		descent float64
+		width   float64
+	}{
+		{text: "x", ascent: 940, descent: 0, width: 1024},
+		{text: "E", ascent: 1341, descent: 0, width: 1251},
+		{text: "É", ascent: 1744, descent: 0, width: 1251},
+		{text: "g", ascent: 1051, descent: -442, width: 1024},
+		{text: "xg", ascent: 1051, descent: -442, width: 2048},
+		{text: "-", ascent: 559, descent: 406, width: 682},
+		{text: " ", ascent: 0, descent: 0, width: 512},
+		{text: "", ascent: 0, descent: 0, width: 0},
+	} {
+		ext := fnt.TextExtents(test.text)
+		want := vg.TextExtents{
+			Width:   vg.Length(test.width),
+			Ascent:  vg.Length(test.ascent),
+			Descent: vg.Length(test.descent),
+		}
+		if ext != want {
+			t.Errorf("unexpected extents for %q: got:%+v want:%+v", test.text, ext, want)
+		}
+		if w := fnt.Width(test.text); ext.Width != w {
+			t.Errorf("unexpected width for %q: got:%v want:%v", test.text, ext.Width, w)
+		}
+	}
+
+	// The glyphs of a string are within the bounds of the font.
+	fnt, err = vg.MakeFont("Times-Roman", 12)
+	if err != nil {
+		t.Fatalf("could not create font: %+v", err)
+	}
+	ext := fnt.TextExtents("Éjg|")
+	font := fnt.Extents()
+	if ext.Ascent > font.Ascent || ext.Descent < font.Descent {
+		t.Errorf("text extents %+v outside of font extents %+v", ext, font)
+	}
+}
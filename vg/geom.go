@@ -4,6 +4,8 @@
 
 package vg
 
+import "math"
+
 // A Point is a location in 2d space.
 //
 // Points are used for drawing, not for data.  For
@@ -56,3 +58,32 @@ func (r Rectangle) Path() (p Path) {
 	p.Close()
 	return
 }
+
+// RoundedPath returns the path of the Rectangle with
+// corners rounded by circular arcs of the given radius.
+// The radius is clamped to half of the smaller side of
+// the Rectangle. If the radius is not positive, the path
+// is that of the Rectangle with square corners.
+func (r Rectangle) RoundedPath(rad Length) (p Path) {
+	sz := r.Size()
+	if half := sz.X / 2; rad > half {
+		rad = half
+	}
+	if half := sz.Y / 2; rad > half {
+		rad = half
+	}
+	if rad <= 0 {
+		return r.Path()
+	}
+	p.Move(Point{X: r.Min.X + rad, Y: r.Min.Y})
+	p.Line(Point{X: r.Max.X - rad, Y: r.Min.Y})
+	p.Arc(Point{X: r.Max.X - rad, Y: r.Min.Y + rad}, rad, -math.Pi/2, math.Pi/2)
+	p.Line(Point{X: r.Max.X, Y: r.Max.Y - rad})
+	p.Arc(Point{X: r.Max.X - rad, Y: r.Max.Y - rad}, rad, 0, math.Pi/2)
+	p.Line(Point{X: r.Min.X + rad, Y: r.Max.Y})
+	p.Arc(Point{X: r.Min.X + rad, Y: r.Max.Y - rad}, rad, math.Pi/2, math.Pi/2)
+	p.Line(Point{X: r.Min.X, Y: r.Min.Y + rad})
+	p.Arc(Point{X: r.Min.X + rad, Y: r.Min.Y + rad}, rad, math.Pi, math.Pi/2)
+	p.Close()
+	return
+}
@@ -0,0 +1,72 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vg_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot/vg"
+)
+
+func TestRoundedPath(t *testing.T) {
+	r := vg.Rectangle{Min: vg.Point{X: 10, Y: 20}, Max: vg.Point{X: 50, Y: 40}}
+	for _, test := range []struct {
+		rad   vg.Length
+		want  vg.Length // Radius of the arcs after clamping.
+		comps int
+	}{
+		{rad: -1, comps: 5},
+		{rad: 0, comps: 5},
+		{rad: 4, want: 4, comps: 10},
+		{rad: 10, want: 10, comps: 10},
+		{rad: 100, want: 10, comps: 10},
+	} {
+		p := r.RoundedPath(test.rad)
+		if len(p) != test.comps {
+			t.Errorf("unexpected number of components for radius %v: got:%d want:%d", test.rad, len(p), test.comps)
+			continue
+		}
+		if p[0].Type != vg.MoveComp || p[len(p)-1].Type != vg.CloseComp {
+			t.Errorf("path for radius %v is not a closed path", test.rad)
+		}
+
+		var arcs int
+		for _, c := range p {
+			switch c.Type {
+			case vg.MoveComp, vg.LineComp:
+				if !contains(r, c.Pos) {
+					t.Errorf("point %v outside rectangle for radius %v", c.Pos, test.rad)
+				}
+			case vg.ArcComp:
+				arcs++
+				if c.Radius != test.want {
+					t.Errorf("unexpected arc radius for radius %v: got:%v want:%v", test.rad, c.Radius, test.want)
+				}
+				// Check the ends and the middle of the arc.
+				for _, a := range []float64{c.Start, c.Start + c.Angle/2, c.Start + c.Angle} {
+					pt := vg.Point{
+						X: c.Pos.X + c.Radius*vg.Length(math.Cos(a)),
+						Y: c.Pos.Y + c.Radius*vg.Length(math.Sin(a)),
+					}
+					if !contains(r, pt) {
+						t.Errorf("arc point %v outside rectangle for radius %v", pt, test.rad)
+					}
+				}
+			}
+		}
+		if test.want > 0 && arcs != 4 {
+			t.Errorf("unexpected number of arcs for radius %v: got:%d want:4", test.rad, arcs)
+		}
+	}
+}
+
+// contains returns whether pt is within r, allowing
+// for rounding errors.
+func contains(r vg.Rectangle, pt vg.Point) bool {
+	const tol = 1e-9
+	return pt.X >= r.Min.X-tol && pt.X <= r.Max.X+tol &&
+		pt.Y >= r.Min.Y-tol && pt.Y <= r.Max.Y+tol
+}
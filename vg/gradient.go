@@ -0,0 +1,86 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vg
+
+import (
+	"image/color"
+	"math"
+)
+
+// LinearGradient is a fill whose color varies linearly
+// along the line from Start to End, and is constant
+// along the lines perpendicular to it. Beyond Start
+// and End, the colors of the first and last stops are
+// extended.
+type LinearGradient struct {
+	// Start and End are the points at the offsets
+	// 0 and 1 of the gradient.
+	Start, End Point
+
+	// Stops are the colors of the gradient at given
+	// offsets, in increasing order of offset.
+	Stops []GradientStop
+}
+
+// GradientStop is a color of a gradient at
+// an offset between 0 and 1.
+type GradientStop struct {
+	Offset float64
+	Color  color.Color
+}
+
+// At returns the color of the gradient at the offset t.
+// The colors of the stops are interpolated linearly,
+// without premultiplication by their alpha, so that a
+// color fades smoothly into a transparent version of
+// itself.
+func (g LinearGradient) At(t float64) color.Color {
+	if len(g.Stops) == 0 {
+		return color.Transparent
+	}
+	if t <= g.Stops[0].Offset {
+		return g.Stops[0].Color
+	}
+	for i, s := range g.Stops[1:] {
+		if t > s.Offset {
+			continue
+		}
+		prev := g.Stops[i]
+		f := (t - prev.Offset) / (s.Offset - prev.Offset)
+		a := color.NRGBAModel.Convert(prev.Color).(color.NRGBA)
+		b := color.NRGBAModel.Convert(s.Color).(color.NRGBA)
+		lerp := func(x, y uint8) uint8 {
+			return uint8(math.Round(float64(x) + f*(float64(y)-float64(x))))
+		}
+		return color.NRGBA{
+			R: lerp(a.R, b.R),
+			G: lerp(a.G, b.G),
+			B: lerp(a.B, b.B),
+			A: lerp(a.A, b.A),
+		}
+	}
+	return g.Stops[len(g.Stops)-1].Color
+}
+
+// Offset returns the offset of the point pt in the gradient,
+// the position of its projection on the line from Start to
+// End. The offset is zero if Start and End are the same.
+func (g LinearGradient) Offset(pt Point) float64 {
+	d := g.End.Sub(g.Start)
+	n := d.Dot(d)
+	if n == 0 {
+		return 0
+	}
+	return float64(pt.Sub(g.Start).Dot(d) / n)
+}
+
+// GradientFiller is a Canvas that can fill paths with gradients.
+type GradientFiller interface {
+	Canvas
+
+	// FillGradient fills the path with the gradient,
+	// defined in the coordinates of the canvas.
+	FillGradient(Path, LinearGradient)
+}
@@ -0,0 +1,69 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vg
+
+import "image/color"
+
+// A Pattern is a fill pattern made of a rectangular
+// cell repeated horizontally and vertically.
+type Pattern interface {
+	// Size returns the width and height of the
+	// cell of the pattern.
+	Size() Point
+
+	// DrawCell draws a cell of the pattern, with its
+	// lower left corner at the origin, to the canvas.
+	// Drawing outside of the cell is clipped.
+	DrawCell(Canvas)
+}
+
+// PatternFiller is a Canvas that can fill paths with patterns.
+type PatternFiller interface {
+	Canvas
+
+	// FillPattern fills the path with the pattern.
+	// The origin of the cells of the pattern is
+	// defined by the canvas.
+	FillPattern(Path, Pattern)
+}
+
+// Hatch is a Pattern of parallel diagonal lines,
+// rising from left to right.
+type Hatch struct {
+	// Color is the color of the lines.
+	Color color.Color
+
+	// Width is the width of the lines.
+	Width Length
+
+	// Spacing is the horizontal and vertical
+	// distance between the lines.
+	Spacing Length
+}
+
+var _ Pattern = Hatch{}
+
+// Size returns the size of the cell of the hatch,
+// implementing the Pattern interface.
+func (h Hatch) Size() Point {
+	return Point{X: h.Spacing, Y: h.Spacing}
+}
+
+// DrawCell draws a cell of the hatch, implementing
+// the Pattern interface.
+func (h Hatch) DrawCell(c Canvas) {
+	c.SetColor(h.Color)
+	c.SetLineWidth(h.Width)
+	s := h.Spacing
+	// Draw the diagonal of the cell and the lines
+	// through the upper left and lower right corners,
+	// so that the lines are continuous between cells.
+	for _, off := range []Length{-s, 0, s} {
+		var p Path
+		p.Move(Point{X: off - s, Y: -s})
+		p.Line(Point{X: off + 2*s, Y: 2 * s})
+		c.Stroke(p)
+	}
+}
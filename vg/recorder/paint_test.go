@@ -0,0 +1,81 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package recorder_test
+
+import (
+	"image/color"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestPaints(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.HideAxes()
+	line, err := plotter.NewLine(plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 0}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Add(line)
+
+	var rec recorder.Canvas
+	p.Draw(draw.NewCanvas(&rec, 100, 100))
+	paints := rec.Paints()
+
+	// The background, the hidden X and Y axis lines and the line.
+	var kinds []string
+	for _, paint := range paints {
+		kinds = append(kinds, reflect.TypeOf(paint.Action).String())
+	}
+	wantKinds := []string{"*recorder.Fill", "*recorder.Stroke", "*recorder.Stroke", "*recorder.Stroke"}
+	if !reflect.DeepEqual(kinds, wantKinds) {
+		t.Fatalf("unexpected paints: got:%v want:%v", kinds, wantKinds)
+	}
+	if got := paints[0].Color; got != p.BackgroundColor {
+		t.Errorf("unexpected background color: got:%v want:%v", got, p.BackgroundColor)
+	}
+
+	got := paints[3]
+	if got.Color != line.Color || got.LineWidth != line.Width || got.Dashes != nil {
+		t.Errorf("unexpected line style: got:%v %v %v want:%v %v %v",
+			got.Color, got.LineWidth, got.Dashes, line.Color, line.Width, line.Dashes)
+	}
+	var pts []vg.Point
+	for _, c := range got.Action.(*recorder.Stroke).Path {
+		pts = append(pts, c.Pos)
+	}
+	// The data area is inset by the padding of the hidden axes.
+	wantPts := []vg.Point{{X: 5, Y: 5}, {X: 52.5, Y: 100}, {X: 100, Y: 5}}
+	if !reflect.DeepEqual(pts, wantPts) {
+		t.Errorf("unexpected line path: got:%v want:%v", pts, wantPts)
+	}
+
+	// The graphics state is restored by Pop.
+	rec.Reset()
+	rec.SetColor(color.Black)
+	rec.SetLineWidth(1)
+	rec.Push()
+	rec.SetColor(color.White)
+	rec.SetLineDash([]vg.Length{2, 3}, 1)
+	rec.Fill(nil)
+	rec.Pop()
+	rec.Stroke(nil)
+	paints = rec.Paints()
+	want := []recorder.Paint{
+		{Action: rec.Actions[5], Color: color.White, LineWidth: 1, Dashes: []vg.Length{2, 3}, Offset: 1},
+		{Action: rec.Actions[7], Color: color.Black, LineWidth: 1},
+	}
+	if !reflect.DeepEqual(paints, want) {
+		t.Errorf("unexpected paints:\ngot: %+v\nwant:%+v", paints, want)
+	}
+}
@@ -272,6 +272,123 @@ func (a *Scale) callerLocation() *callerLocation {
 	return &a.l
 }
 
+// SetLineCap corresponds to the vg.LineCapJoiner.SetLineCap method.
+type SetLineCap struct {
+	Cap vg.LineCap
+
+	l callerLocation
+}
+
+var _ vg.LineCapJoiner = (*Canvas)(nil)
+
+// SetLineCap implements the SetLineCap method of the vg.LineCapJoiner interface.
+func (c *Canvas) SetLineCap(lc vg.LineCap) {
+	c.append(&SetLineCap{Cap: lc})
+}
+
+// Call returns the method call that generated the action.
+func (a *SetLineCap) Call() string {
+	return fmt.Sprintf("%sSetLineCap(%v)", a.l, a.Cap)
+}
+
+// ApplyTo applies the action to the given vg.Canvas.
+func (a *SetLineCap) ApplyTo(c vg.Canvas) {
+	if l, ok := c.(vg.LineCapJoiner); ok {
+		l.SetLineCap(a.Cap)
+	}
+}
+
+func (a *SetLineCap) callerLocation() *callerLocation {
+	return &a.l
+}
+
+// SetLineJoin corresponds to the vg.LineCapJoiner.SetLineJoin method.
+type SetLineJoin struct {
+	Join vg.LineJoin
+
+	l callerLocation
+}
+
+// SetLineJoin implements the SetLineJoin method of the vg.LineCapJoiner interface.
+func (c *Canvas) SetLineJoin(lj vg.LineJoin) {
+	c.append(&SetLineJoin{Join: lj})
+}
+
+// Call returns the method call that generated the action.
+func (a *SetLineJoin) Call() string {
+	return fmt.Sprintf("%sSetLineJoin(%v)", a.l, a.Join)
+}
+
+// ApplyTo applies the action to the given vg.Canvas.
+func (a *SetLineJoin) ApplyTo(c vg.Canvas) {
+	if l, ok := c.(vg.LineCapJoiner); ok {
+		l.SetLineJoin(a.Join)
+	}
+}
+
+func (a *SetLineJoin) callerLocation() *callerLocation {
+	return &a.l
+}
+
+// Clip corresponds to the vg.Clipper.Clip method.
+type Clip struct {
+	Path vg.Path
+
+	l callerLocation
+}
+
+var _ vg.Clipper = (*Canvas)(nil)
+
+// Clip implements the Clip method of the vg.Clipper interface.
+func (c *Canvas) Clip(path vg.Path) {
+	c.append(&Clip{Path: append(vg.Path(nil), path...)})
+}
+
+// Call returns the method call that generated the action.
+func (a *Clip) Call() string {
+	return fmt.Sprintf("%sClip(%#v)", a.l, a.Path)
+}
+
+// ApplyTo applies the action to the given vg.Canvas.
+func (a *Clip) ApplyTo(c vg.Canvas) {
+	if cl, ok := c.(vg.Clipper); ok {
+		cl.Clip(a.Path)
+	}
+}
+
+func (a *Clip) callerLocation() *callerLocation {
+	return &a.l
+}
+
+// Transform corresponds to the vg.Transformer.Transform method.
+type Transform struct {
+	Matrix vg.Matrix
+
+	l callerLocation
+}
+
+var _ vg.Transformer = (*Canvas)(nil)
+
+// Transform implements the Transform method of the vg.Transformer interface.
+func (c *Canvas) Transform(m vg.Matrix) {
+	c.append(&Transform{Matrix: m})
+}
+
+// Call returns the method call that generated the action.
+func (a *Transform) Call() string {
+	m := a.Matrix
+	return fmt.Sprintf("%sTransform(%v, %v, %v, %v, %v, %v)", a.l, m.A, m.B, m.C, m.D, m.E, m.F)
+}
+
+// ApplyTo applies the action to the given vg.Canvas.
+func (a *Transform) ApplyTo(c vg.Canvas) {
+	vg.Transform(c, a.Matrix)
+}
+
+func (a *Transform) callerLocation() *callerLocation {
+	return &a.l
+}
+
 // Push corresponds to the vg.Canvas.Push method.
 type Push struct {
 	l callerLocation
@@ -478,3 +595,65 @@ func (a *Comment) ApplyTo(c vg.Canvas) {
 func (a *Comment) callerLocation() *callerLocation {
 	return &a.l
 }
+
+// Paint is a recorded drawing action, with the color and
+// the line style of the canvas when it was drawn.
+type Paint struct {
+	// Action is the *Stroke, *Fill, *FillString
+	// or *DrawImage action.
+	Action Action
+
+	// Color is the color of the canvas,
+	// nil if no color was set.
+	Color color.Color
+
+	// LineWidth, Dashes and Offset are the line
+	// width and the line dashes of the canvas.
+	LineWidth vg.Length
+	Dashes    []vg.Length
+	Offset    vg.Length
+}
+
+// Paints returns the drawing actions recorded by the Canvas,
+// in the order they were recorded, with the color and the line
+// style set by the previous actions, following the saving and
+// restoring of the graphics state by Push and Pop.
+func (c *Canvas) Paints() []Paint {
+	type state struct {
+		color  color.Color
+		width  vg.Length
+		dashes []vg.Length
+		offset vg.Length
+	}
+	var (
+		paints []Paint
+		cur    state
+		stack  []state
+	)
+	for _, a := range c.Actions {
+		switch a := a.(type) {
+		case *SetColor:
+			cur.color = a.Color
+		case *SetLineWidth:
+			cur.width = a.Width
+		case *SetLineDash:
+			cur.dashes, cur.offset = a.Dashes, a.Offsets
+		case *Push:
+			stack = append(stack, cur)
+		case *Pop:
+			if len(stack) != 0 {
+				cur = stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+			}
+		case *Stroke, *Fill, *FillString, *DrawImage:
+			paints = append(paints, Paint{
+				Action:    a,
+				Color:     cur.color,
+				LineWidth: cur.width,
+				Dashes:    cur.dashes,
+				Offset:    cur.offset,
+			})
+		}
+	}
+	return paints
+}
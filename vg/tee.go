@@ -74,6 +74,14 @@ func (tee teeCanvas) Scale(x, y float64) {
 	}
 }
 
+// Transform applies the affine transform m to the context
+// of each canvas, see vg.Transform.
+func (tee teeCanvas) Transform(m Matrix) {
+	for _, c := range tee.cs {
+		Transform(c, m)
+	}
+}
+
 // Push saves the current line width, the
 // current dash pattern, the current
 // transforms, and the current color
@@ -0,0 +1,111 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vg
+
+import "math"
+
+// Matrix is the affine transform matrix
+//
+//	| A C E |
+//	| B D F |
+//	| 0 0 1 |
+//
+// mapping the point (x, y) to (A*x + C*y + E, B*x + D*y + F),
+// following the convention of PostScript, PDF and SVG.
+type Matrix struct {
+	A, B, C, D float64
+	E, F       Length
+}
+
+// Identity returns the identity matrix.
+func Identity() Matrix {
+	return Matrix{A: 1, D: 1}
+}
+
+// Rotation returns the matrix of a counter-clockwise
+// rotation by the given angle, in radians.
+func Rotation(rad float64) Matrix {
+	sin, cos := math.Sincos(rad)
+	return Matrix{A: cos, B: sin, C: -sin, D: cos}
+}
+
+// Scaling returns the matrix of a scaling by x
+// horizontally and y vertically.
+func Scaling(x, y float64) Matrix {
+	return Matrix{A: x, D: y}
+}
+
+// Translation returns the matrix of a translation by pt.
+func Translation(pt Point) Matrix {
+	return Matrix{A: 1, D: 1, E: pt.X, F: pt.Y}
+}
+
+// Mul returns the product m×n, the transform
+// applying n and then m to points.
+//
+// Applying m and then n to a canvas, as with successive
+// calls to Translate, Rotate and Scale, is equivalent to
+// applying m.Mul(n).
+func (m Matrix) Mul(n Matrix) Matrix {
+	return Matrix{
+		A: m.A*n.A + m.C*n.B,
+		B: m.B*n.A + m.D*n.B,
+		C: m.A*n.C + m.C*n.D,
+		D: m.B*n.C + m.D*n.D,
+		E: Length(m.A)*n.E + Length(m.C)*n.F + m.E,
+		F: Length(m.B)*n.E + Length(m.D)*n.F + m.F,
+	}
+}
+
+// Apply returns the point pt transformed by m.
+func (m Matrix) Apply(pt Point) Point {
+	return Point{
+		X: Length(m.A)*pt.X + Length(m.C)*pt.Y + m.E,
+		Y: Length(m.B)*pt.X + Length(m.D)*pt.Y + m.F,
+	}
+}
+
+// Transformer is a Canvas supporting arbitrary affine transforms.
+type Transformer interface {
+	Canvas
+
+	// Transform applies the affine transform m to the
+	// context, following the transforms already applied.
+	Transform(m Matrix)
+}
+
+// Transform applies the affine transform m to the context of
+// the canvas. Like the transforms applied by the Rotate, Translate
+// and Scale methods, it is undone by the Pop matching the last
+// call to Push.
+//
+// If c is a Transformer, its Transform method is used. Otherwise
+// m is decomposed into a translation, a rotation, a scaling and
+// another rotation, applied with the methods of the Canvas.
+func Transform(c Canvas, m Matrix) {
+	if t, ok := c.(Transformer); ok {
+		t.Transform(m)
+		return
+	}
+
+	// Decompose the linear part of m as R(phi)×S(sx, sy)×R(theta),
+	// with the closed form singular value decomposition of 2×2
+	// matrices.
+	e := (m.A + m.D) / 2
+	f := (m.A - m.D) / 2
+	g := (m.B + m.C) / 2
+	h := (m.B - m.C) / 2
+	q := math.Hypot(e, h)
+	r := math.Hypot(f, g)
+	a1 := math.Atan2(g, f)
+	a2 := math.Atan2(h, e)
+	theta := (a2 - a1) / 2
+	phi := (a2 + a1) / 2
+
+	c.Translate(Point{X: m.E, Y: m.F})
+	c.Rotate(phi)
+	c.Scale(q+r, q-r)
+	c.Rotate(theta)
+}
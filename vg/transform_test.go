@@ -0,0 +1,89 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vg_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestMatrix(t *testing.T) {
+	// Rotate a quarter turn, double the width and
+	// translate, in the order of calls to a canvas.
+	m := vg.Translation(vg.Point{X: 10, Y: 20}).Mul(vg.Rotation(math.Pi / 2)).Mul(vg.Scaling(2, 1))
+	for _, test := range []struct {
+		pt, want vg.Point
+	}{
+		{pt: vg.Point{X: 0, Y: 0}, want: vg.Point{X: 10, Y: 20}},
+		{pt: vg.Point{X: 1, Y: 0}, want: vg.Point{X: 10, Y: 22}},
+		{pt: vg.Point{X: 0, Y: 1}, want: vg.Point{X: 9, Y: 20}},
+	} {
+		got := m.Apply(test.pt)
+		if !closePoint(got, test.want) {
+			t.Errorf("unexpected transform of %v: got:%v want:%v", test.pt, got, test.want)
+		}
+	}
+	if got := vg.Identity().Mul(m); got != m {
+		t.Errorf("unexpected product with identity: got:%v want:%v", got, m)
+	}
+}
+
+func TestTransformFallback(t *testing.T) {
+	for _, m := range []vg.Matrix{
+		vg.Identity(),
+		vg.Translation(vg.Point{X: 3, Y: -4}),
+		vg.Rotation(0.3).Mul(vg.Scaling(2, 0.5)),
+		vg.Scaling(-1, 1),
+		{A: 1, C: 0.5, D: 1, E: 1, F: 2},
+		{A: 0.2, B: -1.5, C: 3, D: 0.7, E: -5, F: 8},
+	} {
+		// Hide the Transform method of the recorder,
+		// so that the fallback is used.
+		var rec recorder.Canvas
+		vg.Transform(struct{ vg.Canvas }{&rec}, m)
+
+		got := vg.Identity()
+		for _, a := range rec.Actions {
+			switch a := a.(type) {
+			case *recorder.Translate:
+				got = got.Mul(vg.Translation(a.Point))
+			case *recorder.Rotate:
+				got = got.Mul(vg.Rotation(a.Angle))
+			case *recorder.Scale:
+				got = got.Mul(vg.Scaling(a.X, a.Y))
+			default:
+				t.Fatalf("unexpected action: %s", a.Call())
+			}
+		}
+		if !closeMatrix(got, m) {
+			t.Errorf("unexpected decomposition of %v: got:%v", m, got)
+		}
+	}
+
+	var rec recorder.Canvas
+	m := vg.Rotation(1)
+	vg.Transform(&rec, m)
+	if len(rec.Actions) != 1 || rec.Actions[0].(*recorder.Transform).Matrix != m {
+		t.Errorf("unexpected actions for a vg.Transformer: %v", rec.Actions)
+	}
+}
+
+func closePoint(a, b vg.Point) bool {
+	const tol = 1e-12
+	return math.Abs(float64(a.X-b.X)) < tol && math.Abs(float64(a.Y-b.Y)) < tol
+}
+
+func closeMatrix(a, b vg.Matrix) bool {
+	const tol = 1e-12
+	for _, d := range []float64{a.A - b.A, a.B - b.B, a.C - b.C, a.D - b.D, float64(a.E - b.E), float64(a.F - b.F)} {
+		if math.Abs(d) > tol {
+			return false
+		}
+	}
+	return true
+}
@@ -94,6 +94,96 @@ type CanvasWriterTo interface {
 	io.WriterTo
 }
 
+// Linker is a Canvas that supports hyperlinks.
+type Linker interface {
+	Canvas
+
+	// Link makes the rectangular region r, in the
+	// coordinates of the canvas, a hyperlink to url.
+	Link(r Rectangle, url string)
+}
+
+// Tooltipper is a Canvas that supports tooltips.
+type Tooltipper interface {
+	Canvas
+
+	// Tooltip attaches the text as a tooltip to everything
+	// drawn to the canvas until the next call to Pop.
+	Tooltip(text string)
+}
+
+// Grouper is a Canvas that supports named groups of
+// shapes, which can be styled by external style sheets.
+type Grouper interface {
+	Canvas
+
+	// Group groups everything drawn to the canvas until
+	// the next call to Pop, identifying the group by the
+	// id and by the space-separated class names, either
+	// of which may be empty.
+	Group(id, class string)
+}
+
+// LineCap is the shape of the ends of stroked paths.
+type LineCap int
+
+const (
+	// ButtCap ends stroked paths squarely at their end points.
+	ButtCap LineCap = iota
+
+	// RoundCap ends stroked paths with half circles
+	// centered on their end points.
+	RoundCap
+
+	// SquareCap ends stroked paths squarely, half the
+	// line width beyond their end points.
+	SquareCap
+)
+
+// LineJoin is the shape of the corners of stroked paths.
+type LineJoin int
+
+const (
+	// MiterJoin joins the segments of stroked paths
+	// with sharp corners.
+	MiterJoin LineJoin = iota
+
+	// RoundJoin joins the segments of stroked paths
+	// with rounded corners.
+	RoundJoin
+
+	// BevelJoin joins the segments of stroked paths
+	// with corners cut off squarely.
+	BevelJoin
+)
+
+// LineCapJoiner is a Canvas that supports line cap
+// and join styles. The styles are part of the context
+// of the canvas, and are restored by Pop.
+type LineCapJoiner interface {
+	Canvas
+
+	// SetLineCap sets the shape of the ends
+	// of stroked paths.
+	SetLineCap(LineCap)
+
+	// SetLineJoin sets the shape of the corners
+	// of stroked paths.
+	SetLineJoin(LineJoin)
+}
+
+// Clipper is a Canvas that can restrict drawing to a region.
+type Clipper interface {
+	Canvas
+
+	// Clip restricts the subsequent drawing operations
+	// to the interior of the path, intersected with the
+	// current clipping region. The clipping region is
+	// part of the context of the canvas, and is restored
+	// by Pop.
+	Clip(Path)
+}
+
 // Initialize sets all of the canvas's values to their
 // initial values.
 func Initialize(c Canvas) {
@@ -102,6 +192,22 @@ func Initialize(c Canvas) {
 	c.SetColor(color.Black)
 }
 
+// ScaleDashes returns the absolute dash pattern and offset, for
+// SetLineDash, of a line of the given width with a dash pattern
+// and offset given as multiples of the width of the line. Such
+// dashes keep the same look when the width of the line changes.
+// A solid pattern is returned if the width is not positive.
+func ScaleDashes(pattern []Length, offset, width Length) ([]Length, Length) {
+	if width <= 0 {
+		return []Length{}, 0
+	}
+	dashes := make([]Length, len(pattern))
+	for i, d := range pattern {
+		dashes[i] = d * width
+	}
+	return dashes, offset * width
+}
+
 type Path []PathComp
 
 // Move moves the current location of the path to
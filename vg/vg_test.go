@@ -10,6 +10,7 @@ import (
 	"io/ioutil"
 	"log"
 	"path/filepath"
+	"reflect"
 	"testing"
 
 	"gonum.org/v1/plot"
@@ -165,6 +166,30 @@ func TestParseLength(t *testing.T) {
 		}
 	}
 }
+
+func TestScaleDashes(t *testing.T) {
+	pattern := []vg.Length{4, 2, 1, 2}
+	for _, test := range []struct {
+		width      vg.Length
+		wantDashes []vg.Length
+		wantOffs   vg.Length
+	}{
+		{width: 1, wantDashes: []vg.Length{4, 2, 1, 2}, wantOffs: 0.5},
+		{width: 2.5, wantDashes: []vg.Length{10, 5, 2.5, 5}, wantOffs: 1.25},
+		{width: 0, wantDashes: []vg.Length{}, wantOffs: 0},
+	} {
+		dashes, offs := vg.ScaleDashes(pattern, 0.5, test.width)
+		if !reflect.DeepEqual(dashes, test.wantDashes) || offs != test.wantOffs {
+			t.Errorf("width=%v: got dashes=%v offset=%v, want dashes=%v offset=%v",
+				test.width, dashes, offs, test.wantDashes, test.wantOffs,
+			)
+		}
+	}
+	if want := []vg.Length{4, 2, 1, 2}; !reflect.DeepEqual(pattern, want) {
+		t.Errorf("pattern modified: got %v, want %v", pattern, want)
+	}
+}
+
 func TestInMemoryCanvas(t *testing.T) {
 	cmpimg.CheckPlot(Example_inMemoryCanvas, t, "sine.png")
 }
@@ -26,6 +26,16 @@ type Canvas struct {
 	stack []context
 	w, h  vg.Length
 	buf   *bytes.Buffer
+
+	// title and created are the title and the
+	// creation date written in the header.
+	title   string
+	created time.Time
+
+	// bounds is the extent of the drawing so far,
+	// valid if drawn is true.
+	bounds vg.Rectangle
+	drawn  bool
 }
 
 type context struct {
@@ -35,6 +45,9 @@ type context struct {
 	offs   vg.Length
 	font   string
 	fsize  vg.Length
+
+	// m is the current transformation matrix.
+	m vg.Matrix
 }
 
 // pr is the amount of precision to use when outputting float64s.
@@ -46,27 +59,95 @@ func New(w, h vg.Length) *Canvas {
 }
 
 // NewTitle returns a new Canvas with the given title string.
+//
+// The bounding box written in the header of the EPS is the
+// extent of the drawing, as bounded by the canvas.
 func NewTitle(w, h vg.Length, title string) *Canvas {
 	c := &Canvas{
-		stack: []context{{}},
-		w:     w,
-		h:     h,
-		buf:   new(bytes.Buffer),
-	}
-	c.buf.WriteString("%%!PS-Adobe-3.0 EPSF-3.0\n")
-	c.buf.WriteString("%%Creator gonum.org/v1/plot/vg/vgeps\n")
-	c.buf.WriteString("%%Title: " + title + "\n")
-	c.buf.WriteString(fmt.Sprintf("%%%%BoundingBox: 0 0 %.*g %.*g\n",
-		pr, w.Dots(DPI),
-		pr, h.Dots(DPI)))
-	c.buf.WriteString(fmt.Sprintf("%%%%CreationDate: %s\n", time.Now()))
-	c.buf.WriteString("%%Orientation: Portrait\n")
-	c.buf.WriteString("%%EndComments\n")
-	c.buf.WriteString("\n")
+		stack:   []context{{m: vg.Identity()}},
+		w:       w,
+		h:       h,
+		buf:     new(bytes.Buffer),
+		title:   title,
+		created: time.Now(),
+	}
 	vg.Initialize(c)
 	return c
 }
 
+// include extends the bounds of the drawing to include the
+// point pt of the current user space, widened by r in user
+// space units.
+func (e *Canvas) include(pt vg.Point, r vg.Length) {
+	m := e.context().m
+	pt = m.Apply(pt)
+	r *= vg.Length(math.Sqrt(math.Abs(m.A*m.D - m.B*m.C)))
+	min := vg.Point{X: pt.X - r, Y: pt.Y - r}
+	max := vg.Point{X: pt.X + r, Y: pt.Y + r}
+	if !e.drawn {
+		e.bounds = vg.Rectangle{Min: min, Max: max}
+		e.drawn = true
+		return
+	}
+	e.bounds.Min.X = vg.Length(math.Min(float64(e.bounds.Min.X), float64(min.X)))
+	e.bounds.Min.Y = vg.Length(math.Min(float64(e.bounds.Min.Y), float64(min.Y)))
+	e.bounds.Max.X = vg.Length(math.Max(float64(e.bounds.Max.X), float64(max.X)))
+	e.bounds.Max.Y = vg.Length(math.Max(float64(e.bounds.Max.Y), float64(max.Y)))
+}
+
+// includePath extends the bounds of the drawing to include
+// the path, widened by r in user space units. Curves are
+// bounded by their control points.
+func (e *Canvas) includePath(path vg.Path, r vg.Length) {
+	for _, comp := range path {
+		switch comp.Type {
+		case vg.MoveComp, vg.LineComp:
+			e.include(comp.Pos, r)
+		case vg.ArcComp:
+			// Include the ends of the arc and the
+			// extreme points of the circle on it.
+			at := func(a float64) vg.Point {
+				sin, cos := math.Sincos(a)
+				return vg.Point{
+					X: comp.Pos.X + comp.Radius*vg.Length(cos),
+					Y: comp.Pos.Y + comp.Radius*vg.Length(sin),
+				}
+			}
+			start, end := comp.Start, comp.Start+comp.Angle
+			if end < start {
+				start, end = end, start
+			}
+			e.include(at(start), r)
+			e.include(at(end), r)
+			for a := math.Ceil(start/(math.Pi/2)) * math.Pi / 2; a < end; a += math.Pi / 2 {
+				e.include(at(a), r)
+			}
+		case vg.CurveComp:
+			for _, pt := range comp.Control {
+				e.include(pt, r)
+			}
+			e.include(comp.Pos, r)
+		}
+	}
+}
+
+// bbox returns the bounds of the drawing within
+// the canvas. The boolean is false if nothing was
+// drawn on the canvas.
+func (e *Canvas) bbox() (vg.Rectangle, bool) {
+	if !e.drawn {
+		return vg.Rectangle{}, false
+	}
+	clamp := func(v, max vg.Length) vg.Length {
+		return vg.Length(math.Max(0, math.Min(float64(max), float64(v))))
+	}
+	b := vg.Rectangle{
+		Min: vg.Point{X: clamp(e.bounds.Min.X, e.w), Y: clamp(e.bounds.Min.Y, e.h)},
+		Max: vg.Point{X: clamp(e.bounds.Max.X, e.w), Y: clamp(e.bounds.Max.Y, e.h)},
+	}
+	return b, true
+}
+
 func (c *Canvas) Size() (w, h vg.Length) {
 	return c.w, c.h
 }
@@ -83,6 +164,18 @@ func (e *Canvas) SetLineWidth(w vg.Length) {
 	}
 }
 
+// SetLineCap implements the vg.LineCapJoiner interface.
+// The values of vg.LineCap and vg.LineJoin are those of
+// the PostScript operators.
+func (e *Canvas) SetLineCap(lc vg.LineCap) {
+	fmt.Fprintf(e.buf, "%d setlinecap\n", lc)
+}
+
+// SetLineJoin implements the vg.LineCapJoiner interface.
+func (e *Canvas) SetLineJoin(lj vg.LineJoin) {
+	fmt.Fprintf(e.buf, "%d setlinejoin\n", lj)
+}
+
 func (e *Canvas) SetLineDash(dashes []vg.Length, o vg.Length) {
 	cur := e.context().dashes
 	dashEq := len(dashes) == len(cur)
@@ -117,18 +210,29 @@ func (e *Canvas) SetColor(c color.Color) {
 }
 
 func (e *Canvas) Rotate(r float64) {
+	e.context().m = e.context().m.Mul(vg.Rotation(r))
 	fmt.Fprintf(e.buf, "%.*g rotate\n", pr, r*180/math.Pi)
 }
 
 func (e *Canvas) Translate(pt vg.Point) {
+	e.context().m = e.context().m.Mul(vg.Translation(pt))
 	fmt.Fprintf(e.buf, "%.*g %.*g translate\n",
 		pr, pt.X.Dots(DPI), pr, pt.Y.Dots(DPI))
 }
 
 func (e *Canvas) Scale(x, y float64) {
+	e.context().m = e.context().m.Mul(vg.Scaling(x, y))
 	fmt.Fprintf(e.buf, "%.*g %.*g scale\n", pr, x, pr, y)
 }
 
+// Transform applies the affine transform m to the context,
+// implementing the vg.Transformer interface.
+func (e *Canvas) Transform(m vg.Matrix) {
+	e.context().m = e.context().m.Mul(m)
+	fmt.Fprintf(e.buf, "[%.*g %.*g %.*g %.*g %.*g %.*g] concat\n",
+		pr, m.A, pr, m.B, pr, m.C, pr, m.D, pr, m.E.Dots(DPI), pr, m.F.Dots(DPI))
+}
+
 func (e *Canvas) Push() {
 	e.stack = append(e.stack, *e.context())
 	e.buf.WriteString("gsave\n")
@@ -139,15 +243,23 @@ func (e *Canvas) Pop() {
 	e.buf.WriteString("grestore\n")
 }
 
+// Clip implements the vg.Clipper interface.
+func (e *Canvas) Clip(path vg.Path) {
+	e.trace(path)
+	e.buf.WriteString("clip\nnewpath\n")
+}
+
 func (e *Canvas) Stroke(path vg.Path) {
 	if e.context().width <= 0 {
 		return
 	}
+	e.includePath(path, e.context().width/2)
 	e.trace(path)
 	e.buf.WriteString("stroke\n")
 }
 
 func (e *Canvas) Fill(path vg.Path) {
+	e.includePath(path, 0)
 	e.trace(path)
 	e.buf.WriteString("fill\n")
 }
@@ -200,22 +312,122 @@ func (e *Canvas) FillString(fnt vg.Font, pt vg.Point, str string) {
 	}
 	fmt.Fprintf(e.buf, "%.*g %.*g moveto\n", pr, pt.X.Dots(DPI), pr, pt.Y.Dots(DPI))
 	fmt.Fprintf(e.buf, "(%s) show\n", str)
+
+	ext := fnt.TextExtents(str)
+	for _, off := range []vg.Point{
+		{Y: ext.Descent},
+		{X: ext.Width, Y: ext.Descent},
+		{X: ext.Width, Y: ext.Ascent},
+		{Y: ext.Ascent},
+	} {
+		e.include(pt.Add(off), 0)
+	}
 }
 
 // DrawImage implements the vg.Canvas.DrawImage method.
-func (c *Canvas) DrawImage(rect vg.Rectangle, img image.Image) {
-	// FIXME: https://github.com/gonum/plot/issues/271
-	panic("vgeps: DrawImage not implemented")
+// The image is written as hex encoded RGB samples for the
+// colorimage operator. Since PostScript has no transparency,
+// translucent pixels are flattened against white.
+func (e *Canvas) DrawImage(rect vg.Rectangle, img image.Image) {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= 0 || h <= 0 {
+		return
+	}
+
+	for _, pt := range []vg.Point{
+		rect.Min,
+		{X: rect.Max.X, Y: rect.Min.Y},
+		rect.Max,
+		{X: rect.Min.X, Y: rect.Max.Y},
+	} {
+		e.include(pt, 0)
+	}
+
+	e.buf.WriteString("gsave\n")
+	fmt.Fprintf(e.buf, "%.*g %.*g translate\n",
+		pr, rect.Min.X.Dots(DPI), pr, rect.Min.Y.Dots(DPI))
+	fmt.Fprintf(e.buf, "%.*g %.*g scale\n",
+		pr, rect.Size().X.Dots(DPI), pr, rect.Size().Y.Dots(DPI))
+	// Map the unit square to the image, with its first row at the top.
+	fmt.Fprintf(e.buf, "%d %d 8 [%d 0 0 %d 0 %d]\n", w, h, w, -h, h)
+	e.buf.WriteString("currentfile /ASCIIHexDecode filter false 3 colorimage\n")
+
+	const (
+		hex = "0123456789abcdef"
+		// lineLen is the number of pixels per line of data,
+		// keeping lines shorter than 255 characters.
+		lineLen = 40
+	)
+	n := 0
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			// RGBA returns alpha-premultiplied values,
+			// so compositing over white adds the
+			// complement of alpha to each channel.
+			r, g, bl, a := img.At(x, y).RGBA()
+			for _, v := range [3]uint32{r, g, bl} {
+				v = (v + math.MaxUint16 - a) >> 8
+				e.buf.WriteByte(hex[v>>4])
+				e.buf.WriteByte(hex[v&0xf])
+			}
+			n++
+			if n%lineLen == 0 {
+				e.buf.WriteByte('\n')
+			}
+		}
+	}
+	e.buf.WriteString(">\n")
+	e.buf.WriteString("grestore\n")
 }
 
 // WriteTo writes the canvas to an io.Writer.
+//
+// The header of the EPS holds the %%BoundingBox of the drawing,
+// in integer points, and its %%HiResBoundingBox. The bounding
+// boxes of an empty canvas are empty, at the origin.
 func (e *Canvas) WriteTo(w io.Writer) (int64, error) {
 	b := bufio.NewWriter(w)
-	n, err := e.buf.WriteTo(b)
+
+	box, ok := e.bbox()
+	var lo, hi image.Point
+	if ok {
+		lo = image.Point{
+			X: int(math.Floor(box.Min.X.Dots(DPI))),
+			Y: int(math.Floor(box.Min.Y.Dots(DPI))),
+		}
+		hi = image.Point{
+			X: int(math.Ceil(box.Max.X.Dots(DPI))),
+			Y: int(math.Ceil(box.Max.Y.Dots(DPI))),
+		}
+	}
+	m, err := fmt.Fprintf(b, `%%!PS-Adobe-3.0 EPSF-3.0
+%%%%Creator gonum.org/v1/plot/vg/vgeps
+%%%%Title: %s
+%%%%BoundingBox: %d %d %d %d
+%%%%HiResBoundingBox: %.*g %.*g %.*g %.*g
+%%%%CreationDate: %s
+%%%%Orientation: Portrait
+%%%%EndComments
+
+`,
+		e.title,
+		lo.X, lo.Y, hi.X, hi.Y,
+		pr, box.Min.X.Dots(DPI), pr, box.Min.Y.Dots(DPI),
+		pr, box.Max.X.Dots(DPI), pr, box.Max.Y.Dots(DPI),
+		e.created,
+	)
+	n := int64(m)
+	if err != nil {
+		return n, err
+	}
+
+	k, err := e.buf.WriteTo(b)
+	n += k
 	if err != nil {
 		return n, err
 	}
-	m, err := fmt.Fprintln(b, "showpage")
+	m, err = fmt.Fprintln(b, "showpage")
 	n += int64(m)
 	if err != nil {
 		return n, err
@@ -0,0 +1,140 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vgeps_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math"
+	"strings"
+	"testing"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/vgeps"
+)
+
+func TestDrawImage(t *testing.T) {
+	// A horizontal gradient from black to red, with
+	// a transparent last row flattened against white.
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 3))
+	for x := 0; x < 4; x++ {
+		for y := 0; y < 2; y++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x * 85), A: 255})
+		}
+		img.Set(x, 2, color.NRGBA{})
+	}
+
+	c := vgeps.New(5*vg.Centimeter, 5*vg.Centimeter)
+	c.DrawImage(vg.Rectangle{
+		Min: vg.Point{X: 10, Y: 20},
+		Max: vg.Point{X: 50, Y: 50},
+	}, img)
+
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	eps := buf.String()
+
+	for _, want := range []string{
+		"10 20 translate\n40 30 scale\n",
+		"4 3 8 [4 0 0 -3 0 3]\n",
+		"currentfile /ASCIIHexDecode filter false 3 colorimage\n",
+		"000000550000aa0000ff0000" +
+			"000000550000aa0000ff0000" +
+			"ffffffffffffffffffffffff>\n",
+	} {
+		if !strings.Contains(eps, want) {
+			t.Errorf("EPS does not contain %q:\n%s", want, eps)
+		}
+	}
+}
+
+func TestBoundingBox(t *testing.T) {
+	rect := func(x0, y0, x1, y1 vg.Length) vg.Path {
+		var p vg.Path
+		p.Move(vg.Point{X: x0, Y: y0})
+		p.Line(vg.Point{X: x1, Y: y0})
+		p.Line(vg.Point{X: x1, Y: y1})
+		p.Line(vg.Point{X: x0, Y: y1})
+		p.Close()
+		return p
+	}
+
+	for _, test := range []struct {
+		name      string
+		draw      func(c *vgeps.Canvas)
+		want      string
+		wantHiRes string
+	}{
+		{
+			name:      "empty",
+			draw:      func(c *vgeps.Canvas) {},
+			want:      "0 0 0 0",
+			wantHiRes: "0 0 0 0",
+		},
+		{
+			name: "fill",
+			draw: func(c *vgeps.Canvas) {
+				c.Fill(rect(20.5, 30, 60.25, 70))
+			},
+			want:      "20 30 61 70",
+			wantHiRes: "20.5 30 60.25 70",
+		},
+		{
+			name: "translated stroke",
+			draw: func(c *vgeps.Canvas) {
+				c.Translate(vg.Point{X: 10, Y: 5})
+				c.SetLineWidth(2)
+				c.Stroke(rect(20, 30, 40, 50))
+			},
+			want:      "29 34 51 56",
+			wantHiRes: "29 34 51 56",
+		},
+		{
+			name: "scaled arc",
+			draw: func(c *vgeps.Canvas) {
+				c.Push()
+				c.Translate(vg.Point{X: 50, Y: 50})
+				c.Scale(2, 2)
+				var p vg.Path
+				p.Move(vg.Point{X: 10})
+				p.Arc(vg.Point{}, 10, 0, math.Pi/2)
+				c.Fill(p)
+				c.Pop()
+			},
+			want:      "50 50 70 70",
+			wantHiRes: "50 50 70 70",
+		},
+		{
+			name: "clamped",
+			draw: func(c *vgeps.Canvas) {
+				c.Fill(rect(-10, 90, 50, 120))
+			},
+			want:      "0 90 50 100",
+			wantHiRes: "0 90 50 100",
+		},
+	} {
+		c := vgeps.New(100, 100)
+		test.draw(c)
+		var buf bytes.Buffer
+		if _, err := c.WriteTo(&buf); err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		eps := buf.String()
+		if !strings.HasPrefix(eps, "%!PS-Adobe-3.0 EPSF-3.0\n") {
+			t.Errorf("%s: unexpected first line: %q", test.name, strings.SplitN(eps, "\n", 2)[0])
+		}
+		for _, want := range []string{
+			"%%BoundingBox: " + test.want + "\n",
+			"%%HiResBoundingBox: " + test.wantHiRes + "\n",
+		} {
+			if !strings.Contains(eps, want) {
+				t.Errorf("%s: EPS does not contain %q:\n%s", test.name, want, eps)
+			}
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"image/jpeg"
 	"image/png"
 	"io"
+	"math"
 
 	"github.com/fogleman/gg"
 	"golang.org/x/image/tiff"
@@ -22,6 +23,13 @@ import (
 	"gonum.org/v1/plot/vg"
 )
 
+var (
+	_ vg.Clipper        = (*Canvas)(nil)
+	_ vg.GradientFiller = (*Canvas)(nil)
+	_ vg.LineCapJoiner  = (*Canvas)(nil)
+	_ vg.PatternFiller  = (*Canvas)(nil)
+)
+
 // Canvas implements the vg.Canvas interface,
 // drawing to an image.Image using draw2d.
 type Canvas struct {
@@ -39,6 +47,16 @@ type Canvas struct {
 	// backgroundColor is the background color, set by
 	// UseBackgroundColor.
 	backgroundColor color.Color
+
+	// antialias specifies whether paths are drawn
+	// with anti-aliasing, set by UseAntialiasing.
+	antialias bool
+
+	// scratch is the context paths are drawn to when
+	// anti-aliasing is disabled, before being composited
+	// onto the image. Its transformation and line style
+	// are kept in sync with those of ctx.
+	scratch *gg.Context
 }
 
 const (
@@ -59,7 +77,8 @@ func New(w, h vg.Length) *Canvas {
 
 // NewWith returns a new image canvas created according to the specified
 // options. The currently accepted options are UseWH,
-// UseDPI, UseImage, and UseImageWithContext.
+// UseDPI, UseImage, UseImageWithContext, UseBackgroundColor
+// and UseAntialiasing.
 // Each of the options specifies the size of the canvas (UseWH, UseImage),
 // the resolution of the canvas (UseDPI), or both (useImageWithContext).
 // If size or resolution are not specified, defaults are used.
@@ -68,6 +87,7 @@ func New(w, h vg.Length) *Canvas {
 func NewWith(o ...option) *Canvas {
 	c := new(Canvas)
 	c.backgroundColor = color.White
+	c.antialias = true
 	var g uint32
 	for _, opt := range o {
 		f := opt(c)
@@ -101,6 +121,13 @@ func NewWith(o ...option) *Canvas {
 		c.img = c.ctx.Image().(draw.Image)
 		c.ctx.InvertY()
 	}
+	if !c.antialias {
+		b := c.img.Bounds()
+		c.scratch = gg.NewContext(b.Dx(), b.Dy())
+		c.scratch.SetLineCapButt()
+		c.scratch.InvertY()
+		c.scratch.SetColor(color.Black)
+	}
 	draw.Draw(c.img, c.img.Bounds(), &image.Uniform{c.backgroundColor}, image.Point{}, draw.Src)
 	c.color = []color.Color{color.Black}
 	vg.Initialize(c)
@@ -114,6 +141,7 @@ const (
 	setsDPI uint32 = 1 << iota
 	setsSize
 	setsBackground
+	setsAntialiasing
 )
 
 type option func(*Canvas) uint32
@@ -132,6 +160,12 @@ func UseWH(w, h vg.Length) option {
 
 // UseDPI sets the dots per inch of a canvas. It should only be
 // used as an option argument when initializing a new canvas.
+//
+// The size of the image of a canvas of a given width and height
+// in pixels scales with its resolution, while lengths, such as
+// line widths and font sizes, keep their physical size, so that
+// a plot can be rendered for the screen or for print at the same
+// size by only changing its resolution.
 func UseDPI(dpi int) option {
 	if dpi <= 0 {
 		panic("DPI must be > 0.")
@@ -178,6 +212,23 @@ func UseBackgroundColor(c color.Color) option {
 	}
 }
 
+// UseAntialiasing specifies whether paths are drawn with
+// anti-aliasing. Without UseAntialiasing, paths are anti-aliased.
+//
+// When anti-aliasing is disabled, each pixel is either fully
+// covered by a path or not at all, so that horizontal and
+// vertical lines, such as grid lines and the edges of heat map
+// cells, are drawn crisply. Lines are drawn at least one pixel
+// wide. Text is always anti-aliased.
+// Disabling anti-aliasing is not supported with
+// UseImageWithContext if the given context is transformed.
+func UseAntialiasing(v bool) option {
+	return func(c *Canvas) uint32 {
+		c.antialias = v
+		return setsAntialiasing
+	}
+}
+
 // Image returns the image the canvas is drawing to.
 //
 // The dimensions of the returned image must not be modified.
@@ -192,6 +243,11 @@ func (c *Canvas) Size() (w, h vg.Length) {
 func (c *Canvas) SetLineWidth(w vg.Length) {
 	c.width = w
 	c.ctx.SetLineWidth(w.Dots(c.DPI()))
+	if c.scratch != nil {
+		// Lines thinner than a pixel would vanish
+		// without anti-aliasing.
+		c.scratch.SetLineWidth(math.Max(w.Dots(c.DPI()), 1))
+	}
 }
 
 func (c *Canvas) SetLineDash(ds []vg.Length, offs vg.Length) {
@@ -201,6 +257,39 @@ func (c *Canvas) SetLineDash(ds []vg.Length, offs vg.Length) {
 	}
 	c.ctx.SetDashOffset(offs.Dots(c.DPI()))
 	c.ctx.SetDash(dashes...)
+	if c.scratch != nil {
+		c.scratch.SetDashOffset(offs.Dots(c.DPI()))
+		c.scratch.SetDash(dashes...)
+	}
+}
+
+// SetLineCap implements the vg.LineCapJoiner interface.
+func (c *Canvas) SetLineCap(lc vg.LineCap) {
+	ggc := gg.LineCapButt
+	switch lc {
+	case vg.RoundCap:
+		ggc = gg.LineCapRound
+	case vg.SquareCap:
+		ggc = gg.LineCapSquare
+	}
+	c.ctx.SetLineCap(ggc)
+	if c.scratch != nil {
+		c.scratch.SetLineCap(ggc)
+	}
+}
+
+// SetLineJoin implements the vg.LineCapJoiner interface.
+// Miter joins are drawn as bevel joins, as gg does not
+// support them.
+func (c *Canvas) SetLineJoin(lj vg.LineJoin) {
+	ggj := gg.LineJoinBevel
+	if lj == vg.RoundJoin {
+		ggj = gg.LineJoinRound
+	}
+	c.ctx.SetLineJoin(ggj)
+	if c.scratch != nil {
+		c.scratch.SetLineJoin(ggj)
+	}
 }
 
 func (c *Canvas) SetColor(clr color.Color) {
@@ -213,50 +302,188 @@ func (c *Canvas) SetColor(clr color.Color) {
 
 func (c *Canvas) Rotate(t float64) {
 	c.ctx.Rotate(t)
+	if c.scratch != nil {
+		c.scratch.Rotate(t)
+	}
 }
 
 func (c *Canvas) Translate(pt vg.Point) {
 	c.ctx.Translate(pt.X.Dots(c.DPI()), pt.Y.Dots(c.DPI()))
+	if c.scratch != nil {
+		c.scratch.Translate(pt.X.Dots(c.DPI()), pt.Y.Dots(c.DPI()))
+	}
 }
 
 func (c *Canvas) Scale(x, y float64) {
 	c.ctx.Scale(x, y)
+	if c.scratch != nil {
+		c.scratch.Scale(x, y)
+	}
 }
 
 func (c *Canvas) Push() {
 	c.color = append(c.color, c.color[len(c.color)-1])
 	c.ctx.Push()
+	if c.scratch != nil {
+		c.scratch.Push()
+	}
 }
 
 func (c *Canvas) Pop() {
 	c.color = c.color[:len(c.color)-1]
 	c.ctx.Pop()
+	if c.scratch != nil {
+		c.scratch.Pop()
+	}
+}
+
+// Clip implements the vg.Clipper interface.
+func (c *Canvas) Clip(p vg.Path) {
+	c.outline(c.ctx, p)
+	c.ctx.Clip()
+	if c.scratch != nil {
+		c.outline(c.scratch, p)
+		c.scratch.Clip()
+	}
 }
 
 func (c *Canvas) Stroke(p vg.Path) {
 	if c.width <= 0 {
 		return
 	}
-	c.outline(p)
+	if c.scratch != nil {
+		c.outline(c.scratch, p)
+		c.scratch.Stroke()
+		c.composite(p, c.width)
+		return
+	}
+	c.outline(c.ctx, p)
 	c.ctx.Stroke()
 }
 
 func (c *Canvas) Fill(p vg.Path) {
-	c.outline(p)
+	if c.scratch != nil {
+		c.outline(c.scratch, p)
+		c.scratch.Fill()
+		c.composite(p, 0)
+		return
+	}
+	c.outline(c.ctx, p)
+	c.ctx.Fill()
+}
+
+// FillPattern fills the path with the pattern, implementing
+// the vg.PatternFiller interface. The cells of the pattern are
+// aligned with the pixels of the image, starting from its top
+// left corner. The path is always filled with anti-aliasing.
+func (c *Canvas) FillPattern(p vg.Path, pat vg.Pattern) {
+	sz := pat.Size()
+	cell := NewWith(UseWH(sz.X, sz.Y), UseDPI(c.dpi), UseBackgroundColor(color.Transparent))
+	pat.DrawCell(cell)
+
+	c.outline(c.ctx, p)
+	c.ctx.SetFillStyle(gg.NewSurfacePattern(cell.Image(), gg.RepeatBoth))
 	c.ctx.Fill()
+	c.ctx.SetColor(c.color[len(c.color)-1])
+}
+
+// FillGradient implements the vg.GradientFiller interface.
+func (c *Canvas) FillGradient(p vg.Path, g vg.LinearGradient) {
+	// Gradients are defined in the coordinates of the image.
+	x0, y0 := c.ctx.TransformPoint(g.Start.X.Dots(c.DPI()), g.Start.Y.Dots(c.DPI()))
+	x1, y1 := c.ctx.TransformPoint(g.End.X.Dots(c.DPI()), g.End.Y.Dots(c.DPI()))
+	grad := gg.NewLinearGradient(x0, y0, x1, y1)
+	for _, s := range g.Stops {
+		grad.AddColorStop(s.Offset, s.Color)
+	}
+
+	c.outline(c.ctx, p)
+	c.ctx.SetFillStyle(grad)
+	c.ctx.Fill()
+	c.ctx.SetColor(c.color[len(c.color)-1])
+}
+
+// composite draws the path p, drawn with the given line width
+// to the scratch context, onto the image in the current color.
+// The pixels that are at least half covered by the path are
+// fully painted, the others are left untouched. The scratch
+// context is cleared afterwards.
+func (c *Canvas) composite(p vg.Path, width vg.Length) {
+	src := c.scratch.Image().(*image.RGBA)
+	r := c.bounds(p, width).Intersect(src.Bounds())
+	if r.Empty() {
+		return
+	}
+	mask := image.NewAlpha(r)
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			if src.RGBAAt(x, y).A >= 0x80 {
+				mask.SetAlpha(x, y, color.Alpha{A: 0xff})
+			}
+		}
+	}
+	draw.Draw(src, r, image.Transparent, image.Point{}, draw.Src)
+	draw.DrawMask(c.img, r, image.NewUniform(c.color[len(c.color)-1]), image.Point{}, mask, r.Min, draw.Over)
+}
+
+// bounds returns the pixel bounds of the path p, drawn with
+// the given line width, in the image of the scratch context.
+func (c *Canvas) bounds(p vg.Path, width vg.Length) image.Rectangle {
+	dpi := c.DPI()
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	add := func(x, y vg.Length) {
+		tx, ty := c.scratch.TransformPoint(x.Dots(dpi), y.Dots(dpi))
+		minX, maxX = math.Min(minX, tx), math.Max(maxX, tx)
+		minY, maxY = math.Min(minY, ty), math.Max(maxY, ty)
+	}
+	for _, comp := range p {
+		switch comp.Type {
+		case vg.MoveComp, vg.LineComp:
+			add(comp.Pos.X, comp.Pos.Y)
+		case vg.ArcComp:
+			// Bound the arc by the square enclosing its circle.
+			r := comp.Radius
+			add(comp.Pos.X-r, comp.Pos.Y-r)
+			add(comp.Pos.X+r, comp.Pos.Y-r)
+			add(comp.Pos.X-r, comp.Pos.Y+r)
+			add(comp.Pos.X+r, comp.Pos.Y+r)
+		case vg.CurveComp:
+			// Curves lie within the hull of their control points.
+			add(comp.Pos.X, comp.Pos.Y)
+			for _, pt := range comp.Control {
+				add(pt.X, pt.Y)
+			}
+		}
+	}
+	if minX > maxX {
+		return image.Rectangle{}
+	}
+
+	// Pad the bounds by the line width, scaled by the
+	// transformation, to account for joins and caps.
+	x0, y0 := c.scratch.TransformPoint(0, 0)
+	x1, y1 := c.scratch.TransformPoint(1, 0)
+	x2, y2 := c.scratch.TransformPoint(0, 1)
+	scale := math.Max(math.Hypot(x1-x0, y1-y0), math.Hypot(x2-x0, y2-y0))
+	pad := math.Max(width.Dots(dpi), 1)*scale + 1
+	return image.Rect(
+		int(math.Floor(minX-pad)), int(math.Floor(minY-pad)),
+		int(math.Ceil(maxX+pad)), int(math.Ceil(maxY+pad)),
+	)
 }
 
-func (c *Canvas) outline(p vg.Path) {
+func (c *Canvas) outline(ctx *gg.Context, p vg.Path) {
 	for _, comp := range p {
 		switch comp.Type {
 		case vg.MoveComp:
-			c.ctx.MoveTo(comp.Pos.X.Dots(c.DPI()), comp.Pos.Y.Dots(c.DPI()))
+			ctx.MoveTo(comp.Pos.X.Dots(c.DPI()), comp.Pos.Y.Dots(c.DPI()))
 
 		case vg.LineComp:
-			c.ctx.LineTo(comp.Pos.X.Dots(c.DPI()), comp.Pos.Y.Dots(c.DPI()))
+			ctx.LineTo(comp.Pos.X.Dots(c.DPI()), comp.Pos.Y.Dots(c.DPI()))
 
 		case vg.ArcComp:
-			c.ctx.DrawArc(comp.Pos.X.Dots(c.DPI()), comp.Pos.Y.Dots(c.DPI()),
+			ctx.DrawArc(comp.Pos.X.Dots(c.DPI()), comp.Pos.Y.Dots(c.DPI()),
 				comp.Radius.Dots(c.DPI()),
 				comp.Start, comp.Start+comp.Angle,
 			)
@@ -264,12 +491,12 @@ func (c *Canvas) outline(p vg.Path) {
 		case vg.CurveComp:
 			switch len(comp.Control) {
 			case 1:
-				c.ctx.QuadraticTo(
+				ctx.QuadraticTo(
 					comp.Control[0].X.Dots(c.DPI()), comp.Control[0].Y.Dots(c.DPI()),
 					comp.Pos.X.Dots(c.DPI()), comp.Pos.Y.Dots(c.DPI()),
 				)
 			case 2:
-				c.ctx.CubicTo(
+				ctx.CubicTo(
 					comp.Control[0].X.Dots(c.DPI()), comp.Control[0].Y.Dots(c.DPI()),
 					comp.Control[1].X.Dots(c.DPI()), comp.Control[1].Y.Dots(c.DPI()),
 					comp.Pos.X.Dots(c.DPI()), comp.Pos.Y.Dots(c.DPI()),
@@ -279,7 +506,7 @@ func (c *Canvas) outline(p vg.Path) {
 			}
 
 		case vg.CloseComp:
-			c.ctx.ClosePath()
+			ctx.ClosePath()
 
 		default:
 			panic(fmt.Sprintf("Unknown path component: %d", comp.Type))
@@ -10,10 +10,13 @@ import (
 	"image/color"
 	"io/ioutil"
 	"log"
+	"math"
 	"reflect"
 	"sync"
 	"testing"
 
+	"golang.org/x/image/webp"
+
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
 	"gonum.org/v1/plot/plotter"
@@ -141,3 +144,148 @@ func TestIssue540(t *testing.T) {
 		t.Fatalf("images differ")
 	}
 }
+
+func TestUseAntialiasing(t *testing.T) {
+	for _, aa := range []bool{true, false} {
+		c := vgimg.NewWith(vgimg.UseWH(20, 20), vgimg.UseDPI(72), vgimg.UseAntialiasing(aa))
+		c.SetColor(color.Black)
+		c.SetLineWidth(1)
+		// Draw a 1px line straddling two rows of pixels.
+		var p vg.Path
+		p.Move(vg.Point{X: 2, Y: 10.3})
+		p.Line(vg.Point{X: 18, Y: 10.3})
+		c.Stroke(p)
+
+		img := c.Image()
+		var black, gray int
+		b := img.Bounds()
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			for x := b.Min.X; x < b.Max.X; x++ {
+				r, g, b, a := img.At(x, y).RGBA()
+				if a != 0xffff {
+					t.Errorf("unexpected transparent pixel at (%d,%d) with antialiasing=%t", x, y, aa)
+				}
+				switch {
+				case r == 0 && g == 0 && b == 0:
+					black++
+				case r != 0xffff || g != 0xffff || b != 0xffff:
+					gray++
+				}
+			}
+		}
+		if aa {
+			if gray == 0 {
+				t.Error("expected gray pixels with antialiasing")
+			}
+			continue
+		}
+		if gray != 0 {
+			t.Errorf("unexpected gray pixels without antialiasing: got:%d", gray)
+		}
+		if black != 16 {
+			t.Errorf("unexpected number of black pixels without antialiasing: got:%d want:16", black)
+		}
+	}
+}
+
+func TestWebp(t *testing.T) {
+	for _, bg := range []color.Color{color.White, color.Transparent} {
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("could not create plot: %v", err)
+		}
+		p.BackgroundColor = bg
+		p.Title.Text = "WebP"
+		l, err := plotter.NewLine(plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 2}, {X: 2, Y: 1}})
+		if err != nil {
+			t.Fatalf("could not create line: %v", err)
+		}
+		l.FillColor = color.NRGBA{R: 255, A: 64}
+		p.Add(l)
+
+		c := vgimg.NewWith(vgimg.UseWH(5*vg.Centimeter, 3*vg.Centimeter), vgimg.UseBackgroundColor(color.Transparent))
+		p.Draw(draw.New(c))
+
+		var buf bytes.Buffer
+		if _, err := (vgimg.WebpCanvas{Canvas: c}).WriteTo(&buf); err != nil {
+			t.Fatalf("could not write webp: %v", err)
+		}
+		got, err := webp.Decode(&buf)
+		if err != nil {
+			t.Fatalf("could not decode webp: %v", err)
+		}
+
+		want := c.Image()
+		if got.Bounds() != want.Bounds() {
+			t.Fatalf("unexpected image bounds: got:%v want:%v", got.Bounds(), want.Bounds())
+		}
+		b := want.Bounds()
+	loop:
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			for x := b.Min.X; x < b.Max.X; x++ {
+				g := color.NRGBAModel.Convert(got.At(x, y))
+				w := color.NRGBAModel.Convert(want.At(x, y))
+				if g != w {
+					t.Errorf("unexpected pixel at (%d, %d) with background %v: got:%v want:%v", x, y, bg, g, w)
+					break loop
+				}
+			}
+		}
+	}
+}
+
+func TestUseDPI(t *testing.T) {
+	const w, h = 4 * vg.Inch, 3 * vg.Inch
+	for _, dpi := range []int{72, 300} {
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("could not create plot: %v", err)
+		}
+		p.Title.Text = "Title"
+		c := vgimg.NewWith(vgimg.UseWH(w, h), vgimg.UseDPI(dpi), vgimg.UseAntialiasing(false))
+		p.Draw(draw.New(c))
+
+		b := c.Image().Bounds()
+		if b.Dx() != 4*dpi || b.Dy() != 3*dpi {
+			t.Errorf("unexpected image size at %d DPI: got:%dx%d want:%dx%d", dpi, b.Dx(), b.Dy(), 4*dpi, 3*dpi)
+		}
+		if cw, ch := c.Size(); cw != w || ch != h {
+			t.Errorf("unexpected canvas size at %d DPI: got:%vx%v want:%vx%v", dpi, cw, ch, w, h)
+		}
+
+		// A box one inch wide and a line two points
+		// thick keep their physical size.
+		c = vgimg.NewWith(vgimg.UseWH(w, h), vgimg.UseDPI(dpi), vgimg.UseAntialiasing(false))
+		c.SetColor(color.Black)
+		c.Fill(vg.Rectangle{
+			Min: vg.Point{X: vg.Inch, Y: vg.Inch},
+			Max: vg.Point{X: 2 * vg.Inch, Y: 2 * vg.Inch},
+		}.Path())
+		c.SetLineWidth(vg.Points(2))
+		var line vg.Path
+		line.Move(vg.Point{X: 2.5 * vg.Inch, Y: 1.5 * vg.Inch})
+		line.Line(vg.Point{X: 3.5 * vg.Inch, Y: 1.5 * vg.Inch})
+		c.Stroke(line)
+
+		img := c.Image()
+		y := b.Dy() - int(1.5*float64(dpi))
+		var box int
+		for x := 0; x < 2*dpi+dpi/2; x++ {
+			if r, _, _, _ := img.At(x, y).RGBA(); r == 0 {
+				box++
+			}
+		}
+		if math.Abs(float64(box-dpi)) > 1 {
+			t.Errorf("unexpected box width at %d DPI: got:%d pixels want:%d", dpi, box, dpi)
+		}
+		var thick int
+		for y := 0; y < b.Dy(); y++ {
+			if r, _, _, _ := img.At(3*dpi, y).RGBA(); r == 0 {
+				thick++
+			}
+		}
+		if want := 2 * float64(dpi) / 72; math.Abs(float64(thick)-want) > 1 {
+			t.Errorf("unexpected line width at %d DPI: got:%d pixels want:%.1f", dpi, thick, want)
+		}
+	}
+}
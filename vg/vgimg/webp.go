@@ -0,0 +1,442 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vgimg
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+)
+
+// A WebpCanvas is an image canvas with a WriteTo method that
+// writes a lossless webp image.
+type WebpCanvas struct {
+	*Canvas
+}
+
+// WriteTo implements the io.WriterTo interface, writing a lossless webp image.
+func (c WebpCanvas) WriteTo(w io.Writer) (int64, error) {
+	wc := writerCounter{Writer: w}
+	b := bufio.NewWriter(&wc)
+	if err := encodeWebP(b, c.img); err != nil {
+		return wc.n, err
+	}
+	err := b.Flush()
+	return wc.n, err
+}
+
+// encodeWebP writes img to w as a lossless webp image,
+// using the VP8L bitstream. The pixels are compressed with
+// backward references to the pixels to their left and
+// above them, and with a single set of prefix codes.
+func encodeWebP(w io.Writer, img image.Image) error {
+	const maxSize = 1 << 14
+	b := img.Bounds()
+	width, height := b.Dx(), b.Dy()
+	if width < 1 || height < 1 || width > maxSize || height > maxSize {
+		return errors.New("vgimg: invalid webp image size")
+	}
+
+	argb := make([]uint32, 0, width*height)
+	alpha := false
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			alpha = alpha || c.A != 0xff
+			argb = append(argb, uint32(c.A)<<24|uint32(c.R)<<16|uint32(c.G)<<8|uint32(c.B))
+		}
+	}
+
+	var bw bitWriter
+	bw.writeBits(0x2f, 8) // Signature.
+	bw.writeBits(uint32(width-1), 14)
+	bw.writeBits(uint32(height-1), 14)
+	if alpha {
+		bw.writeBits(1, 1)
+	} else {
+		bw.writeBits(0, 1)
+	}
+	bw.writeBits(0, 3) // Version.
+	bw.writeBits(0, 1) // No transform.
+	bw.writeBits(0, 1) // No color cache.
+	bw.writeBits(0, 1) // No meta prefix codes.
+	vp8lEncodePixels(&bw, argb, width)
+	data := bw.bytes()
+
+	size := len(data)
+	pad := size & 1
+	hdr := make([]byte, 20)
+	copy(hdr[0:], "RIFF")
+	binary.LittleEndian.PutUint32(hdr[4:], uint32(4+8+size+pad))
+	copy(hdr[8:], "WEBPVP8L")
+	binary.LittleEndian.PutUint32(hdr[16:], uint32(size))
+	if _, err := w.Write(hdr); err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if pad != 0 {
+		_, err := w.Write([]byte{0})
+		return err
+	}
+	return nil
+}
+
+const (
+	vp8lMinMatch  = 3
+	vp8lMaxMatch  = 4096
+	vp8lLenCodes  = 24
+	vp8lDistCodes = 40
+)
+
+// vp8lSymbol is a literal pixel, or a backward reference
+// of the given length with the given distance code.
+type vp8lSymbol struct {
+	argb     uint32
+	length   int
+	distCode int
+}
+
+// vp8lEncodePixels writes the entropy-coded pixels of an
+// image of the given width, with their prefix codes.
+func vp8lEncodePixels(bw *bitWriter, argb []uint32, width int) {
+	// Distance code 1 refers to the pixel
+	// above, and code 2 to the pixel to the
+	// left, in the distance map of VP8L.
+	dists := [...]struct{ code, dist int }{{1, width}, {2, 1}}
+
+	var (
+		syms  []vp8lSymbol
+		freqs [5][]int
+	)
+	freqs[0] = make([]int, 256+vp8lLenCodes)
+	freqs[1] = make([]int, 256)
+	freqs[2] = make([]int, 256)
+	freqs[3] = make([]int, 256)
+	freqs[4] = make([]int, vp8lDistCodes)
+	for i := 0; i < len(argb); {
+		var best vp8lSymbol
+		for _, d := range dists {
+			if i < d.dist {
+				continue
+			}
+			n := 0
+			for n < vp8lMaxMatch && i+n < len(argb) && argb[i+n] == argb[i+n-d.dist] {
+				n++
+			}
+			if n > best.length {
+				best = vp8lSymbol{length: n, distCode: d.code}
+			}
+		}
+		if best.length >= vp8lMinMatch {
+			lc, _, _ := prefixEncode(best.length)
+			dc, _, _ := prefixEncode(best.distCode)
+			freqs[0][256+lc]++
+			freqs[4][dc]++
+			syms = append(syms, best)
+			i += best.length
+			continue
+		}
+		p := argb[i]
+		freqs[0][p>>8&0xff]++
+		freqs[1][p>>16&0xff]++
+		freqs[2][p&0xff]++
+		freqs[3][p>>24]++
+		syms = append(syms, vp8lSymbol{argb: p})
+		i++
+	}
+
+	var codes [5]prefixCode
+	for i, f := range freqs {
+		codes[i] = newPrefixCode(f, 15)
+		codes[i].write(bw)
+	}
+	for _, s := range syms {
+		if s.length == 0 {
+			codes[0].writeSymbol(bw, int(s.argb>>8&0xff))
+			codes[1].writeSymbol(bw, int(s.argb>>16&0xff))
+			codes[2].writeSymbol(bw, int(s.argb&0xff))
+			codes[3].writeSymbol(bw, int(s.argb>>24))
+			continue
+		}
+		lc, n, extra := prefixEncode(s.length)
+		codes[0].writeSymbol(bw, 256+lc)
+		bw.writeBits(extra, n)
+		dc, n, extra := prefixEncode(s.distCode)
+		codes[4].writeSymbol(bw, dc)
+		bw.writeBits(extra, n)
+	}
+}
+
+// prefixEncode returns the prefix code, and the number
+// and the value of the extra bits, encoding the length or
+// distance code v in VP8L.
+func prefixEncode(v int) (code int, n uint, extra uint32) {
+	v--
+	if v < 4 {
+		return v, 0, 0
+	}
+	hi := uint(0)
+	for v>>(hi+1) != 0 {
+		hi++
+	}
+	second := v >> (hi - 1) & 1
+	n = hi - 1
+	return int(2*hi) + second, n, uint32(v & (1<<n - 1))
+}
+
+// prefixCode is a canonical Huffman code.
+type prefixCode struct {
+	lengths []uint8
+	codes   []uint32
+
+	// single is whether the code has a single
+	// symbol, which is coded with zero bits.
+	single bool
+}
+
+// newPrefixCode returns the canonical Huffman code of
+// the symbols with the given frequencies, with codes
+// at most maxLen bits long.
+func newPrefixCode(freqs []int, maxLen uint8) prefixCode {
+	lengths := huffmanLengths(freqs, maxLen)
+	var count [16]uint32
+	for _, l := range lengths {
+		count[l]++
+	}
+	count[0] = 0
+	var next [16]uint32
+	var code uint32
+	for l := 1; l < len(next); l++ {
+		code = (code + count[l-1]) << 1
+		next[l] = code
+	}
+	codes := make([]uint32, len(lengths))
+	var used uint32
+	for _, n := range count {
+		used += n
+	}
+	if used == 1 {
+		return prefixCode{lengths: lengths, codes: codes, single: true}
+	}
+	for s, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		// Codes are read from the most significant
+		// bit, but bits are packed from the least.
+		c := next[l]
+		next[l]++
+		var r uint32
+		for i := uint8(0); i < l; i++ {
+			r = r<<1 | c>>i&1
+		}
+		codes[s] = r
+	}
+	return prefixCode{lengths: lengths, codes: codes}
+}
+
+// writeSymbol writes the code of the symbol s.
+func (c prefixCode) writeSymbol(bw *bitWriter, s int) {
+	if c.single {
+		return
+	}
+	bw.writeBits(c.codes[s], uint(c.lengths[s]))
+}
+
+// write writes the code lengths of the prefix code.
+func (c prefixCode) write(bw *bitWriter) {
+	var used []int
+	for s, l := range c.lengths {
+		if l != 0 {
+			used = append(used, s)
+		}
+	}
+	if len(used) <= 2 && (len(used) == 0 || used[len(used)-1] < 256) {
+		if len(used) == 0 {
+			used = []int{0}
+		}
+		// Simple code length code.
+		bw.writeBits(1, 1)
+		bw.writeBits(uint32(len(used)-1), 1)
+		if used[0] < 2 {
+			bw.writeBits(0, 1)
+			bw.writeBits(uint32(used[0]), 1)
+		} else {
+			bw.writeBits(1, 1)
+			bw.writeBits(uint32(used[0]), 8)
+		}
+		if len(used) == 2 {
+			bw.writeBits(uint32(used[1]), 8)
+		}
+		return
+	}
+
+	// Normal code length code, with runs of
+	// zeros coded by the codes 17 and 18.
+	type token struct {
+		sym   int
+		extra uint32
+	}
+	var (
+		tokens []token
+		freqs  = make([]int, 19)
+	)
+	for i := 0; i < len(c.lengths); {
+		l := c.lengths[i]
+		n := 1
+		for i+n < len(c.lengths) && c.lengths[i+n] == l {
+			n++
+		}
+		if l != 0 || n < 3 {
+			for j := 0; j < n; j++ {
+				tokens = append(tokens, token{sym: int(l)})
+			}
+			freqs[l] += n
+			i += n
+			continue
+		}
+		if n > 138 {
+			n = 138
+		}
+		if n <= 10 {
+			tokens = append(tokens, token{sym: 17, extra: uint32(n - 3)})
+			freqs[17]++
+		} else {
+			tokens = append(tokens, token{sym: 18, extra: uint32(n - 11)})
+			freqs[18]++
+		}
+		i += n
+	}
+
+	order := [...]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	lc := newPrefixCode(freqs, 7)
+	num := len(order)
+	for num > 4 && lc.lengths[order[num-1]] == 0 {
+		num--
+	}
+	bw.writeBits(0, 1)
+	bw.writeBits(uint32(num-4), 4)
+	for _, s := range order[:num] {
+		bw.writeBits(uint32(lc.lengths[s]), 3)
+	}
+	bw.writeBits(0, 1) // All the code lengths are coded.
+	for _, t := range tokens {
+		lc.writeSymbol(bw, t.sym)
+		switch t.sym {
+		case 17:
+			bw.writeBits(t.extra, 3)
+		case 18:
+			bw.writeBits(t.extra, 7)
+		}
+	}
+}
+
+// huffmanLengths returns the lengths of the Huffman codes
+// of symbols with the given frequencies, limited to maxLen
+// bits by flattening the frequencies as needed. Symbols
+// that do not occur have no code, except that a single
+// symbol is given a code when all the frequencies are zero.
+func huffmanLengths(freqs []int, maxLen uint8) []uint8 {
+	lengths := make([]uint8, len(freqs))
+	f := append([]int(nil), freqs...)
+	for {
+		var h nodeHeap
+		for s, n := range f {
+			if n > 0 {
+				h = append(h, &huffNode{freq: n, sym: s})
+			}
+		}
+		switch len(h) {
+		case 0:
+			return lengths
+		case 1:
+			lengths[h[0].sym] = 1
+			return lengths
+		}
+		heap.Init(&h)
+		for h.Len() > 1 {
+			a := heap.Pop(&h).(*huffNode)
+			b := heap.Pop(&h).(*huffNode)
+			heap.Push(&h, &huffNode{freq: a.freq + b.freq, sym: -1, left: a, right: b})
+		}
+		ok := true
+		var walk func(n *huffNode, depth uint8)
+		walk = func(n *huffNode, depth uint8) {
+			if n.left == nil {
+				lengths[n.sym] = depth
+				ok = ok && depth <= maxLen
+				return
+			}
+			walk(n.left, depth+1)
+			walk(n.right, depth+1)
+		}
+		walk(h[0], 0)
+		if ok {
+			return lengths
+		}
+		for s, n := range f {
+			if n > 0 {
+				f[s] = (n + 1) / 2
+			}
+		}
+	}
+}
+
+type huffNode struct {
+	freq        int
+	sym         int
+	left, right *huffNode
+}
+
+// nodeHeap is a min-heap of Huffman tree nodes.
+type nodeHeap []*huffNode
+
+func (h nodeHeap) Len() int { return len(h) }
+func (h nodeHeap) Less(i, j int) bool {
+	if h[i].freq != h[j].freq {
+		return h[i].freq < h[j].freq
+	}
+	return h[i].sym < h[j].sym
+}
+func (h nodeHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *nodeHeap) Push(x interface{}) { *h = append(*h, x.(*huffNode)) }
+func (h *nodeHeap) Pop() interface{} {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
+
+// bitWriter packs bits from the least significant bit.
+type bitWriter struct {
+	buf  []byte
+	acc  uint64
+	nacc uint
+}
+
+func (w *bitWriter) writeBits(v uint32, n uint) {
+	w.acc |= uint64(v) << w.nacc
+	w.nacc += n
+	for w.nacc >= 8 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc >>= 8
+		w.nacc -= 8
+	}
+}
+
+// bytes returns the written bits, padded with zeros.
+func (w *bitWriter) bytes() []byte {
+	if w.nacc > 0 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc, w.nacc = 0, 0
+	}
+	return w.buf
+}
@@ -0,0 +1,119 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vgimg
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"testing"
+
+	"golang.org/x/exp/rand"
+	"golang.org/x/image/webp"
+)
+
+func TestEncodeWebP(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		w, h int
+		at   func(x, y int) color.NRGBA
+	}{
+		{
+			name: "single pixel",
+			w:    1, h: 1,
+			at: func(x, y int) color.NRGBA { return color.NRGBA{R: 10, G: 20, B: 30, A: 255} },
+		},
+		{
+			name: "column",
+			w:    1, h: 37,
+			at: func(x, y int) color.NRGBA { return color.NRGBA{R: uint8(7 * y), G: 3, B: uint8(y), A: 255} },
+		},
+		{
+			name: "row",
+			w:    301, h: 1,
+			at: func(x, y int) color.NRGBA { return color.NRGBA{R: uint8(x), G: uint8(x >> 8), B: 99, A: 255} },
+		},
+		{
+			name: "flat",
+			w:    64, h: 48,
+			at: func(x, y int) color.NRGBA { return color.NRGBA{R: 255, G: 255, B: 255, A: 255} },
+		},
+		{
+			name: "gradient alpha",
+			w:    17, h: 13,
+			at: func(x, y int) color.NRGBA {
+				return color.NRGBA{R: uint8(15 * x), G: uint8(19 * y), B: uint8(x * y), A: uint8(16*x + y)}
+			},
+		},
+		{
+			// Fully transparent pixels keep their colors.
+			name: "transparent",
+			w:    9, h: 7,
+			at: func(x, y int) color.NRGBA { return color.NRGBA{R: uint8(x), G: uint8(y), B: 1} },
+		},
+		{
+			// Repeated tiles are encoded with backward
+			// references along and across the rows.
+			name: "tiles",
+			w:    123, h: 77,
+			at: func(x, y int) color.NRGBA {
+				if (x/5+y/3)%2 == 0 {
+					return color.NRGBA{R: 200, G: 40, B: 40, A: 255}
+				}
+				return color.NRGBA{R: 40, G: 40, B: 200, A: 128}
+			},
+		},
+		{
+			name: "noise",
+			w:    99, h: 101,
+			at: func(x, y int) color.NRGBA {
+				v := rnd.Uint32()
+				return color.NRGBA{R: uint8(v), G: uint8(v >> 8), B: uint8(v >> 16), A: uint8(v >> 24)}
+			},
+		},
+	} {
+		want := image.NewNRGBA(image.Rect(0, 0, test.w, test.h))
+		for y := 0; y < test.h; y++ {
+			for x := 0; x < test.w; x++ {
+				want.SetNRGBA(x, y, test.at(x, y))
+			}
+		}
+
+		var buf bytes.Buffer
+		if err := encodeWebP(&buf, want); err != nil {
+			t.Errorf("%s: could not encode webp: %v", test.name, err)
+			continue
+		}
+		got, err := webp.Decode(&buf)
+		if err != nil {
+			t.Errorf("%s: could not decode webp: %v", test.name, err)
+			continue
+		}
+		if got.Bounds() != want.Bounds() {
+			t.Errorf("%s: unexpected image bounds: got:%v want:%v", test.name, got.Bounds(), want.Bounds())
+			continue
+		}
+	loop:
+		for y := 0; y < test.h; y++ {
+			for x := 0; x < test.w; x++ {
+				g := color.NRGBAModel.Convert(got.At(x, y))
+				if w := want.NRGBAAt(x, y); g != w {
+					t.Errorf("%s: unexpected pixel at (%d, %d): got:%v want:%v", test.name, x, y, g, w)
+					break loop
+				}
+			}
+		}
+	}
+
+	for _, r := range []image.Rectangle{
+		image.Rect(0, 0, 0, 10),
+		image.Rect(0, 0, 1<<14+1, 1),
+	} {
+		if err := encodeWebP(&bytes.Buffer{}, image.NewNRGBA(r)); err == nil {
+			t.Errorf("expected an error for an image of size %v", r.Size())
+		}
+	}
+}
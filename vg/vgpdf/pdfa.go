@@ -0,0 +1,240 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vgpdf
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// producer is the producer of PDF/A documents,
+// recorded in both the document information
+// dictionary and the XMP metadata.
+const producer = "gonum.org/v1/plot"
+
+// PDFA specifies whether the resulting PDF canvas should be
+// written as a PDF/A-1b document, for long-term archival.
+// PDFA returns the previous value before modification.
+//
+// A PDF/A canvas embeds subsets of the fonts it uses, draws
+// colors and images without transparency, and is written
+// with XMP metadata and an sRGB output intent. PDFA must be
+// called before drawing to the canvas, as it starts a new
+// document when the mode changes.
+func (c *Canvas) PDFA(v bool) bool {
+	prev := c.pdfa
+	c.pdfa = v
+	if v != prev {
+		c.reset()
+	}
+	return prev
+}
+
+// opaque returns img composited over a white background.
+func opaque(img image.Image) image.Image {
+	dst := image.NewRGBA(img.Bounds())
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, img.Bounds().Min, draw.Over)
+	return dst
+}
+
+var (
+	startxref = regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n?$`)
+	xrefTable = regexp.MustCompile(`^xref\n0 (\d+)\n`)
+)
+
+// archive returns the PDF document doc, written by gofpdf,
+// amended to conform to PDF/A-1b. The catalog of the document
+// is given the XMP metadata and the sRGB output intent, the
+// header is followed by a binary comment and the trailer is
+// given a file identifier.
+//
+// The document information dictionary of doc must record the
+// producer and the creation and modification dates date, so
+// that it matches the XMP metadata.
+func archive(doc []byte, date time.Time) ([]byte, error) {
+	m := startxref.FindSubmatch(doc)
+	if m == nil {
+		return nil, errors.New("vgpdf: could not find cross-reference table")
+	}
+	xref, err := strconv.Atoi(string(m[1]))
+	if err != nil || xref > len(doc) {
+		return nil, errors.New("vgpdf: invalid cross-reference table offset")
+	}
+	m = xrefTable.FindSubmatch(doc[xref:])
+	if m == nil {
+		return nil, errors.New("vgpdf: invalid cross-reference table")
+	}
+	size, _ := strconv.Atoi(string(m[1]))
+	fields := bytes.Fields(doc[xref+len(m[0]):])
+	if len(fields) < 3*size {
+		return nil, errors.New("vgpdf: truncated cross-reference table")
+	}
+	offsets := make([]int, size)
+	for i := 1; i < size; i++ {
+		offsets[i], err = strconv.Atoi(string(fields[3*i]))
+		if err != nil {
+			return nil, fmt.Errorf("vgpdf: invalid cross-reference table entry: %v", err)
+		}
+	}
+
+	// gofpdf writes the information dictionary and the
+	// catalog last, just before the cross-reference table.
+	root, info := size-1, size-2
+	catalog := doc[offsets[root]:xref]
+	end := bytes.LastIndex(catalog, []byte(">>\nendobj"))
+	if end < 0 || !bytes.Contains(catalog, []byte("/Type /Catalog")) {
+		return nil, errors.New("vgpdf: could not find document catalog")
+	}
+
+	meta, icc, intent := size, size+1, size+2
+	header := bytes.IndexByte(doc, '\n') + 1
+
+	var buf bytes.Buffer
+	buf.Write(doc[:header])
+	// A comment of binary characters marks the file as binary.
+	buf.WriteString("%\xe2\xe3\xcf\xd3\n")
+	shift := buf.Len() - header
+	buf.Write(doc[header:offsets[root]])
+	for i := 1; i < root; i++ {
+		offsets[i] += shift
+	}
+
+	offsets = append(offsets, 0, 0, 0)
+	offsets[root] = buf.Len()
+	buf.Write(catalog[:end])
+	fmt.Fprintf(&buf, "/Metadata %d 0 R\n/OutputIntents [%d 0 R]\n>>\nendobj\n", meta, intent)
+
+	xmp := xmpMetadata(date)
+	offsets[meta] = buf.Len()
+	fmt.Fprintf(&buf, "%d 0 obj\n<< /Type /Metadata /Subtype /XML /Length %d >>\nstream\n", meta, len(xmp))
+	buf.Write(xmp)
+	buf.WriteString("\nendstream\nendobj\n")
+
+	prof := srgbProfile()
+	offsets[icc] = buf.Len()
+	fmt.Fprintf(&buf, "%d 0 obj\n<< /N 3 /Length %d >>\nstream\n", icc, len(prof))
+	buf.Write(prof)
+	buf.WriteString("\nendstream\nendobj\n")
+
+	offsets[intent] = buf.Len()
+	fmt.Fprintf(&buf, "%d 0 obj\n<< /Type /OutputIntent /S /GTS_PDFA1 "+
+		"/OutputConditionIdentifier (sRGB IEC61966-2.1) /Info (sRGB IEC61966-2.1) "+
+		"/DestOutputProfile %d 0 R >>\nendobj\n", intent, icc)
+
+	id := md5.Sum(buf.Bytes())
+	xref = buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets))
+	for _, off := range offsets[1:] {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<<\n/Size %d\n/Root %d 0 R\n/Info %d 0 R\n/ID [<%x> <%x>]\n>>\n", len(offsets), root, info, id, id)
+	fmt.Fprintf(&buf, "startxref\n%d\n%%%%EOF\n", xref)
+
+	return buf.Bytes(), nil
+}
+
+// xmpMetadata returns the XMP metadata of a PDF/A-1b
+// document created and modified at date.
+func xmpMetadata(date time.Time) []byte {
+	d := date.Format("2006-01-02T15:04:05")
+	return []byte(`<?xpacket begin="` + "\ufeff" + `" id="W5M0MpCehiHzreSzNTczkc9d"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/">
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+<rdf:Description rdf:about="" xmlns:pdfaid="http://www.aiim.org/pdfa/ns/id/">
+<pdfaid:part>1</pdfaid:part>
+<pdfaid:conformance>B</pdfaid:conformance>
+</rdf:Description>
+<rdf:Description rdf:about="" xmlns:pdf="http://ns.adobe.com/pdf/1.3/">
+<pdf:Producer>` + producer + `</pdf:Producer>
+</rdf:Description>
+<rdf:Description rdf:about="" xmlns:xmp="http://ns.adobe.com/xap/1.0/">
+<xmp:CreateDate>` + d + `</xmp:CreateDate>
+<xmp:ModifyDate>` + d + `</xmp:ModifyDate>
+</rdf:Description>
+</rdf:RDF>
+</x:xmpmeta>
+<?xpacket end="w"?>`)
+}
+
+// srgbProfile returns an ICC version 2 display profile
+// of the sRGB color space, with the primaries adapted to
+// the D50 illuminant of the profile connection space and
+// the transfer function approximated by a gamma of 2.2.
+func srgbProfile() []byte {
+	be := binary.BigEndian
+	s15f16 := func(v float64) []byte {
+		b := make([]byte, 4)
+		be.PutUint32(b, uint32(int32(math.Round(v*65536))))
+		return b
+	}
+	xyz := func(x, y, z float64) []byte {
+		b := []byte("XYZ \x00\x00\x00\x00")
+		b = append(b, s15f16(x)...)
+		b = append(b, s15f16(y)...)
+		return append(b, s15f16(z)...)
+	}
+
+	const name = "sRGB IEC61966-2.1"
+	desc := []byte("desc\x00\x00\x00\x00")
+	desc = append(desc, 0, 0, 0, byte(len(name)+1))
+	desc = append(desc, name...)
+	desc = append(desc, 0)
+	// Empty Unicode and ScriptCode descriptions.
+	desc = append(desc, make([]byte, 4+4+2+1+67)...)
+
+	trc := []byte("curv\x00\x00\x00\x00\x00\x00\x00\x01\x02\x33")
+
+	tags := []struct {
+		sig  string
+		data []byte
+	}{
+		{"desc", desc},
+		{"cprt", []byte("text\x00\x00\x00\x00No copyright, use freely\x00")},
+		{"wtpt", xyz(0.9642, 1, 0.8249)},
+		{"rXYZ", xyz(0.4361, 0.2225, 0.0139)},
+		{"gXYZ", xyz(0.3851, 0.7169, 0.0971)},
+		{"bXYZ", xyz(0.1431, 0.0606, 0.7141)},
+		{"rTRC", trc},
+		{"gTRC", trc},
+		{"bTRC", trc},
+	}
+
+	var table, data bytes.Buffer
+	off := 128 + 4 + 12*len(tags)
+	binary.Write(&table, be, uint32(len(tags)))
+	for _, t := range tags {
+		for data.Len()%4 != 0 {
+			data.WriteByte(0)
+		}
+		table.WriteString(t.sig)
+		binary.Write(&table, be, uint32(off+data.Len()))
+		binary.Write(&table, be, uint32(len(t.data)))
+		data.Write(t.data)
+	}
+
+	header := make([]byte, 128)
+	be.PutUint32(header[0:], uint32(128+table.Len()+data.Len()))
+	be.PutUint32(header[8:], 0x02100000) // Version 2.1.
+	copy(header[12:], "mntrRGB XYZ ")
+	be.PutUint16(header[24:], 2000) // Date and time.
+	be.PutUint16(header[26:], 1)
+	be.PutUint16(header[28:], 1)
+	copy(header[36:], "acsp")
+	copy(header[68:], xyz(0.9642, 1, 0.8249)[8:])
+
+	prof := append(header, table.Bytes()...)
+	return append(prof, data.Bytes()...)
+}
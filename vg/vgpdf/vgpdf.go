@@ -19,6 +19,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"time"
 
 	pdf "github.com/jung-kurt/gofpdf"
 
@@ -29,6 +30,13 @@ import (
 // DPI is the nominal resolution of drawing in PDF.
 const DPI = 72
 
+var (
+	_ vg.Clipper       = (*Canvas)(nil)
+	_ vg.LineCapJoiner = (*Canvas)(nil)
+	_ vg.Linker        = (*Canvas)(nil)
+	_ vg.Transformer   = (*Canvas)(nil)
+)
+
 // Canvas implements the vg.Canvas interface,
 // drawing to a PDF.
 type Canvas struct {
@@ -43,7 +51,11 @@ type Canvas struct {
 	// Switch to embed fonts in PDF file.
 	// The default is to embed fonts.
 	// This makes the PDF file more portable but also larger.
+	// Only the glyphs drawn are embedded.
 	embed bool
+
+	// pdfa switches to the output of PDF/A documents.
+	pdfa bool
 }
 
 type context struct {
@@ -54,26 +66,35 @@ type context struct {
 
 // New creates a new PDF Canvas.
 func New(w, h vg.Length) *Canvas {
-	cfg := pdf.InitType{
-		UnitStr: "pt",
-		Size:    pdf.SizeType{Wd: w.Points(), Ht: h.Points()},
-	}
 	c := &Canvas{
-		doc:   pdf.NewCustom(&cfg),
 		w:     w,
 		h:     h,
 		dpi:   DPI,
-		stack: make([]context, 1),
-		fonts: make(map[vg.Font]struct{}),
 		embed: true,
 	}
+	c.reset()
+	return c
+}
+
+// reset starts a new document on the canvas,
+// with a single blank page.
+func (c *Canvas) reset() {
+	cfg := pdf.InitType{
+		UnitStr: "pt",
+		Size:    pdf.SizeType{Wd: c.w.Points(), Ht: c.h.Points()},
+	}
+	c.doc = pdf.NewCustom(&cfg)
+	c.stack = make([]context, 1)
+	c.fonts = make(map[vg.Font]struct{})
 	c.NextPage()
 	vg.Initialize(c)
-	return c
 }
 
 // EmbedFonts specifies whether the resulting PDF canvas should
-// embed the fonts or not.
+// embed the fonts or not. Embedded fonts are subset to the glyphs
+// of the text drawn on the canvas, so that the size of the
+// document grows with the number of distinct runes drawn rather
+// than with the size of the fonts.
 // EmbedFonts returns the previous value before modification.
 func (c *Canvas) EmbedFonts(v bool) bool {
 	prev := c.embed
@@ -117,9 +138,37 @@ func (c *Canvas) SetColor(clr color.Color) {
 	c.doc.SetFillColor(r, g, b)
 	c.doc.SetDrawColor(r, g, b)
 	c.doc.SetTextColor(r, g, b)
+	if c.pdfa {
+		// PDF/A-1 does not allow transparency.
+		return
+	}
 	c.doc.SetAlpha(a, "Normal")
 }
 
+// SetLineCap implements the vg.LineCapJoiner interface.
+func (c *Canvas) SetLineCap(lc vg.LineCap) {
+	switch lc {
+	case vg.RoundCap:
+		c.doc.SetLineCapStyle("round")
+	case vg.SquareCap:
+		c.doc.SetLineCapStyle("square")
+	default:
+		c.doc.SetLineCapStyle("butt")
+	}
+}
+
+// SetLineJoin implements the vg.LineCapJoiner interface.
+func (c *Canvas) SetLineJoin(lj vg.LineJoin) {
+	switch lj {
+	case vg.RoundJoin:
+		c.doc.SetLineJoinStyle("round")
+	case vg.BevelJoin:
+		c.doc.SetLineJoinStyle("bevel")
+	default:
+		c.doc.SetLineJoinStyle("miter")
+	}
+}
+
 func (c *Canvas) Rotate(r float64) {
 	c.doc.TransformRotate(-r*180/math.Pi, 0, 0)
 }
@@ -133,6 +182,22 @@ func (c *Canvas) Scale(x float64, y float64) {
 	c.doc.TransformScale(x*100, y*100, 0, 0)
 }
 
+// Transform applies the affine transform m to the context,
+// implementing the vg.Transformer interface.
+func (c *Canvas) Transform(m vg.Matrix) {
+	// go-fpdf uses the top left corner as origin, so the
+	// matrix is conjugated by the flip of the Y axis.
+	h := c.unit(c.h)
+	c.doc.Transform(pdf.TransformMatrix{
+		A: m.A,
+		B: -m.B,
+		C: -m.C,
+		D: m.D,
+		E: m.C*h + c.unit(m.E),
+		F: h - m.D*h - c.unit(m.F),
+	})
+}
+
 func (c *Canvas) Push() {
 	c.stack = append(c.stack, *c.context())
 	c.doc.TransformBegin()
@@ -143,6 +208,11 @@ func (c *Canvas) Pop() {
 	c.stack = c.stack[:len(c.stack)-1]
 }
 
+// Clip implements the vg.Clipper interface.
+func (c *Canvas) Clip(p vg.Path) {
+	c.pdfPath(p, "W n")
+}
+
 func (c *Canvas) Stroke(p vg.Path) {
 	if c.context().width > 0 {
 		c.pdfPath(p, "D")
@@ -175,6 +245,16 @@ func (c *Canvas) FillString(fnt vg.Font, pt vg.Point, str string) {
 	c.doc.CellFormat(w, h, str, "", 0, "BL", false, 0, "")
 }
 
+// Link adds a link annotation to the current page, making the
+// rectangular region r a hyperlink to url. The region is given in
+// the coordinates of the page and is not affected by the
+// transformations applied to the canvas.
+func (c *Canvas) Link(r vg.Rectangle, url string) {
+	sz := r.Size()
+	// go-fpdf uses the top left corner as origin.
+	c.doc.LinkString(c.unit(r.Min.X), c.unit(c.h-r.Max.Y), c.unit(sz.X), c.unit(sz.Y), url)
+}
+
 func (c *Canvas) sbounds(fnt vg.Font, txt string) (left, top, right, bottom float64) {
 	_, h := c.doc.GetFontSize()
 	d := c.doc.GetFontDesc("", "")
@@ -192,6 +272,10 @@ func (c *Canvas) DrawImage(rect vg.Rectangle, img image.Image) {
 	opts := pdf.ImageOptions{ImageType: "png", ReadDpi: true}
 	name := c.imageName()
 
+	if c.pdfa {
+		img = opaque(img)
+	}
+
 	buf := new(bytes.Buffer)
 	err := png.Encode(buf, img)
 	if err != nil {
@@ -216,12 +300,20 @@ func (c *Canvas) font(fnt vg.Font, pt vg.Point) {
 			log.Panicf("vgpdf: could not load TTF data from asset for TTF font %q: %v", n+".ttf", err)
 		}
 
+		if c.embed || c.pdfa {
+			// UTF-8 fonts are embedded as subsets of the
+			// glyphs of the runes drawn with FillString.
+			c.fonts[fnt] = struct{}{}
+			c.doc.AddUTF8FontFromBytes(fnt.Name(), "", raw)
+			return
+		}
+
 		enc, err := fonts.Asset("cp1252.map")
 		if err != nil {
 			log.Panicf("vgpdf: could not load encoding map: %v", err)
 		}
 
-		zdata, jdata, err := makeFont(raw, enc, c.embed)
+		zdata, jdata, err := makeFont(raw, enc, false)
 		if err != nil {
 			log.Panicf("vgpdf: could not generate font data for PDF: %v", err)
 		}
@@ -325,6 +417,9 @@ func (w *writerCounter) Write(p []byte) (int, error) {
 // and may no longer be used for drawing.
 func (c *Canvas) WriteTo(w io.Writer) (int64, error) {
 	c.Pop()
+	if c.pdfa {
+		return c.writePDFA(w)
+	}
 	c.doc.Close()
 	wc := writerCounter{Writer: w}
 	b := bufio.NewWriter(&wc)
@@ -335,6 +430,26 @@ func (c *Canvas) WriteTo(w io.Writer) (int64, error) {
 	return wc.n, err
 }
 
+// writePDFA writes the Canvas to an io.Writer
+// as a PDF/A document.
+func (c *Canvas) writePDFA(w io.Writer) (int64, error) {
+	now := time.Now().UTC()
+	c.doc.SetProducer(producer, false)
+	c.doc.SetCreationDate(now)
+	c.doc.SetModificationDate(now)
+	c.doc.Close()
+	var buf bytes.Buffer
+	if err := c.doc.Output(&buf); err != nil {
+		return 0, err
+	}
+	doc, err := archive(buf.Bytes(), now)
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(doc)
+	return int64(n), err
+}
+
 // rgba converts a Go color into a gofpdf 3-tuple int + 1 float64
 func rgba(c color.Color) (int, int, int, float64) {
 	if c == nil {
@@ -396,11 +511,19 @@ func makeFont(font, encoding []byte, embed bool) (z, j []byte, err error) {
 // The new page is the new current page.
 // Modifications applied to the canvas will only be applied to that new page.
 func (c *Canvas) NextPage() {
+	c.NextPageSize(c.w, c.h)
+}
+
+// NextPageSize creates a new page of the given size in the final PDF
+// document. The new page is the new current page and the size of the
+// canvas is the size of that page.
+func (c *Canvas) NextPageSize(w, h vg.Length) {
 	if c.doc.PageNo() > 0 {
 		c.Pop()
 	}
+	c.w, c.h = w, h
 	c.doc.SetMargins(0, 0, 0)
-	c.doc.AddPage()
+	c.doc.AddPageFormat("P", pdf.SizeType{Wd: w.Points(), Ht: h.Points()})
 	c.Push()
 	c.Translate(vg.Point{X: 0, Y: c.h})
 	c.Scale(1, -1)
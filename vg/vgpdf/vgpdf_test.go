@@ -11,12 +11,19 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"regexp"
+	"strconv"
 	"testing"
 
+	"github.com/golang/freetype/truetype"
+	"rsc.io/pdf"
+
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
 	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/fonts"
 	"gonum.org/v1/plot/vg/vgpdf"
 )
 
@@ -183,3 +190,202 @@ func TestIssue540(t *testing.T) {
 		t.Fatalf("images differ")
 	}
 }
+
+func TestLink(t *testing.T) {
+	c := vgpdf.New(100, 100)
+	c.Link(vg.Rectangle{Min: vg.Point{X: 10, Y: 20}, Max: vg.Point{X: 30, Y: 50}}, "https://gonum.org/")
+
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatalf("could not write canvas: %v", err)
+	}
+	const want = "<</Type /Annot /Subtype /Link /Rect [10.00 50.00 30.00 20.00] /Border [0 0 0] /A <</S /URI /URI (https://gonum.org/)>>>>"
+	if !bytes.Contains(buf.Bytes(), []byte(want)) {
+		t.Errorf("missing link annotation %q", want)
+	}
+}
+
+func TestScatterLinks(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	s, err := plotter.NewScatter(plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 0}})
+	if err != nil {
+		t.Fatalf("could not create scatter: %v", err)
+	}
+	s.LinkFunc = func(i int) string {
+		if i == 1 {
+			return "" // No link for the second point.
+		}
+		return fmt.Sprintf("https://example.com/%d", i)
+	}
+	p.Add(s)
+
+	c := vgpdf.New(200, 200)
+	p.Draw(draw.New(c))
+
+	var buf bytes.Buffer
+	if _, err = c.WriteTo(&buf); err != nil {
+		t.Fatalf("could not write canvas: %v", err)
+	}
+	if got := bytes.Count(buf.Bytes(), []byte("/Subtype /Link")); got != 2 {
+		t.Errorf("unexpected number of link annotations: got:%d want:2", got)
+	}
+	for _, url := range []string{"https://example.com/0", "https://example.com/2"} {
+		if !bytes.Contains(buf.Bytes(), []byte("/URI ("+url+")")) {
+			t.Errorf("missing link annotation for %q", url)
+		}
+	}
+}
+
+func TestPDFA(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	p.Title.Text = "PDF/A"
+	p.X.Label.Text = "X axis"
+	pts := plotter.XYs{{X: 0, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 0}, {X: 1, Y: 1}}
+	poly, err := plotter.NewPolygon(pts)
+	if err != nil {
+		t.Fatalf("could not create polygon: %v", err)
+	}
+	poly.Color = color.NRGBA{R: 255, A: 128}
+	p.Add(poly)
+
+	c := vgpdf.New(200, 200)
+	if c.PDFA(true) {
+		t.Error("unexpected default PDF/A mode")
+	}
+	p.Draw(draw.New(c))
+
+	var buf bytes.Buffer
+	if _, err = c.WriteTo(&buf); err != nil {
+		t.Fatalf("could not write canvas: %v", err)
+	}
+	doc := buf.Bytes()
+
+	for _, re := range []string{
+		`(?m)^/Metadata (\d+) 0 R\n/OutputIntents \[(\d+) 0 R\]\n>>\nendobj$`,
+		`<< /Type /Metadata /Subtype /XML /Length \d+ >>\nstream\n<\?xpacket `,
+		`<pdfaid:part>1</pdfaid:part>\n<pdfaid:conformance>B</pdfaid:conformance>`,
+		`<< /Type /OutputIntent /S /GTS_PDFA1 .*/DestOutputProfile \d+ 0 R >>`,
+		`<< /N 3 /Length \d+ >>\nstream\n`,
+		`/FontFile2 \d+ 0 R`,
+		`/ID \[<[0-9a-f]{32}> <[0-9a-f]{32}>\]`,
+	} {
+		if !regexp.MustCompile(re).Match(doc) {
+			t.Errorf("missing %q in PDF/A output", re)
+		}
+	}
+	if bytes.Contains(doc, []byte("/ExtGState")) {
+		t.Error("unexpected graphics state with transparency in PDF/A output")
+	}
+
+	// Check that the cross-reference table
+	// locates all the objects.
+	m := regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`).FindSubmatch(doc)
+	if m == nil {
+		t.Fatal("missing cross-reference table")
+	}
+	xref, _ := strconv.Atoi(string(m[1]))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(doc[xref:], -1)
+	for i, e := range entries {
+		off, _ := strconv.Atoi(string(e[1]))
+		obj := fmt.Sprintf("%d 0 obj\n", i+1)
+		if !bytes.HasPrefix(doc[off:], []byte(obj)) {
+			t.Errorf("cross-reference table entry %d does not locate object", i+1)
+		}
+	}
+}
+
+// numbersPlot returns a plot whose text is
+// only digits and the labels of its axes.
+func numbersPlot() (*plot.Plot, error) {
+	p, err := plot.New()
+	if err != nil {
+		return nil, err
+	}
+	p.X.Label.Text = "X"
+	p.Y.Label.Text = "Y"
+	p.X.Min, p.X.Max = 0, 10
+	p.Y.Min, p.Y.Max = 0, 10
+	return p, nil
+}
+
+func TestSubsetFonts(t *testing.T) {
+	p, err := numbersPlot()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	c := vgpdf.New(200, 200)
+	p.Draw(draw.New(c))
+
+	var buf bytes.Buffer
+	if _, err = c.WriteTo(&buf); err != nil {
+		t.Fatalf("could not write canvas: %v", err)
+	}
+	r, err := pdf.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("could not read PDF: %v", err)
+	}
+
+	drawn := make(map[rune]bool)
+	for _, txt := range r.Page(1).Content().Text {
+		for _, r := range txt.S {
+			drawn[r] = true
+		}
+	}
+	full, err := fonts.Asset("LiberationSerif-Regular.ttf")
+	if err != nil {
+		t.Fatalf("could not load font: %v", err)
+	}
+
+	res := r.Page(1).Resources().Key("Font")
+	if len(res.Keys()) == 0 {
+		t.Fatal("no font in PDF")
+	}
+	for _, k := range res.Keys() {
+		desc := res.Key(k).Key("DescendantFonts").Index(0).Key("FontDescriptor")
+		data, err := ioutil.ReadAll(desc.Key("FontFile2").Reader())
+		if err != nil {
+			t.Fatalf("could not read embedded font %s: %v", k, err)
+		}
+		if len(data) > len(full)/10 {
+			t.Errorf("embedded font %s is not a subset: got %d bytes, full font has %d bytes", k, len(data), len(full))
+		}
+		fnt, err := truetype.Parse(data)
+		if err != nil {
+			t.Fatalf("could not parse embedded font %s: %v", k, err)
+		}
+		for r := rune(0x20); r < 0x250; r++ {
+			if got := fnt.Index(r) != 0; got != drawn[r] && r != ' ' {
+				t.Errorf("unexpected glyph of %q in embedded font %s: got:%t want:%t", r, k, got, drawn[r])
+			}
+		}
+	}
+}
+
+func BenchmarkEmbedFonts(b *testing.B) {
+	p, err := numbersPlot()
+	if err != nil {
+		b.Fatalf("could not create plot: %v", err)
+	}
+	for _, embed := range []bool{false, true} {
+		b.Run(fmt.Sprintf("embed=%t", embed), func(b *testing.B) {
+			var size int64
+			for i := 0; i < b.N; i++ {
+				c := vgpdf.New(200, 200)
+				c.EmbedFonts(embed)
+				p.Draw(draw.New(c))
+				n, err := c.WriteTo(ioutil.Discard)
+				if err != nil {
+					b.Fatal(err)
+				}
+				size = n
+			}
+			b.ReportMetric(float64(size), "bytes/pdf")
+		})
+	}
+}
@@ -17,13 +17,14 @@ import (
 	"image/png"
 	"io"
 	"math"
+	"strings"
 
 	svgo "github.com/ajstarks/svgo"
 
 	"gonum.org/v1/plot/vg"
 )
 
-// pr is the precision to use when outputting float64s.
+// pr is the default precision to use when outputting float64s.
 const pr = 5
 
 const (
@@ -39,13 +40,47 @@ type Canvas struct {
 
 	buf   *bytes.Buffer
 	stack []context
+
+	// embed specifies whether the fonts used
+	// are embedded in the SVG document.
+	embed bool
+	// fonts is the set of names of the fonts
+	// already embedded in the SVG document.
+	fonts map[string]struct{}
+
+	// patterns is the number of fill patterns
+	// defined in the SVG document.
+	patterns int
+	// gradients is the number of gradients
+	// defined in the SVG document.
+	gradients int
+	// clips is the number of clipping paths
+	// defined in the SVG document.
+	clips int
+
+	// prec is the number of significant digits
+	// of the coordinates and lengths written to
+	// the SVG document.
+	prec int
 }
 
+var (
+	_ vg.Clipper        = (*Canvas)(nil)
+	_ vg.GradientFiller = (*Canvas)(nil)
+	_ vg.Grouper        = (*Canvas)(nil)
+	_ vg.LineCapJoiner  = (*Canvas)(nil)
+	_ vg.PatternFiller  = (*Canvas)(nil)
+	_ vg.Tooltipper     = (*Canvas)(nil)
+	_ vg.Transformer    = (*Canvas)(nil)
+)
+
 type context struct {
 	color      color.Color
 	dashArray  []vg.Length
 	dashOffset vg.Length
 	lineWidth  vg.Length
+	lineCap    vg.LineCap
+	lineJoin   vg.LineJoin
 	gEnds      int
 }
 
@@ -62,14 +97,42 @@ func UseWH(w, h vg.Length) option {
 	}
 }
 
+// EmbedFonts specifies whether the fonts used by the canvas are
+// embedded in the SVG document as @font-face rules. Embedding
+// the fonts ensures that text is rendered with the fonts used
+// to lay out the plot, whether or not they are installed on the
+// system displaying the document. Only the fonts actually used
+// are embedded. The default is not to embed fonts.
+func EmbedFonts(v bool) option {
+	return func(c *Canvas) {
+		c.embed = v
+	}
+}
+
+// Precision specifies the number of significant digits of the
+// coordinates, transforms and lengths written to the SVG document.
+// Fewer digits give smaller documents for plots with many points,
+// at the cost of rounding the positions of the drawn elements.
+// Values with more digits before the decimal point than the
+// precision are written in exponent notation. The default is 5.
+func Precision(n int) option {
+	return func(c *Canvas) {
+		if n <= 0 {
+			panic("vgsvg: precision must be > 0")
+		}
+		c.prec = n
+	}
+}
+
 // New returns a new image canvas.
 func New(w, h vg.Length) *Canvas {
 	return NewWith(UseWH(w, h))
 }
 
 // NewWith returns a new image canvas created according to the specified
-// options. The currently accepted options is UseWH. If size is not
-// specified, the default is used.
+// options. The currently accepted options are UseWH, EmbedFonts and
+// Precision.
+// If size is not specified, the default is used.
 func NewWith(opts ...option) *Canvas {
 	buf := new(bytes.Buffer)
 	c := &Canvas{
@@ -78,6 +141,8 @@ func NewWith(opts ...option) *Canvas {
 		h:     DefaultHeight,
 		buf:   buf,
 		stack: []context{{}},
+		fonts: make(map[string]struct{}),
+		prec:  pr,
 	}
 
 	for _, opt := range opts {
@@ -91,16 +156,16 @@ func NewWith(opts ...option) *Canvas {
 <svg width="%.*gpt" height="%.*gpt" viewBox="0 0 %.*g %.*g"
 	xmlns="http://www.w3.org/2000/svg"
 	xmlns:xlink="http://www.w3.org/1999/xlink">`+"\n",
-		pr, c.w,
-		pr, c.h,
-		pr, c.w,
-		pr, c.h,
+		c.prec, c.w,
+		c.prec, c.h,
+		c.prec, c.w,
+		c.prec, c.h,
 	)
 
 	// Swap the origin to the bottom left.
 	// This must be matched with a </g> when saving,
 	// before the closing </svg>.
-	c.svg.Gtransform(fmt.Sprintf("scale(1, -1) translate(0, -%.*g)", pr, c.h.Points()))
+	c.svg.Gtransform(fmt.Sprintf("scale(1, -1) translate(0, -%.*g)", c.prec, c.h.Points()))
 
 	vg.Initialize(c)
 	return c
@@ -127,6 +192,16 @@ func (c *Canvas) SetColor(clr color.Color) {
 	c.context().color = clr
 }
 
+// SetLineCap implements the vg.LineCapJoiner interface.
+func (c *Canvas) SetLineCap(lc vg.LineCap) {
+	c.context().lineCap = lc
+}
+
+// SetLineJoin implements the vg.LineCapJoiner interface.
+func (c *Canvas) SetLineJoin(lj vg.LineJoin) {
+	c.context().lineJoin = lj
+}
+
 func (c *Canvas) Rotate(rot float64) {
 	rot = rot * 180 / math.Pi
 	c.svg.Rotate(rot)
@@ -134,7 +209,7 @@ func (c *Canvas) Rotate(rot float64) {
 }
 
 func (c *Canvas) Translate(pt vg.Point) {
-	c.svg.Gtransform(fmt.Sprintf("translate(%.*g, %.*g)", pr, pt.X.Points(), pr, pt.Y.Points()))
+	c.svg.Gtransform(fmt.Sprintf("translate(%.*g, %.*g)", c.prec, pt.X.Points(), c.prec, pt.Y.Points()))
 	c.context().gEnds++
 }
 
@@ -143,6 +218,14 @@ func (c *Canvas) Scale(x, y float64) {
 	c.context().gEnds++
 }
 
+// Transform applies the affine transform m to the context,
+// implementing the vg.Transformer interface.
+func (c *Canvas) Transform(m vg.Matrix) {
+	c.svg.Gtransform(fmt.Sprintf("matrix(%.*g, %.*g, %.*g, %.*g, %.*g, %.*g)",
+		c.prec, m.A, c.prec, m.B, c.prec, m.C, c.prec, m.D, c.prec, m.E.Points(), c.prec, m.F.Points()))
+	c.context().gEnds++
+}
+
 func (c *Canvas) Push() {
 	top := *c.context()
 	top.gEnds = 0
@@ -156,6 +239,48 @@ func (c *Canvas) Pop() {
 	c.stack = c.stack[:len(c.stack)-1]
 }
 
+// Tooltip implements the vg.Tooltipper interface. The shapes
+// drawn until the next call to Pop are grouped, with the text
+// as the title of the group, which browsers display when the
+// pointer hovers over the shapes.
+func (c *Canvas) Tooltip(text string) {
+	fmt.Fprintf(c.buf, "<g>\n<title>%s</title>\n", html.EscapeString(text))
+	c.context().gEnds++
+}
+
+// Group implements the vg.Grouper interface. The shapes
+// drawn until the next call to Pop are grouped in a g
+// element with the id and class attributes, so that they
+// can be selected by CSS rules. Empty attributes are left
+// out.
+func (c *Canvas) Group(id, class string) {
+	c.buf.WriteString("<g")
+	if id != "" {
+		fmt.Fprintf(c.buf, ` id="%s"`, html.EscapeString(id))
+	}
+	if class != "" {
+		fmt.Fprintf(c.buf, ` class="%s"`, html.EscapeString(class))
+	}
+	c.buf.WriteString(">\n")
+	c.context().gEnds++
+}
+
+// Clip implements the vg.Clipper interface. The shapes drawn
+// until the next call to Pop are grouped, and the group is
+// clipped by an SVG clipPath.
+func (c *Canvas) Clip(path vg.Path) {
+	c.clips++
+	id := fmt.Sprintf("clip%d", c.clips)
+	fmt.Fprintf(c.buf, `<defs>
+<clipPath id="%s">
+<path d="%s" />
+</clipPath>
+</defs>
+<g clip-path="url(#%s)">
+`, id, c.pathData(path), id)
+	c.context().gEnds++
+}
+
 func (c *Canvas) Stroke(path vg.Path) {
 	if c.context().lineWidth.Points() <= 0 {
 		return
@@ -164,9 +289,11 @@ func (c *Canvas) Stroke(path vg.Path) {
 		style(elm("fill", "#000000", "none"),
 			elm("stroke", "none", colorString(c.context().color)),
 			elm("stroke-opacity", "1", opacityString(c.context().color)),
-			elm("stroke-width", "1", "%.*g", pr, c.context().lineWidth.Points()),
+			elm("stroke-width", "1", "%.*g", c.prec, c.context().lineWidth.Points()),
 			elm("stroke-dasharray", "none", dashArrayString(c)),
-			elm("stroke-dashoffset", "0", "%.*g", pr, c.context().dashOffset.Points())))
+			elm("stroke-dashoffset", "0", "%.*g", c.prec, c.context().dashOffset.Points()),
+			elm("stroke-linecap", "butt", lineCapString(c.context().lineCap)),
+			elm("stroke-linejoin", "miter", lineJoinString(c.context().lineJoin))))
 }
 
 func (c *Canvas) Fill(path vg.Path) {
@@ -175,17 +302,65 @@ func (c *Canvas) Fill(path vg.Path) {
 			elm("fill-opacity", "1", opacityString(c.context().color))))
 }
 
+// FillPattern fills the path with the pattern, implementing
+// the vg.PatternFiller interface. The pattern is defined as an
+// SVG pattern, with its cells aligned with the origin of the
+// current coordinate system of the canvas.
+func (c *Canvas) FillPattern(path vg.Path, pat vg.Pattern) {
+	sz := pat.Size()
+	cell := &Canvas{
+		w:     sz.X,
+		h:     sz.Y,
+		buf:   new(bytes.Buffer),
+		stack: []context{{}},
+		embed: c.embed,
+		fonts: c.fonts,
+	}
+	cell.svg = svgo.New(cell.buf)
+	vg.Initialize(cell)
+	pat.DrawCell(cell)
+	for i := 1; i < cell.nEnds(); i++ {
+		cell.svg.Gend()
+	}
+
+	c.patterns++
+	id := fmt.Sprintf("pattern%d", c.patterns)
+	fmt.Fprintf(c.buf, `<defs>
+<pattern id="%s" patternUnits="userSpaceOnUse" width="%.*g" height="%.*g">
+%s</pattern>
+</defs>
+`, id, c.prec, sz.X.Points(), c.prec, sz.Y.Points(), cell.buf)
+	c.svg.Path(c.pathData(path), style(elm("fill", "", "url(#"+id+")")))
+}
+
+// FillGradient implements the vg.GradientFiller interface,
+// defining the gradient as an SVG linearGradient.
+func (c *Canvas) FillGradient(path vg.Path, g vg.LinearGradient) {
+	c.gradients++
+	id := fmt.Sprintf("gradient%d", c.gradients)
+	fmt.Fprintf(c.buf, `<defs>
+<linearGradient id="%s" gradientUnits="userSpaceOnUse" x1="%.*g" y1="%.*g" x2="%.*g" y2="%.*g">
+`, id, c.prec, g.Start.X.Points(), c.prec, g.Start.Y.Points(), c.prec, g.End.X.Points(), c.prec, g.End.Y.Points())
+	for _, s := range g.Stops {
+		clr := color.NRGBAModel.Convert(s.Color).(color.NRGBA)
+		fmt.Fprintf(c.buf, `<stop offset="%.*g" stop-color="#%02X%02X%02X" stop-opacity="%.*g"/>
+`, pr, s.Offset, clr.R, clr.G, clr.B, pr, float64(clr.A)/math.MaxUint8)
+	}
+	fmt.Fprint(c.buf, "</linearGradient>\n</defs>\n")
+	c.svg.Path(c.pathData(path), style(elm("fill", "", "url(#"+id+")")))
+}
+
 func (c *Canvas) pathData(path vg.Path) string {
 	buf := new(bytes.Buffer)
 	var x, y float64
 	for _, comp := range path {
 		switch comp.Type {
 		case vg.MoveComp:
-			fmt.Fprintf(buf, "M%.*g,%.*g", pr, comp.Pos.X.Points(), pr, comp.Pos.Y.Points())
+			fmt.Fprintf(buf, "M%.*g,%.*g", c.prec, comp.Pos.X.Points(), c.prec, comp.Pos.Y.Points())
 			x = comp.Pos.X.Points()
 			y = comp.Pos.Y.Points()
 		case vg.LineComp:
-			fmt.Fprintf(buf, "L%.*g,%.*g", pr, comp.Pos.X.Points(), pr, comp.Pos.Y.Points())
+			fmt.Fprintf(buf, "L%.*g,%.*g", c.prec, comp.Pos.X.Points(), c.prec, comp.Pos.Y.Points())
 			x = comp.Pos.X.Points()
 			y = comp.Pos.Y.Points()
 		case vg.ArcComp:
@@ -193,7 +368,7 @@ func (c *Canvas) pathData(path vg.Path) string {
 			x0 := comp.Pos.X.Points() + r*math.Cos(comp.Start)
 			y0 := comp.Pos.Y.Points() + r*math.Sin(comp.Start)
 			if x0 != x || y0 != y {
-				fmt.Fprintf(buf, "L%.*g,%.*g", pr, x0, pr, y0)
+				fmt.Fprintf(buf, "L%.*g,%.*g", c.prec, x0, c.prec, y0)
 			}
 			if math.Abs(comp.Angle) >= 2*math.Pi {
 				x, y = circle(buf, c, &comp)
@@ -204,13 +379,13 @@ func (c *Canvas) pathData(path vg.Path) string {
 			switch len(comp.Control) {
 			case 1:
 				fmt.Fprintf(buf, "Q%.*g,%.*g,%.*g,%.*g",
-					pr, comp.Control[0].X.Points(), pr, comp.Control[0].Y.Points(),
-					pr, comp.Pos.X.Points(), pr, comp.Pos.Y.Points())
+					c.prec, comp.Control[0].X.Points(), c.prec, comp.Control[0].Y.Points(),
+					c.prec, comp.Pos.X.Points(), c.prec, comp.Pos.Y.Points())
 			case 2:
 				fmt.Fprintf(buf, "C%.*g,%.*g,%.*g,%.*g,%.*g,%.*g",
-					pr, comp.Control[0].X.Points(), pr, comp.Control[0].Y.Points(),
-					pr, comp.Control[1].X.Points(), pr, comp.Control[1].Y.Points(),
-					pr, comp.Pos.X.Points(), pr, comp.Pos.Y.Points())
+					c.prec, comp.Control[0].X.Points(), c.prec, comp.Control[0].Y.Points(),
+					c.prec, comp.Control[1].X.Points(), c.prec, comp.Control[1].Y.Points(),
+					c.prec, comp.Pos.X.Points(), c.prec, comp.Pos.Y.Points())
 			default:
 				panic("vgsvg: invalid number of control points")
 			}
@@ -245,10 +420,10 @@ func circle(w io.Writer, c *Canvas, comp *vg.PathComp) (x, y float64) {
 	x = comp.Pos.X.Points() + r*math.Cos(comp.Start+angle)
 	y = comp.Pos.Y.Points() + r*math.Sin(comp.Start+angle)
 
-	fmt.Fprintf(w, "A%.*g,%.*g 0 %d %d %.*g,%.*g", pr, r, pr, r,
-		large(angle/2), sweep(angle/2), pr, x0, pr, y0) //
-	fmt.Fprintf(w, "A%.*g,%.*g 0 %d %d %.*g,%.*g", pr, r, pr, r,
-		large(angle/2), sweep(angle/2), pr, x, pr, y)
+	fmt.Fprintf(w, "A%.*g,%.*g 0 %d %d %.*g,%.*g", c.prec, r, c.prec, r,
+		large(angle/2), sweep(angle/2), c.prec, x0, c.prec, y0) //
+	fmt.Fprintf(w, "A%.*g,%.*g 0 %d %d %.*g,%.*g", c.prec, r, c.prec, r,
+		large(angle/2), sweep(angle/2), c.prec, x, c.prec, y)
 	return
 }
 
@@ -268,8 +443,8 @@ func arc(w io.Writer, c *Canvas, comp *vg.PathComp) (x, y float64) {
 	r := comp.Radius.Points()
 	x = comp.Pos.X.Points() + r*math.Cos(comp.Start+comp.Angle)
 	y = comp.Pos.Y.Points() + r*math.Sin(comp.Start+comp.Angle)
-	fmt.Fprintf(w, "A%.*g,%.*g 0 %d %d %.*g,%.*g", pr, r, pr, r,
-		large(comp.Angle), sweep(comp.Angle), pr, x, pr, y)
+	fmt.Fprintf(w, "A%.*g,%.*g 0 %d %d %.*g,%.*g", c.prec, r, c.prec, r,
+		large(comp.Angle), sweep(comp.Angle), c.prec, x, c.prec, y)
 	return
 }
 
@@ -293,19 +468,44 @@ func large(a float64) int {
 
 // FillString draws str at position pt using the specified font.
 // Text passed to FillString is escaped with html.EscapeString.
+//
+// Fonts other than the standard Postscript fonts are referred
+// to by their name as font family.
 func (c *Canvas) FillString(font vg.Font, pt vg.Point, str string) {
 	fontStr, ok := fontMap[font.Name()]
 	if !ok {
-		panic(fmt.Sprintf("Unknown font: %s", font.Name()))
+		fontStr = "font-family:" + font.Name() + ";font-weight:normal;font-style:normal"
+	}
+	if c.embed {
+		c.embedFont(font.Name(), fontStr)
 	}
 	sty := style(fontStr,
-		elm("font-size", "medium", "%.*gpx", pr, font.Size.Points()),
+		elm("font-size", "medium", "%.*gpx", c.prec, font.Size.Points()),
 		elm("fill", "#000000", colorString(c.context().color)))
 	if sty != "" {
 		sty = "\n\t" + sty
 	}
 	fmt.Fprintf(c.buf, `<text x="%.*g" y="%.*g" transform="scale(1, -1)"%s>%s</text>`+"\n",
-		pr, pt.X.Points(), pr, -pt.Y.Points(), sty, html.EscapeString(str))
+		c.prec, pt.X.Points(), c.prec, -pt.Y.Points(), sty, html.EscapeString(str))
+}
+
+// embedFont adds a @font-face rule to the SVG document for the
+// named font with the given style, if it has not been added yet.
+// The font data is embedded in the rule as base64 encoded TrueType
+// data. Style elements apply to the whole document, wherever they
+// appear in it, so the rule is written before the first text using
+// the font.
+func (c *Canvas) embedFont(name, fontStr string) {
+	if _, ok := c.fonts[name]; ok {
+		return
+	}
+	data, err := vg.FontData(name)
+	if err != nil {
+		panic(fmt.Errorf("vgsvg: could not embed font %q: %+v", name, err))
+	}
+	fmt.Fprintf(c.buf, "<defs>\n<style>\n@font-face {\n\t%s;\n\tsrc:url(\"data:font/ttf;base64,%s\") format(\"truetype\");\n}\n</style>\n</defs>\n",
+		strings.Replace(fontStr, ";", ";\n\t", -1), base64.StdEncoding.EncodeToString(data))
+	c.fonts[name] = struct{}{}
 }
 
 // DrawImage implements the vg.Canvas.DrawImage method.
@@ -426,12 +626,36 @@ func elm(key, def, f string, vls ...interface{}) string {
 	return key + ":" + value
 }
 
+// lineCapString returns the SVG name of the line cap.
+func lineCapString(lc vg.LineCap) string {
+	switch lc {
+	case vg.RoundCap:
+		return "round"
+	case vg.SquareCap:
+		return "square"
+	default:
+		return "butt"
+	}
+}
+
+// lineJoinString returns the SVG name of the line join.
+func lineJoinString(lj vg.LineJoin) string {
+	switch lj {
+	case vg.RoundJoin:
+		return "round"
+	case vg.BevelJoin:
+		return "bevel"
+	default:
+		return "miter"
+	}
+}
+
 // dashArrayString returns a string representing the
 // dash array specification.
 func dashArrayString(c *Canvas) string {
 	str := ""
 	for i, d := range c.context().dashArray {
-		str += fmt.Sprintf("%.*g", pr, d.Points())
+		str += fmt.Sprintf("%.*g", c.prec, d.Points())
 		if i < len(c.context().dashArray)-1 {
 			str += ","
 		}
@@ -6,7 +6,13 @@ package vgsvg_test
 
 import (
 	"bytes"
+	"fmt"
+	"image/color"
 	"io/ioutil"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
 	"gonum.org/v1/plot"
@@ -101,3 +107,327 @@ func TestHtmlEscape(t *testing.T) {
 		t.Fatalf("images differ:\ngot:\n%s\nwant:\n%s\n", b.Bytes(), want)
 	}
 }
+
+func TestEmbedFonts(t *testing.T) {
+	// Register a non-standard font, using the data of a Liberation font.
+	vg.FontMap["Custom-Sans"] = "LiberationSans-Regular"
+	defer delete(vg.FontMap, "Custom-Sans")
+
+	custom, err := vg.MakeFont("Custom-Sans", 12)
+	if err != nil {
+		t.Fatalf("could not create custom font: %v", err)
+	}
+	times, err := vg.MakeFont("Times-Roman", 12)
+	if err != nil {
+		t.Fatalf("could not create font: %v", err)
+	}
+
+	for _, embed := range []bool{false, true} {
+		c := vgsvg.NewWith(vgsvg.UseWH(5*vg.Centimeter, 5*vg.Centimeter), vgsvg.EmbedFonts(embed))
+		c.FillString(custom, vg.Point{X: 10, Y: 10}, "custom")
+		c.FillString(custom, vg.Point{X: 10, Y: 30}, "custom again")
+		c.FillString(times, vg.Point{X: 10, Y: 50}, "times")
+
+		b := new(bytes.Buffer)
+		if _, err = c.WriteTo(b); err != nil {
+			t.Fatal(err)
+		}
+		svg := b.String()
+
+		if !strings.Contains(svg, "font-family:Custom-Sans") {
+			t.Errorf("text does not refer to the custom font family with embed=%t", embed)
+		}
+		want := 0
+		if embed {
+			want = 2
+		}
+		if got := strings.Count(svg, "@font-face"); got != want {
+			t.Errorf("unexpected number of @font-face rules with embed=%t: got:%d want:%d", embed, got, want)
+		}
+		if !embed {
+			continue
+		}
+		for _, family := range []string{"Custom-Sans", "Times"} {
+			if !strings.Contains(svg, "@font-face {\n\tfont-family:"+family+";") {
+				t.Errorf("missing @font-face rule for font family %q", family)
+			}
+		}
+		if !strings.Contains(svg, `src:url("data:font/ttf;base64,`) {
+			t.Error("missing embedded font data")
+		}
+	}
+}
+
+func TestFillPattern(t *testing.T) {
+	c := vgsvg.NewWith(vgsvg.UseWH(5*vg.Centimeter, 5*vg.Centimeter))
+	dc := draw.New(c)
+	hatch := vg.Hatch{Color: color.Black, Width: 1, Spacing: 5}
+	pts := []vg.Point{{X: 10, Y: 10}, {X: 100, Y: 10}, {X: 50, Y: 100}}
+	dc.FillPolygonPattern(hatch, pts)
+	dc.FillPolygonPattern(hatch, pts)
+
+	b := new(bytes.Buffer)
+	if _, err := c.WriteTo(b); err != nil {
+		t.Fatal(err)
+	}
+	svg := b.String()
+
+	for _, want := range []string{
+		`<pattern id="pattern1" patternUnits="userSpaceOnUse" width="5" height="5">`,
+		`<pattern id="pattern2" patternUnits="userSpaceOnUse" width="5" height="5">`,
+		`style="fill:url(#pattern1)"`,
+		`style="fill:url(#pattern2)"`,
+	} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("SVG does not contain %q:\n%s", want, svg)
+		}
+	}
+	if got, want := strings.Count(svg, "</pattern>"), 2; got != want {
+		t.Errorf("unexpected number of patterns: got:%d want:%d", got, want)
+	}
+	if strings.Contains(svg, "<image") {
+		t.Error("pattern was rasterized")
+	}
+}
+
+func TestTooltip(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	s, err := plotter.NewScatter(plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}})
+	if err != nil {
+		t.Fatalf("could not create scatter: %v", err)
+	}
+	tips := []string{"first", "", "<third>"}
+	s.TooltipFunc = func(i int) string { return tips[i] }
+	p.Add(s)
+
+	c := vgsvg.New(10*vg.Centimeter, 10*vg.Centimeter)
+	p.Draw(draw.New(c))
+	var buf bytes.Buffer
+	_, err = c.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("could not write canvas: %v", err)
+	}
+
+	for _, tip := range []string{"first", "&lt;third&gt;"} {
+		re := regexp.MustCompile(`<g>\n<title>` + tip + `</title>\n<path d="[^"]*"\s+style="[^"]*" />\n</g>`)
+		if !re.Match(buf.Bytes()) {
+			t.Errorf("missing glyph group with tooltip %q", tip)
+		}
+	}
+	if got := strings.Count(buf.String(), "<title>"); got != 2 {
+		t.Errorf("unexpected number of tooltips: got:%d want:2", got)
+	}
+}
+
+func TestGroup(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	for _, name := range []string{"a", "<b>"} {
+		l, err := plotter.NewLine(plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 1}})
+		if err != nil {
+			t.Fatalf("could not create line: %v", err)
+		}
+		g := &plotter.Group{Plotter: l, ID: "line-" + name, Class: "series series-" + name}
+		p.Add(g)
+		p.Legend.Add(name, g)
+	}
+
+	c := vgsvg.New(10*vg.Centimeter, 10*vg.Centimeter)
+	p.Draw(draw.New(c))
+	var buf bytes.Buffer
+	_, err = c.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("could not write canvas: %v", err)
+	}
+
+	for _, name := range []string{"a", "&lt;b&gt;"} {
+		re := regexp.MustCompile(`<g id="line-` + name + `" class="series series-` + name + `">\n(<g[^>]*>\n)*<path d="[^"]*"\s+style="[^"]*" />\n`)
+		if !re.Match(buf.Bytes()) {
+			t.Errorf("missing series group of line %q", name)
+		}
+		re = regexp.MustCompile(`<g class="series series-` + name + `">\n(<g[^>]*>\n)*<path d="[^"]*"\s+style="[^"]*" />\n`)
+		if !re.Match(buf.Bytes()) {
+			t.Errorf("missing legend group of line %q", name)
+		}
+	}
+}
+
+func TestLineCapJoin(t *testing.T) {
+	c := vgsvg.New(10, 10)
+	var p vg.Path
+	p.Move(vg.Point{X: 1, Y: 1})
+	p.Line(vg.Point{X: 5, Y: 9})
+	p.Line(vg.Point{X: 9, Y: 1})
+
+	c.Stroke(p)
+	c.Push()
+	c.SetLineCap(vg.RoundCap)
+	c.SetLineJoin(vg.BevelJoin)
+	c.Stroke(p)
+	c.Push()
+	c.SetLineCap(vg.SquareCap)
+	c.SetLineJoin(vg.RoundJoin)
+	c.Stroke(p)
+	c.Pop()
+	c.Stroke(p)
+	c.Pop()
+	c.Stroke(p)
+
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatalf("could not write canvas: %v", err)
+	}
+	re := regexp.MustCompile(`<path d="[^"]*"\s+style="([^"]*)" />`)
+	var got []string
+	for _, m := range re.FindAllStringSubmatch(buf.String(), -1) {
+		var style []string
+		for _, s := range strings.Split(m[1], ";") {
+			if strings.HasPrefix(s, "stroke-line") {
+				style = append(style, s)
+			}
+		}
+		got = append(got, strings.Join(style, ";"))
+	}
+	want := []string{
+		"",
+		"stroke-linecap:round;stroke-linejoin:bevel",
+		"stroke-linecap:square;stroke-linejoin:round",
+		"stroke-linecap:round;stroke-linejoin:bevel",
+		"",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected line styles:\ngot: %q\nwant:%q", got, want)
+	}
+}
+
+func TestFillGradient(t *testing.T) {
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("could not create plot: %v", err)
+	}
+	l, err := plotter.NewLine(plotter.XYs{{X: 0, Y: 1}, {X: 1, Y: 3}, {X: 2, Y: 2}})
+	if err != nil {
+		t.Fatalf("could not create line: %v", err)
+	}
+	l.FillGradient = []vg.GradientStop{
+		{Offset: 0, Color: color.NRGBA{B: 255, A: 0}},
+		{Offset: 1, Color: color.NRGBA{B: 255, A: 255}},
+	}
+	p.Add(l)
+
+	c := vgsvg.New(10*vg.Centimeter, 10*vg.Centimeter)
+	p.Draw(draw.New(c))
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatalf("could not write canvas: %v", err)
+	}
+	svg := buf.String()
+
+	re := regexp.MustCompile(`<linearGradient id="gradient1" gradientUnits="userSpaceOnUse" ` +
+		`x1="([^"]*)" y1="([^"]*)" x2="([^"]*)" y2="([^"]*)">\n` +
+		`<stop offset="0" stop-color="#0000FF" stop-opacity="0"/>\n` +
+		`<stop offset="1" stop-color="#0000FF" stop-opacity="1"/>\n` +
+		`</linearGradient>`)
+	m := re.FindStringSubmatch(svg)
+	if m == nil {
+		t.Fatalf("SVG does not contain the expected gradient:\n%s", svg)
+	}
+	if m[1] != m[3] {
+		t.Errorf("gradient is not vertical: x1=%s x2=%s", m[1], m[3])
+	}
+	if m[2] == m[4] {
+		t.Errorf("gradient has no extent: y1=%s y2=%s", m[2], m[4])
+	}
+	if !strings.Contains(svg, `style="fill:url(#gradient1)"`) {
+		t.Error("SVG does not fill with the gradient")
+	}
+}
+
+func TestClip(t *testing.T) {
+	c := vgsvg.New(10, 10)
+	r := vg.Rectangle{Min: vg.Point{X: 2, Y: 2}, Max: vg.Point{X: 8, Y: 8}}
+	c.Push()
+	c.Clip(r.Path())
+	c.Fill(vg.Rectangle{Max: vg.Point{X: 10, Y: 10}}.Path())
+	c.Pop()
+	c.Fill(r.Path())
+
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatalf("could not write canvas: %v", err)
+	}
+	re := regexp.MustCompile(`<clipPath id="clip1">\n<path d="[^"]+" />\n</clipPath>\n</defs>\n` +
+		`<g clip-path="url\(#clip1\)">\n<path d="[^"]+"\s+/>\n</g>\n<path d=`)
+	if !re.Match(buf.Bytes()) {
+		t.Errorf("unexpected clipped group:\n%s", buf.Bytes())
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	var path vg.Path
+	path.Move(vg.Point{X: 1.23456789, Y: 98.7654321})
+	path.Line(vg.Point{X: 12.3456789, Y: 0.00123456789})
+
+	for _, test := range []struct {
+		prec int
+		want string
+	}{
+		{prec: 0, want: `d="M1.2346,98.765L12.346,0.0012346"`},
+		{prec: 3, want: `d="M1.23,98.8L12.3,0.00123"`},
+		{prec: 1, want: `d="M1,1e+02L1e+01,0.001"`},
+	} {
+		c := vgsvg.NewWith(vgsvg.UseWH(5*vg.Centimeter, 5*vg.Centimeter))
+		if test.prec != 0 {
+			c = vgsvg.NewWith(vgsvg.UseWH(5*vg.Centimeter, 5*vg.Centimeter), vgsvg.Precision(test.prec))
+		}
+		c.Translate(vg.Point{X: 1.23456789, Y: 2})
+		c.Stroke(path)
+
+		b := new(bytes.Buffer)
+		if _, err := c.WriteTo(b); err != nil {
+			t.Fatal(err)
+		}
+		svg := b.String()
+		if !strings.Contains(svg, test.want) {
+			t.Errorf("unexpected path data with precision %d: want %s in:\n%s", test.prec, test.want, svg)
+		}
+		prec := test.prec
+		if prec == 0 {
+			prec = 5
+		}
+		wantTranslate := fmt.Sprintf("translate(%.*g, 2)", prec, 1.23456789)
+		if !strings.Contains(svg, wantTranslate) {
+			t.Errorf("unexpected translation with precision %d: want %s in:\n%s", test.prec, wantTranslate, svg)
+		}
+	}
+}
+
+func BenchmarkPrecision(b *testing.B) {
+	var path vg.Path
+	path.Move(vg.Point{})
+	for i := 1; i < 10000; i++ {
+		x := float64(i) / 10000
+		path.Line(vg.Point{X: vg.Length(x) * 10 * vg.Centimeter, Y: vg.Length(math.Sin(20*x)+1) * 5 * vg.Centimeter})
+	}
+
+	for _, prec := range []int{3, 4, 5, 8} {
+		b.Run(fmt.Sprintf("%d", prec), func(b *testing.B) {
+			var size int64
+			for i := 0; i < b.N; i++ {
+				c := vgsvg.NewWith(vgsvg.UseWH(10*vg.Centimeter, 10*vg.Centimeter), vgsvg.Precision(prec))
+				c.Stroke(path)
+				n, err := c.WriteTo(ioutil.Discard)
+				if err != nil {
+					b.Fatal(err)
+				}
+				size = n
+			}
+			b.ReportMetric(float64(size), "bytes/svg")
+		})
+	}
+}
@@ -12,13 +12,14 @@ package vgtex // import "gonum.org/v1/plot/vg/vgtex"
 import (
 	"bufio"
 	"bytes"
+	"encoding/base64"
 	"fmt"
 	"image"
 	"image/color"
 	"image/png"
 	"io"
+	"io/ioutil"
 	"math"
-	"os"
 	"strings"
 	"time"
 
@@ -28,12 +29,8 @@ import (
 const degPerRadian = 180 / math.Pi
 
 const (
-	defaultHeader = `%%%%%% generated by gonum/plot %%%%%%
-\documentclass{standalone}
-\usepackage{pgf}
-\begin{document}
-`
-	defaultFooter = "\\end{document}\n"
+	defaultDocumentClass = "standalone"
+	defaultFooter        = "\\end{document}\n"
 )
 
 // Canvas implements the vg.Canvas interface, translating drawing
@@ -47,6 +44,54 @@ type Canvas struct {
 	// .tex file that can be fed to, e.g., pdflatex.
 	document bool
 	id       int64 // id is a unique identifier for this canvas
+
+	// class is the LaTeX document class used in document mode.
+	class string
+	// packages are the LaTeX packages required by the output,
+	// in addition to pgf.
+	packages []texPackage
+
+	// If embed is true, images are embedded in the output
+	// instead of being written to external files.
+	embed  bool
+	images []texImage
+
+	// If stream is true, runs of straight line segments
+	// are written as PGF plot streams.
+	stream bool
+	// streamed is true if a plot stream was written,
+	// so that the streampoint macro must be defined.
+	streamed bool
+
+	// colors holds the distinct colors used while drawing,
+	// in order of first use. They are emitted as named
+	// colors ahead of the pgfpicture body.
+	colors []rgb
+	cnames map[rgb]string
+}
+
+// texPackage is a LaTeX package and its options.
+type texPackage struct {
+	name    string
+	options string
+}
+
+func (p texPackage) String() string {
+	if p.options == "" {
+		return fmt.Sprintf(`\usepackage{%s}`, p.name)
+	}
+	return fmt.Sprintf(`\usepackage[%s]{%s}`, p.options, p.name)
+}
+
+// texImage is a PNG image embedded in the LaTeX output.
+type texImage struct {
+	name string
+	data []byte
+}
+
+// rgb is the opaque part of a color, as registered by the canvas.
+type rgb struct {
+	r, g, b uint32
 }
 
 type context struct {
@@ -74,20 +119,51 @@ func newCanvas(w, h vg.Length, document bool) *Canvas {
 		h:        h,
 		document: document,
 		id:       time.Now().UnixNano(),
+		class:    defaultDocumentClass,
+		cnames:   make(map[rgb]string),
 	}
-	if !document {
-		c.wtex(`%%%% gonum/plot created for LaTeX/pgf`)
-		c.wtex(`%%%% you need to add:`)
-		c.wtex(`%%%%   \usepackage{pgf}`)
-		c.wtex(`%%%% to your LaTeX document`)
-	}
-	c.wtex("")
-	c.wtex(`\begin{pgfpicture}`)
 	c.stack = make([]context, 1)
 	vg.Initialize(c)
 	return c
 }
 
+// SetDocumentClass sets the LaTeX document class used when the canvas
+// was created with NewDocument. The default is "standalone".
+func (c *Canvas) SetDocumentClass(class string) {
+	c.class = class
+}
+
+// AddPackage adds a LaTeX package, with the given comma-separated options,
+// to the preamble of the generated document.
+// The pgf package is always included.
+//
+// When the canvas is not in document mode, the required packages are
+// listed in a comment at the top of the output.
+func (c *Canvas) AddPackage(name, options string) {
+	c.packages = append(c.packages, texPackage{name: name, options: options})
+}
+
+// SetEmbedImages sets whether images drawn with DrawImage are embedded,
+// base64-encoded, inside the generated LaTeX rather than written to
+// external PNG files.
+//
+// Embedded images are extracted by LaTeX when the document is compiled,
+// which requires a LaTeX distribution supporting filecontents* [overwrite]
+// and the base64 tool being allowed through --shell-escape.
+func (c *Canvas) SetEmbedImages(embed bool) {
+	c.embed = embed
+}
+
+// SetStreamLines sets whether the runs of straight line segments of
+// the paths drawn on the canvas are written as PGF plot streams, with
+// the points of a run packed on a few lines, instead of one path
+// command per segment. This shrinks the output, and the time taken by
+// LaTeX to compile it, for paths with many points such as dense lines
+// and scatters. The drawn geometry is unchanged.
+func (c *Canvas) SetStreamLines(stream bool) {
+	c.stream = stream
+}
+
 func (c *Canvas) context() *context {
 	return &c.stack[len(c.stack)-1]
 }
@@ -129,6 +205,35 @@ func (c *Canvas) Scale(x, y float64) {
 	c.wtex(`\pgftransformyscale{%g}`, y)
 }
 
+// Transform implements the vg.Transformer.Transform method.
+func (c *Canvas) Transform(m vg.Matrix) {
+	c.wtex(`\pgftransformcm{%g}{%g}{%g}{%g}{\pgfpoint{%gpt}{%gpt}}`, m.A, m.B, m.C, m.D, m.E, m.F)
+}
+
+// SetLineCap implements the vg.LineCapJoiner interface.
+func (c *Canvas) SetLineCap(lc vg.LineCap) {
+	switch lc {
+	case vg.RoundCap:
+		c.wtex(`\pgfsetroundcap`)
+	case vg.SquareCap:
+		c.wtex(`\pgfsetrectcap`)
+	default:
+		c.wtex(`\pgfsetbuttcap`)
+	}
+}
+
+// SetLineJoin implements the vg.LineCapJoiner interface.
+func (c *Canvas) SetLineJoin(lj vg.LineJoin) {
+	switch lj {
+	case vg.RoundJoin:
+		c.wtex(`\pgfsetroundjoin`)
+	case vg.BevelJoin:
+		c.wtex(`\pgfsetbeveljoin`)
+	default:
+		c.wtex(`\pgfsetmiterjoin`)
+	}
+}
+
 // Push implements the vg.Canvas.Push method.
 func (c *Canvas) Push() {
 	c.wtex(`\begin{pgfscope}`)
@@ -163,31 +268,96 @@ func (c *Canvas) Fill(p vg.Path) {
 	c.Pop()
 }
 
+// Clip restricts subsequent drawing operations to the interior of the
+// given path. The clipping region is part of the state saved by Push,
+// and is thus reset by the corresponding call to Pop.
+func (c *Canvas) Clip(p vg.Path) {
+	c.wpath(p)
+	c.wtex(`\pgfusepath{clip}`)
+}
+
 // FillString implements the vg.Canvas.FillString method.
 func (c *Canvas) FillString(f vg.Font, pt vg.Point, text string) {
+	c.FillText(f, pt, 0, text)
+}
+
+// FillText fills in text at the specified location using the given font.
+// The text is horizontally aligned with respect to pt according to xalign,
+// following the draw.XAlignment convention: 0 aligns the left edge of the
+// text with pt, -0.5 its center and -1 its right edge.
+//
+// The preset alignments are mapped to PGF anchors, so that the text is
+// positioned according to its width as typeset by LaTeX.
+func (c *Canvas) FillText(f vg.Font, pt vg.Point, xalign float64, text string) {
+	var anchor string
+	switch xalign {
+	case 0:
+		anchor = "base,left"
+	case -0.5:
+		anchor = "base"
+	case -1:
+		anchor = "base,right"
+	default:
+		anchor = "base,left"
+		pt.X += vg.Length(xalign) * f.Width(text)
+	}
 	c.Push()
 	c.wcolor()
-	pt.X += 0.5 * f.Width(text)
-	c.wtex(`\pgftext[base,at={\pgfpoint{%gpt}{%gpt}}]{{\fontsize{%gpt}{%gpt}\selectfont %s}}`, pt.X, pt.Y, f.Size, f.Size, text)
+	c.wtex(`\pgftext[%s,at={\pgfpoint{%gpt}{%gpt}}]{{\fontsize{%gpt}{%gpt}\selectfont%s %s}}`, anchor, pt.X, pt.Y, f.Size, f.Size, texFont(f.Name()), text)
 	c.Pop()
 }
 
+// texFont returns the LaTeX font selection commands corresponding to
+// the named PostScript font, or the empty string if the font family
+// is unknown and the ambient document font should be used.
+func texFont(name string) string {
+	var cmd string
+	switch {
+	case strings.HasPrefix(name, "Times"):
+		cmd = `\rmfamily`
+	case strings.HasPrefix(name, "Helvetica"):
+		cmd = `\sffamily`
+	case strings.HasPrefix(name, "Courier"):
+		cmd = `\ttfamily`
+	default:
+		return ""
+	}
+	if strings.Contains(name, "Bold") {
+		cmd += `\bfseries`
+	}
+	switch {
+	case strings.Contains(name, "Italic"):
+		cmd += `\itshape`
+	case strings.Contains(name, "Oblique"):
+		cmd += `\slshape`
+	}
+	return cmd
+}
+
 // DrawImage implements the vg.Canvas.DrawImage method.
 // DrawImage will first save the image inside a PNG file and have the
 // generated LaTeX reference that file.
 // The file name will be "gonum-pgf-image-<canvas-id>-<time.Now()>.png
+//
+// If images are embedded, see SetEmbedImages, the PNG file is instead
+// stored base64-encoded in the LaTeX output and extracted at compile time.
 func (c *Canvas) DrawImage(rect vg.Rectangle, img image.Image) {
 	fname := fmt.Sprintf("gonum-pgf-image-%v-%v.png", c.id, time.Now().UnixNano())
-	f, err := os.Create(fname)
-	if err != nil {
-		panic(err)
-	}
-	defer f.Close()
-	err = png.Encode(f, img)
+	buf := new(bytes.Buffer)
+	err := png.Encode(buf, img)
 	if err != nil {
 		panic(fmt.Errorf("vgtex: error encoding image to PNG: %v", err))
 	}
 
+	if c.embed {
+		c.images = append(c.images, texImage{name: fname, data: buf.Bytes()})
+	} else {
+		err = ioutil.WriteFile(fname, buf.Bytes(), 0644)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	var (
 		xmin   = rect.Min.X
 		ymin   = rect.Min.Y
@@ -234,28 +404,44 @@ func (c *Canvas) wcolor() {
 		col = color.Black
 	}
 	r, g, b, a := col.RGBA()
-	// FIXME(sbinet) \color will last until the end of the current TeX group
-	// use \pgfsetcolor and \pgfsetstrokecolor instead.
-	// it needs a named color: define it on the fly (storing it at the beginning
-	// of the document.)
-	c.wtex(
-		`\color[rgb]{%g,%g,%g}`,
-		float64(r)/math.MaxUint16,
-		float64(g)/math.MaxUint16,
-		float64(b)/math.MaxUint16,
-	)
+	name := c.colorName(rgb{r: r, g: g, b: b})
+	c.wtex(`\pgfsetcolor{%s}`, name)
+	c.wtex(`\pgfsetstrokecolor{%s}`, name)
 
 	opacity := float64(a) / math.MaxUint16
 	c.wtex(`\pgfsetstrokeopacity{%g}`, opacity)
 	c.wtex(`\pgfsetfillopacity{%g}`, opacity)
 }
 
+// colorName returns the name of the PGF color associated with col,
+// registering a new named color if col has not been used yet.
+func (c *Canvas) colorName(col rgb) string {
+	name, ok := c.cnames[col]
+	if !ok {
+		name = fmt.Sprintf("plotcolor%d", len(c.colors))
+		c.cnames[col] = name
+		c.colors = append(c.colors, col)
+	}
+	return name
+}
+
 func (c *Canvas) wpath(p vg.Path) {
-	for _, comp := range p {
+	for i := 0; i < len(p); i++ {
+		comp := p[i]
 		switch comp.Type {
 		case vg.MoveComp:
+			if n := lineRun(p[i+1:]); c.stream && n > 0 {
+				c.wstream(p[i:i+1+n], true)
+				i += n
+				continue
+			}
 			c.wtex(`\pgfpathmoveto{\pgfpoint{%gpt}{%gpt}}`, comp.Pos.X, comp.Pos.Y)
 		case vg.LineComp:
+			if n := lineRun(p[i:]); c.stream && n > 1 {
+				c.wstream(p[i:i+n], false)
+				i += n - 1
+				continue
+			}
 			c.wtex(`\pgflineto{\pgfpoint{%gpt}{%gpt}}`, comp.Pos.X, comp.Pos.Y)
 		case vg.ArcComp:
 			start := comp.Start * degPerRadian
@@ -274,7 +460,7 @@ func (c *Canvas) wpath(p vg.Path) {
 			default:
 				panic("vgtex: invalid number of control points")
 			}
-			c.wtex(`\pgfcurveto{\pgfpoint{%gpt}{%gpt}}{\pgfpoint{%gpt}{%gpt}}{\pgfpoint{%gpt}{%gpt}}`,
+			c.wtex(`\pgfpathcurveto{\pgfpoint{%gpt}{%gpt}}{\pgfpoint{%gpt}{%gpt}}{\pgfpoint{%gpt}{%gpt}}`,
 				a.X, a.Y, b.X, b.Y, comp.Pos.X, comp.Pos.Y)
 		case vg.CloseComp:
 			c.wtex("%% path-close")
@@ -284,6 +470,91 @@ func (c *Canvas) wpath(p vg.Path) {
 	}
 }
 
+// streamPoint is the macro adding a point, given by its coordinates
+// in points, to a plot stream. It is defined at the start of the
+// picture when plot streams are used.
+const streamPoint = `\def\gonumpt#1#2{\pgfplotstreampoint{\pgfqpoint{#1pt}{#2pt}}}`
+
+// streamPointsPerLine is the number of points
+// of a plot stream written on each line.
+const streamPointsPerLine = 8
+
+// lineRun returns the number of line components
+// at the start of p.
+func lineRun(p vg.Path) int {
+	for i, comp := range p {
+		if comp.Type != vg.LineComp {
+			return i
+		}
+	}
+	return len(p)
+}
+
+// wstream writes path components as a plot stream, drawn with the
+// lineto handler that draws lines to the points of the stream. The
+// first component is a move if move is true, and a line otherwise.
+func (c *Canvas) wstream(p vg.Path, move bool) {
+	c.streamed = true
+	c.wtex(`\pgfplothandlerlineto`)
+	if !move {
+		c.wtex(`\pgfsetlinetofirstplotpoint`)
+	}
+	c.wtex(`\pgfplotstreamstart`)
+	var line strings.Builder
+	for i, comp := range p {
+		fmt.Fprintf(&line, `\gonumpt{%g}{%g}`, comp.Pos.X, comp.Pos.Y)
+		if (i+1)%streamPointsPerLine == 0 || i == len(p)-1 {
+			c.wtex("%s", line.String())
+			line.Reset()
+		}
+	}
+	c.wtex(`\pgfplotstreamend`)
+	if !move {
+		c.wtex(`\pgfsetmovetofirstplotpoint`)
+	}
+}
+
+// header returns the document preamble, or the comment listing the
+// required packages when the canvas is not in document mode.
+func (c *Canvas) header() string {
+	pkgs := append([]texPackage{{name: "pgf"}}, c.packages...)
+	var buf strings.Builder
+	if c.document {
+		buf.WriteString("%%%%%% generated by gonum/plot %%%%%%\n")
+		fmt.Fprintf(&buf, "\\documentclass{%s}\n", c.class)
+		for _, p := range pkgs {
+			fmt.Fprintf(&buf, "%v\n", p)
+		}
+		buf.WriteString("\\begin{document}\n")
+		return buf.String()
+	}
+
+	buf.WriteString("%% gonum/plot created for LaTeX/pgf\n")
+	buf.WriteString("%% you need to add:\n")
+	for _, p := range pkgs {
+		fmt.Fprintf(&buf, "%%%%   %v\n", p)
+	}
+	buf.WriteString("%% to your LaTeX document\n")
+	return buf.String()
+}
+
+// tex returns the LaTeX instructions storing the base64-encoded image
+// and extracting it to its PNG file.
+func (img texImage) tex() string {
+	const width = 76
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "\\begin{filecontents*}[overwrite]{%s.b64}\n", img.name)
+	enc := base64.StdEncoding.EncodeToString(img.data)
+	for len(enc) > width {
+		buf.WriteString(enc[:width] + "\n")
+		enc = enc[width:]
+	}
+	buf.WriteString(enc + "\n")
+	buf.WriteString("\\end{filecontents*}\n")
+	fmt.Fprintf(&buf, "\\immediate\\write18{base64 -d %[1]s.b64 > %[1]s}\n", img.name)
+	return buf.String()
+}
+
 // WriteTo implements the io.WriterTo interface, writing a LaTeX/pgf plot.
 func (c *Canvas) WriteTo(w io.Writer) (int64, error) {
 	var (
@@ -292,8 +563,42 @@ func (c *Canvas) WriteTo(w io.Writer) (int64, error) {
 		err error
 	)
 	b := bufio.NewWriter(w)
-	if c.document {
-		nn, err = b.Write([]byte(defaultHeader))
+	nn, err = b.Write([]byte(c.header()))
+	n += int64(nn)
+	if err != nil {
+		return n, err
+	}
+	nn, err = b.Write([]byte("\n"))
+	n += int64(nn)
+	if err != nil {
+		return n, err
+	}
+	for _, img := range c.images {
+		nn, err = b.Write([]byte(img.tex()))
+		n += int64(nn)
+		if err != nil {
+			return n, err
+		}
+	}
+	for i, col := range c.colors {
+		nn, err = fmt.Fprintf(b,
+			"\\definecolor{plotcolor%d}{rgb}{%g,%g,%g}\n", i,
+			float64(col.r)/math.MaxUint16,
+			float64(col.g)/math.MaxUint16,
+			float64(col.b)/math.MaxUint16,
+		)
+		n += int64(nn)
+		if err != nil {
+			return n, err
+		}
+	}
+	nn, err = b.Write([]byte("\\begin{pgfpicture}\n"))
+	n += int64(nn)
+	if err != nil {
+		return n, err
+	}
+	if c.streamed {
+		nn, err = b.Write([]byte(streamPoint + "\n"))
 		n += int64(nn)
 		if err != nil {
 			return n, err
@@ -5,13 +5,25 @@
 package vgtex_test
 
 import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
 	"image/color"
+	"image/png"
+	"io/ioutil"
+	"math"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
 	"gonum.org/v1/plot/plotter"
 	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/vgtex"
 )
 
 func TestTexCanvas(t *testing.T) {
@@ -97,3 +109,444 @@ func TestFillStyle(t *testing.T) {
 		}
 	}, t, "fillstyle.tex")
 }
+
+func TestNamedColors(t *testing.T) {
+	for _, document := range []bool{false, true} {
+		var c *vgtex.Canvas
+		if document {
+			c = vgtex.NewDocument(10, 10)
+		} else {
+			c = vgtex.New(10, 10)
+		}
+		red := color.RGBA{R: 255, A: 255}
+		c.SetColor(red)
+		c.Push()
+		c.SetColor(color.RGBA{B: 255, A: 128})
+		c.Fill(vg.Path{{Type: vg.MoveComp}, {Type: vg.LineComp, Pos: vg.Point{X: 1, Y: 1}}})
+		c.Pop()
+		c.Fill(vg.Path{{Type: vg.MoveComp}, {Type: vg.LineComp, Pos: vg.Point{X: 1, Y: 1}}})
+		c.SetColor(red)
+		c.Fill(vg.Path{{Type: vg.MoveComp}, {Type: vg.LineComp, Pos: vg.Point{X: 1, Y: 1}}})
+
+		var buf bytes.Buffer
+		if _, err := c.WriteTo(&buf); err != nil {
+			t.Fatalf("could not write canvas: %+v", err)
+		}
+		out := buf.String()
+
+		if strings.Contains(out, `\color[rgb]`) {
+			t.Errorf("document=%v: unexpected inline color:\n%s", document, out)
+		}
+		for _, def := range []string{
+			`\definecolor{plotcolor0}{rgb}{0,0,1}`,
+			`\definecolor{plotcolor1}{rgb}{1,0,0}`,
+		} {
+			if got := strings.Count(out, def); got != 1 {
+				t.Errorf("document=%v: got %d definitions of %q, want 1", document, got, def)
+			}
+		}
+		if strings.Contains(out, "plotcolor2") {
+			t.Errorf("document=%v: colors not deduplicated:\n%s", document, out)
+		}
+		if i, j := strings.Index(out, `\definecolor`), strings.Index(out, `\begin{pgfpicture}`); i > j {
+			t.Errorf("document=%v: color definitions after pgfpicture body", document)
+		}
+		if got, want := strings.Count(out, `\pgfsetcolor{plotcolor1}`), 2; got != want {
+			t.Errorf("document=%v: got %d uses of plotcolor1 after Pop, want %d", document, got, want)
+		}
+	}
+}
+
+func TestCurve(t *testing.T) {
+	c := vgtex.New(10, 10)
+	var p vg.Path
+	p.Move(vg.Point{X: 0, Y: 0})
+	p.CubeTo(vg.Point{X: 1, Y: 2}, vg.Point{X: 3, Y: 4}, vg.Point{X: 5, Y: 6})
+	c.Stroke(p)
+
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatalf("could not write canvas: %+v", err)
+	}
+
+	const want = `\pgfpathcurveto{\pgfpoint{1pt}{2pt}}{\pgfpoint{3pt}{4pt}}{\pgfpoint{5pt}{6pt}}`
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("missing curve instruction %q in:\n%s", want, buf.String())
+	}
+}
+
+func TestPreamble(t *testing.T) {
+	for _, test := range []struct {
+		document bool
+		want     string
+	}{
+		{
+			document: true,
+			want: `%%%%%% generated by gonum/plot %%%%%%
+\documentclass{article}
+\usepackage{pgf}
+\usepackage{amsmath}
+\usepackage[no-math]{fontspec}
+\begin{document}
+`,
+		},
+		{
+			document: false,
+			want: `%% gonum/plot created for LaTeX/pgf
+%% you need to add:
+%%   \usepackage{pgf}
+%%   \usepackage{amsmath}
+%%   \usepackage[no-math]{fontspec}
+%% to your LaTeX document
+`,
+		},
+	} {
+		var c *vgtex.Canvas
+		if test.document {
+			c = vgtex.NewDocument(10, 10)
+		} else {
+			c = vgtex.New(10, 10)
+		}
+		c.SetDocumentClass("article")
+		c.AddPackage("amsmath", "")
+		c.AddPackage("fontspec", "no-math")
+
+		var buf bytes.Buffer
+		if _, err := c.WriteTo(&buf); err != nil {
+			t.Fatalf("could not write canvas: %+v", err)
+		}
+		if got := buf.String(); !strings.HasPrefix(got, test.want) {
+			t.Errorf("document=%v: invalid preamble:\ngot:\n%s\nwant:\n%s", test.document, got, test.want)
+		}
+	}
+}
+
+func TestFillStringFont(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		size vg.Length
+		want string
+	}{
+		{"Times-Roman", 8, `{\fontsize{8pt}{8pt}\selectfont\rmfamily x}`},
+		{"Helvetica-Bold", 14, `{\fontsize{14pt}{14pt}\selectfont\sffamily\bfseries x}`},
+		{"Courier-BoldOblique", 10, `{\fontsize{10pt}{10pt}\selectfont\ttfamily\bfseries\slshape x}`},
+		{"Times-Italic", 12, `{\fontsize{12pt}{12pt}\selectfont\rmfamily\itshape x}`},
+	} {
+		fnt, err := vg.MakeFont(test.name, test.size)
+		if err != nil {
+			t.Fatalf("could not create font %q: %+v", test.name, err)
+		}
+		c := vgtex.New(10, 10)
+		c.FillString(fnt, vg.Point{}, "x")
+
+		var buf bytes.Buffer
+		if _, err := c.WriteTo(&buf); err != nil {
+			t.Fatalf("could not write canvas: %+v", err)
+		}
+		if !strings.Contains(buf.String(), test.want) {
+			t.Errorf("%s: missing font selection %q in:\n%s", test.name, test.want, buf.String())
+		}
+	}
+}
+
+func TestFillTextAlign(t *testing.T) {
+	fnt, err := vg.MakeFont("Times-Roman", 10)
+	if err != nil {
+		t.Fatalf("could not create font: %+v", err)
+	}
+	for _, test := range []struct {
+		xalign float64
+		want   string
+	}{
+		{0, `\pgftext[base,left,at={\pgfpoint{10pt}{20pt}}]`},
+		{-0.5, `\pgftext[base,at={\pgfpoint{10pt}{20pt}}]`},
+		{-1, `\pgftext[base,right,at={\pgfpoint{10pt}{20pt}}]`},
+	} {
+		c := vgtex.New(100, 100)
+		c.FillText(fnt, vg.Point{X: 10, Y: 20}, test.xalign, "text")
+
+		var buf bytes.Buffer
+		if _, err := c.WriteTo(&buf); err != nil {
+			t.Fatalf("could not write canvas: %+v", err)
+		}
+		if !strings.Contains(buf.String(), test.want) {
+			t.Errorf("xalign=%v: missing anchor %q in:\n%s", test.xalign, test.want, buf.String())
+		}
+	}
+}
+
+func TestDrawImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, color.RGBA{R: 255, A: 255})
+	img.Set(1, 0, color.RGBA{G: 255, A: 255})
+	img.Set(0, 1, color.RGBA{B: 255, A: 255})
+	img.Set(1, 1, color.White)
+
+	var want bytes.Buffer
+	err := png.Encode(&want, img)
+	if err != nil {
+		t.Fatalf("could not encode image: %+v", err)
+	}
+
+	re := regexp.MustCompile(`\\pgfimage\[height=20pt,width=10pt\]\{(gonum-pgf-image-[0-9-]+\.png)\}`)
+	for _, embed := range []bool{false, true} {
+		c := vgtex.New(100, 100)
+		c.SetEmbedImages(embed)
+		c.DrawImage(vg.Rectangle{Min: vg.Point{X: 5, Y: 5}, Max: vg.Point{X: 15, Y: 25}}, img)
+
+		var buf bytes.Buffer
+		if _, err := c.WriteTo(&buf); err != nil {
+			t.Fatalf("could not write canvas: %+v", err)
+		}
+		out := buf.String()
+		m := re.FindStringSubmatch(out)
+		if m == nil {
+			t.Errorf("embed=%v: missing image instruction in:\n%s", embed, out)
+			continue
+		}
+		fname := m[1]
+
+		if !embed {
+			got, err := ioutil.ReadFile(fname)
+			if err != nil {
+				t.Errorf("could not read external image: %+v", err)
+				continue
+			}
+			os.Remove(fname)
+			if !bytes.Equal(got, want.Bytes()) {
+				t.Errorf("invalid external image content")
+			}
+			continue
+		}
+
+		if _, err := os.Stat(fname); err == nil {
+			os.Remove(fname)
+			t.Errorf("embedded image written to external file %q", fname)
+		}
+		if !strings.Contains(strings.Replace(out, "\n", "", -1), base64.StdEncoding.EncodeToString(want.Bytes())) {
+			t.Errorf("missing base64-encoded image in:\n%s", out)
+		}
+		if i, j := strings.Index(out, `\begin{filecontents*}`), strings.Index(out, `\begin{pgfpicture}`); i < 0 || i > j {
+			t.Errorf("embedded image should be stored ahead of the pgfpicture body:\n%s", out)
+		}
+	}
+}
+
+func TestClip(t *testing.T) {
+	c := vgtex.New(100, 100)
+	c.Push()
+	rect := vg.Rectangle{Min: vg.Point{X: 10, Y: 10}, Max: vg.Point{X: 50, Y: 50}}
+	c.Clip(rect.Path())
+	var line vg.Path
+	line.Move(vg.Point{X: 0, Y: 30})
+	line.Line(vg.Point{X: 100, Y: 30})
+	c.Stroke(line)
+	c.Pop()
+	c.Stroke(line)
+
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatalf("could not write canvas: %+v", err)
+	}
+	out := buf.String()
+
+	clip := strings.Index(out, `\pgfusepath{clip}`)
+	if clip < 0 {
+		t.Fatalf("missing clip instruction in:\n%s", out)
+	}
+	stroke := strings.Index(out, `\pgfusepath{stroke}`)
+	if stroke < clip {
+		t.Errorf("clip instruction should precede the stroke:\n%s", out)
+	}
+	// The clipping scope must be closed before the last stroke.
+	last := strings.LastIndex(out, `\pgfusepath{stroke}`)
+	depth := 0
+	for _, line := range strings.Split(out[clip:last], "\n") {
+		switch strings.TrimSpace(line) {
+		case `\begin{pgfscope}`:
+			depth++
+		case `\end{pgfscope}`:
+			depth--
+		}
+		if depth < 0 {
+			break
+		}
+	}
+	if depth >= 0 {
+		t.Errorf("clipping region not reset by Pop:\n%s", out)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	c := vgtex.New(10, 10)
+	c.Push()
+	// Rotate, scale and translate with a single matrix.
+	const angle = math.Pi / 6
+	m := vg.Translation(vg.Point{X: 10, Y: 20}).Mul(vg.Rotation(angle)).Mul(vg.Scaling(2, 3))
+	vg.Transform(c, m)
+	var p vg.Path
+	p.Move(vg.Point{X: 0, Y: 0})
+	p.Line(vg.Point{X: 1, Y: 1})
+	c.Stroke(p)
+	c.Pop()
+
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatalf("could not write canvas: %+v", err)
+	}
+
+	sin, cos := math.Sincos(angle)
+	want := fmt.Sprintf(`\pgftransformcm{%g}{%g}{%g}{%g}{\pgfpoint{10pt}{20pt}}`, 2*cos, 2*sin, -3*sin, 3*cos)
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("missing transform instruction %q in:\n%s", want, buf.String())
+	}
+}
+
+func TestLineCapJoin(t *testing.T) {
+	c := vgtex.New(10, 10)
+	c.Push()
+	c.SetLineCap(vg.RoundCap)
+	c.SetLineJoin(vg.BevelJoin)
+	var p vg.Path
+	p.Move(vg.Point{X: 1, Y: 1})
+	p.Line(vg.Point{X: 5, Y: 9})
+	p.Line(vg.Point{X: 9, Y: 1})
+	c.Stroke(p)
+	c.Pop()
+
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatalf("could not write canvas: %+v", err)
+	}
+
+	// The styles are set in the scope of the Push.
+	re := regexp.MustCompile(`\\begin{pgfscope}\s+\\pgfsetroundcap\s+\\pgfsetbeveljoin\s+\\begin{pgfscope}`)
+	if !re.Match(buf.Bytes()) {
+		t.Errorf("missing line cap and join instructions in:\n%s", buf.String())
+	}
+}
+
+// texPath returns the components of the paths drawn in the LaTeX
+// output of a canvas, as M, L and A for moves, lines and arcs
+// followed by their arguments.
+func texPath(t *testing.T, out string) []string {
+	t.Helper()
+	var (
+		move   = regexp.MustCompile(`^\\pgfpathmoveto{\\pgfpoint{(.*)pt}{(.*)pt}}$`)
+		line   = regexp.MustCompile(`^\\pgflineto{\\pgfpoint{(.*)pt}{(.*)pt}}$`)
+		arc    = regexp.MustCompile(`^\\pgfpatharc{(.*)}{(.*)}{(.*)pt}$`)
+		point  = regexp.MustCompile(`\\gonumpt{([^}]*)}{([^}]*)}`)
+		comps  []string
+		stream = -1
+
+		linetoFirst bool
+	)
+	for _, l := range strings.Split(out, "\n") {
+		l = strings.TrimSpace(l)
+		switch {
+		case l == `\pgfsetlinetofirstplotpoint`:
+			linetoFirst = true
+		case l == `\pgfsetmovetofirstplotpoint`:
+			linetoFirst = false
+		case l == `\pgfplotstreamstart`:
+			stream = 0
+		case l == `\pgfplotstreamend`:
+			stream = -1
+		case stream >= 0:
+			for _, m := range point.FindAllStringSubmatch(l, -1) {
+				op := "L"
+				if stream == 0 && !linetoFirst {
+					op = "M"
+				}
+				comps = append(comps, op+" "+m[1]+" "+m[2])
+				stream++
+			}
+		default:
+			if m := move.FindStringSubmatch(l); m != nil {
+				comps = append(comps, "M "+m[1]+" "+m[2])
+			}
+			if m := line.FindStringSubmatch(l); m != nil {
+				comps = append(comps, "L "+m[1]+" "+m[2])
+			}
+			if m := arc.FindStringSubmatch(l); m != nil {
+				comps = append(comps, "A "+m[1]+" "+m[2]+" "+m[3])
+			}
+		}
+	}
+	return comps
+}
+
+func TestStreamLines(t *testing.T) {
+	draw := func(stream bool) string {
+		c := vgtex.New(100, 100)
+		c.SetStreamLines(stream)
+		var p vg.Path
+		p.Move(vg.Point{X: 0, Y: 50})
+		for i := 0; i < 200; i++ {
+			p.Line(vg.Point{X: vg.Length(i) / 2, Y: vg.Length(i * i % 7)})
+		}
+		p.Arc(vg.Point{X: 50, Y: 50}, 10, 0, math.Pi)
+		p.Line(vg.Point{X: 60, Y: 60})
+		p.Line(vg.Point{X: 70, Y: 60})
+		p.Move(vg.Point{X: 1, Y: 2})
+		p.Line(vg.Point{X: 3, Y: 4})
+		p.Close()
+		c.Stroke(p)
+
+		var buf bytes.Buffer
+		if _, err := c.WriteTo(&buf); err != nil {
+			t.Fatalf("could not write canvas: %+v", err)
+		}
+		return buf.String()
+	}
+
+	plain := draw(false)
+	streamed := draw(true)
+	if strings.Contains(plain, `\pgfplotstreamstart`) || strings.Contains(plain, `\gonumpt`) {
+		t.Errorf("unexpected plot stream in default output:\n%s", plain)
+	}
+	if got := strings.Count(streamed, `\pgfplotstreamstart`); got != 3 {
+		t.Errorf("unexpected number of plot streams: got:%d want:3\n%s", got, streamed)
+	}
+	if !strings.Contains(streamed, "\\begin{pgfpicture}\n\\def\\gonumpt#1#2") {
+		t.Errorf("missing definition of the stream point macro:\n%s", streamed)
+	}
+	if len(streamed) >= len(plain) {
+		t.Errorf("streamed output not smaller: got:%d plain:%d", len(streamed), len(plain))
+	}
+
+	want := texPath(t, plain)
+	got := texPath(t, streamed)
+	if len(want) != 1+200+1+2+2 {
+		t.Fatalf("unexpected number of path components: got:%d want:206", len(want))
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected streamed geometry:\ngot: %q\nwant:%q", got, want)
+	}
+}
+
+func BenchmarkStreamLines(b *testing.B) {
+	const n = 10000
+	var p vg.Path
+	p.Move(vg.Point{X: 0, Y: 50})
+	for i := 1; i < n; i++ {
+		x := vg.Length(i) / 100
+		p.Line(vg.Point{X: x, Y: 50 + 40*vg.Length(math.Sin(float64(x)))})
+	}
+	for _, stream := range []bool{false, true} {
+		b.Run(fmt.Sprintf("stream=%t", stream), func(b *testing.B) {
+			var size int64
+			for i := 0; i < b.N; i++ {
+				c := vgtex.New(100, 100)
+				c.SetStreamLines(stream)
+				c.Stroke(p)
+				var err error
+				size, err = c.WriteTo(ioutil.Discard)
+				if err != nil {
+					b.Fatalf("could not write canvas: %+v", err)
+				}
+			}
+			b.ReportMetric(float64(size), "bytes")
+		})
+	}
+}